@@ -0,0 +1,31 @@
+//go:build acceptance || blockstorage || clusters
+
+package v3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/clusters"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestClustersListDetail(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	blockClient, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	blockClient.Microversion = "3.7"
+
+	allPages, err := clusters.ListDetail(blockClient, clusters.ListOpts{}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allClusters, err := clusters.ExtractClusters(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, cluster := range allClusters {
+		tools.PrintResource(t, cluster)
+	}
+}
@@ -0,0 +1,46 @@
+//go:build acceptance || blockstorage || defaulttypes
+
+package v3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	identity "github.com/gophercloud/gophercloud/v2/internal/acceptance/openstack/identity/v3"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/defaulttypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestDefaultTypesCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	client.Microversion = "3.62"
+
+	identityClient, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	vt, err := CreateVolumeType(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteVolumeType(t, client, vt)
+
+	project, err := identity.CreateProject(t, identityClient, nil)
+	th.AssertNoErr(t, err)
+	defer identity.DeleteProject(t, identityClient, project.ID)
+
+	defaultType, err := defaulttypes.Set(context.TODO(), client, project.ID, defaulttypes.SetOpts{VolumeType: vt.ID}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, defaultType)
+	th.AssertEquals(t, project.ID, defaultType.ProjectID)
+	th.AssertEquals(t, vt.ID, defaultType.VolumeTypeID)
+
+	defaultType, err = defaulttypes.Get(context.TODO(), client, project.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, vt.ID, defaultType.VolumeTypeID)
+
+	err = defaulttypes.Delete(context.TODO(), client, project.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
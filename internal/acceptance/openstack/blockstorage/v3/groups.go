@@ -0,0 +1,198 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+// CreateGroupType will create a group type with a random name. An error will
+// be returned if the group type was unable to be created.
+func CreateGroupType(t *testing.T, client *gophercloud.ServiceClient) (*grouptypes.GroupType, error) {
+	name := tools.RandomString("ACPTTEST", 16)
+	description := "create_from_gophercloud"
+	t.Logf("Attempting to create group type: %s", name)
+
+	createOpts := grouptypes.CreateOpts{
+		Name:        name,
+		Description: description,
+		GroupSpecs:  map[string]string{"consistent_group_snapshot_enabled": "<is> False"},
+	}
+
+	gt, err := grouptypes.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	tools.PrintResource(t, gt)
+	th.AssertEquals(t, gt.Name, name)
+	th.AssertEquals(t, gt.Description, description)
+
+	t.Logf("Successfully created group type: %s", gt.ID)
+
+	return gt, nil
+}
+
+// DeleteGroupType will delete a group type. A fatal error will occur if the
+// group type failed to be deleted. This works best when used as a deferred
+// function.
+func DeleteGroupType(t *testing.T, client *gophercloud.ServiceClient, gt *grouptypes.GroupType) {
+	t.Logf("Attempting to delete group type: %s", gt.ID)
+
+	err := grouptypes.Delete(context.TODO(), client, gt.ID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete group type %s: %v", gt.ID, err)
+	}
+
+	t.Logf("Successfully deleted group type: %s", gt.ID)
+}
+
+// CreateGroup will create a group of the given group type and volume type
+// with a random name. An error will be returned if the group was unable to be
+// created.
+func CreateGroup(t *testing.T, client *gophercloud.ServiceClient, gt *grouptypes.GroupType, vt *volumetypes.VolumeType) (*groups.Group, error) {
+	name := tools.RandomString("ACPTTEST", 16)
+	t.Logf("Attempting to create group: %s", name)
+
+	createOpts := groups.CreateOpts{
+		Name:        name,
+		GroupType:   gt.ID,
+		VolumeTypes: []string{vt.ID},
+	}
+
+	group, err := groups.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		return group, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 60*time.Second)
+	defer cancel()
+
+	err = groups.WaitForStatus(ctx, client, group.ID, "available")
+	if err != nil {
+		return group, err
+	}
+
+	group, err = groups.Get(context.TODO(), client, group.ID).Extract()
+	if err != nil {
+		return group, err
+	}
+
+	tools.PrintResource(t, group)
+	th.AssertEquals(t, group.Name, name)
+	th.AssertEquals(t, group.GroupType, gt.ID)
+
+	t.Logf("Successfully created group: %s", group.ID)
+
+	return group, nil
+}
+
+// DeleteGroup will delete a group and its volumes. A fatal error will occur
+// if the group failed to be deleted. This works best when used as a deferred
+// function.
+func DeleteGroup(t *testing.T, client *gophercloud.ServiceClient, group *groups.Group) {
+	t.Logf("Attempting to delete group: %s", group.ID)
+
+	err := groups.Delete(context.TODO(), client, group.ID, groups.DeleteOpts{DeleteVolumes: true}).ExtractErr()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			t.Logf("Group %s is already deleted", group.ID)
+			return
+		}
+		t.Fatalf("Unable to delete group %s: %v", group.ID, err)
+	}
+
+	// Group types can't be deleted until their groups have been,
+	// so block until the group is deleted.
+	err = tools.WaitFor(func(ctx context.Context) (bool, error) {
+		_, err := groups.Get(ctx, client, group.ID).Extract()
+		if err != nil {
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("Error waiting for group to delete: %v", err)
+	}
+
+	t.Logf("Successfully deleted group: %s", group.ID)
+}
+
+// CreateGroupSnapshot will create a snapshot of the specified group. An error
+// will be returned if the group snapshot was unable to be created.
+func CreateGroupSnapshot(t *testing.T, client *gophercloud.ServiceClient, group *groups.Group) (*groupsnapshots.GroupSnapshot, error) {
+	name := tools.RandomString("ACPTTEST", 16)
+	t.Logf("Attempting to create group snapshot: %s", name)
+
+	createOpts := groupsnapshots.CreateOpts{
+		GroupID: group.ID,
+		Name:    name,
+	}
+
+	groupSnapshot, err := groupsnapshots.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		return groupSnapshot, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 60*time.Second)
+	defer cancel()
+
+	err = groupsnapshots.WaitForStatus(ctx, client, groupSnapshot.ID, "available")
+	if err != nil {
+		return groupSnapshot, err
+	}
+
+	groupSnapshot, err = groupsnapshots.Get(context.TODO(), client, groupSnapshot.ID).Extract()
+	if err != nil {
+		return groupSnapshot, err
+	}
+
+	tools.PrintResource(t, groupSnapshot)
+	th.AssertEquals(t, groupSnapshot.Name, name)
+	th.AssertEquals(t, groupSnapshot.GroupID, group.ID)
+
+	t.Logf("Successfully created group snapshot: %s", groupSnapshot.ID)
+
+	return groupSnapshot, nil
+}
+
+// DeleteGroupSnapshot will delete a group snapshot. A fatal error will occur
+// if the group snapshot failed to be deleted.
+func DeleteGroupSnapshot(t *testing.T, client *gophercloud.ServiceClient, groupSnapshot *groupsnapshots.GroupSnapshot) {
+	t.Logf("Attempting to delete group snapshot: %s", groupSnapshot.ID)
+
+	err := groupsnapshots.Delete(context.TODO(), client, groupSnapshot.ID).ExtractErr()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			t.Logf("Group snapshot %s is already deleted", groupSnapshot.ID)
+			return
+		}
+		t.Fatalf("Unable to delete group snapshot %s: %v", groupSnapshot.ID, err)
+	}
+
+	// Groups can't be deleted until their snapshots have been,
+	// so block until the group snapshot is deleted.
+	err = tools.WaitFor(func(ctx context.Context) (bool, error) {
+		_, err := groupsnapshots.Get(ctx, client, groupSnapshot.ID).Extract()
+		if err != nil {
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("Error waiting for group snapshot to delete: %v", err)
+	}
+
+	t.Logf("Successfully deleted group snapshot: %s", groupSnapshot.ID)
+}
@@ -0,0 +1,128 @@
+//go:build acceptance || blockstorage || groups
+
+package v3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestGroupTypesCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	client.Microversion = "3.11"
+
+	gt, err := CreateGroupType(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteGroupType(t, client, gt)
+
+	allPages, err := grouptypes.List(client, nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allGroupTypes, err := grouptypes.ExtractGroupTypes(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, v := range allGroupTypes {
+		tools.PrintResource(t, v)
+		if v.ID == gt.ID {
+			found = true
+		}
+	}
+
+	th.AssertTrue(t, found)
+
+	name := gt.Name + "-updated"
+	updateOpts := grouptypes.UpdateOpts{
+		Name: &name,
+	}
+
+	newGT, err := grouptypes.Update(context.TODO(), client, gt.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, name, newGT.Name)
+
+	createOpts := grouptypes.GroupSpecsOpts{
+		"replication_enabled": "<is> False",
+	}
+	groupSpecs, err := grouptypes.CreateGroupSpecs(context.TODO(), client, gt.ID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "<is> False", groupSpecs["replication_enabled"])
+
+	err = grouptypes.DeleteGroupSpec(context.TODO(), client, gt.ID, "replication_enabled").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	groupSpecs, err = grouptypes.ListGroupSpecs(context.TODO(), client, gt.ID).Extract()
+	th.AssertNoErr(t, err)
+	_, ok := groupSpecs["replication_enabled"]
+	th.AssertFalse(t, ok)
+}
+
+func TestGroupsCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+	clients.RequireLong(t)
+
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	client.Microversion = "3.20"
+
+	vt, err := CreateVolumeTypeNoExtraSpecs(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteVolumeType(t, client, vt)
+
+	gt, err := CreateGroupType(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteGroupType(t, client, gt)
+
+	group, err := CreateGroup(t, client, gt, vt)
+	th.AssertNoErr(t, err)
+	defer DeleteGroup(t, client, group)
+
+	volume, err := CreateVolumeWithType(t, client, vt)
+	th.AssertNoErr(t, err)
+	defer DeleteVolume(t, client, volume)
+
+	updateOpts := groups.UpdateOpts{
+		AddVolumes: []string{volume.ID},
+	}
+	err = groups.Update(context.TODO(), client, group.ID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = groups.WaitForStatus(context.TODO(), client, group.ID, "available")
+	th.AssertNoErr(t, err)
+
+	groupSnapshot, err := CreateGroupSnapshot(t, client, group)
+	th.AssertNoErr(t, err)
+	defer DeleteGroupSnapshot(t, client, groupSnapshot)
+
+	allPages, err := groupsnapshots.ListDetail(client, groupsnapshots.ListOpts{GroupID: group.ID}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allGroupSnapshots, err := groupsnapshots.ExtractGroupSnapshots(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allGroupSnapshots))
+
+	updateOpts = groups.UpdateOpts{
+		RemoveVolumes: []string{volume.ID},
+	}
+	err = groups.Update(context.TODO(), client, group.ID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = groups.WaitForStatus(context.TODO(), client, group.ID, "available")
+	th.AssertNoErr(t, err)
+
+	err = volumes.WaitForStatus(context.TODO(), client, volume.ID, "available")
+	th.AssertNoErr(t, err)
+
+	err = groups.ResetStatus(context.TODO(), client, group.ID, groups.ResetStatusOpts{Status: "available"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
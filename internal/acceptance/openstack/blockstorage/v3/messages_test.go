@@ -0,0 +1,29 @@
+//go:build acceptance || blockstorage || messages
+
+package v3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/messages"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestMessagesList(t *testing.T) {
+	blockClient, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	blockClient.Microversion = "3.5"
+
+	allPages, err := messages.List(blockClient, messages.ListOpts{}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allMessages, err := messages.ExtractMessages(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, message := range allMessages {
+		tools.PrintResource(t, message)
+	}
+}
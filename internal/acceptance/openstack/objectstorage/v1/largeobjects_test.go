@@ -0,0 +1,63 @@
+//go:build acceptance || objectstorage || largeobjects
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestLargeObjects(t *testing.T) {
+	client, err := clients.NewObjectStorageV1Client()
+	th.AssertNoErr(t, err)
+
+	cName := "test-container-" + tools.RandomFunnyStringNoSlash(8)
+	segmentContainer := cName + "_segments"
+	_, err = containers.Create(context.TODO(), client, cName, nil).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, containers.Delete(context.TODO(), client, segmentContainer).Err)
+		th.AssertNoErr(t, containers.Delete(context.TODO(), client, cName).Err)
+	}()
+
+	// Swift requires every segment but the last one to be at least 1 MiB.
+	content := make([]byte, 5*1024*1024/2)
+	_, err = rand.Read(content)
+	th.AssertNoErr(t, err)
+
+	oName := "test-object-" + tools.RandomFunnyString(8)
+	res, err := largeobjects.Upload(context.TODO(), client, cName, oName, largeobjects.UploadOpts{
+		Content:     bytes.NewReader(content),
+		SegmentSize: 1024 * 1024,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, res.UploadedSegments)
+	defer func() {
+		deleteOpts := objects.DeleteOpts{
+			MultipartManifest: "delete",
+		}
+		th.AssertNoErr(t, objects.Delete(context.TODO(), client, cName, oName, deleteOpts).Err)
+	}()
+
+	segments, err := largeobjects.GetManifest(context.TODO(), client, cName, oName).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(segments))
+	tools.PrintResource(t, segments)
+
+	download := objects.Download(context.TODO(), client, cName, oName, nil)
+	th.AssertNoErr(t, download.Err)
+	defer download.Body.Close()
+	downloaded, err := io.ReadAll(download.Body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, bytes.Equal(content, downloaded))
+}
@@ -0,0 +1,187 @@
+// Package rangedownload implements the parallel ranged downloads shared by
+// the object storage and image services.
+package rangedownload
+
+import (
+	"context"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultChunkSize is the size of the ranges requested when Opts.ChunkSize
+	// is not set.
+	DefaultChunkSize int64 = 16 * 1024 * 1024
+
+	// DefaultConcurrency is the number of ranges downloaded concurrently when
+	// Opts.Concurrency is not set.
+	DefaultConcurrency = 4
+
+	// DefaultMaxRetries is the number of times the download of a range is
+	// retried when Opts.MaxRetries is not set.
+	DefaultMaxRetries = 3
+)
+
+// FetchFunc requests length bytes of the content, starting at offset. It
+// returns the body and the headers of the response.
+type FetchFunc func(ctx context.Context, offset, length int64) (io.ReadCloser, http.Header, error)
+
+// Opts holds the parameters of Download.
+type Opts struct {
+	// Size is the size of the content.
+	Size int64
+
+	// Offset is the offset the download starts at. The bytes before it are
+	// expected to be already written.
+	Offset int64
+
+	ChunkSize   int64
+	Concurrency int
+	MaxRetries  int
+}
+
+// Download downloads the content from Opts.Offset to Opts.Size into w. It
+// returns the offset up to which the content is written without gap, which is
+// Opts.Size on success and can be used as Opts.Offset to resume the download
+// otherwise.
+func Download(ctx context.Context, w io.WriterAt, fetch FetchFunc, opts Opts) (int64, error) {
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	} else if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	if opts.Offset < 0 || opts.Offset > opts.Size {
+		return 0, fmt.Errorf("offset %d is out of the content range [0, %d]", opts.Offset, opts.Size)
+	}
+
+	var chunks []*chunk
+	for offset := opts.Offset; offset < opts.Size; offset += chunkSize {
+		chunks = append(chunks, &chunk{
+			offset: offset,
+			length: min(chunkSize, opts.Size-offset),
+		})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	queue := make(chan *chunk)
+	for range min(concurrency, len(chunks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range queue {
+				if err := c.download(ctx, w, fetch, maxRetries); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+
+enqueue:
+	for _, c := range chunks {
+		select {
+		case queue <- c:
+		case <-ctx.Done():
+			break enqueue
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	// The content is written up to the first incomplete chunk, and to the
+	// part of it which was downloaded.
+	written := opts.Size
+	for _, c := range chunks {
+		if c.written < c.length {
+			written = c.offset + c.written
+			break
+		}
+	}
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return written, firstErr
+}
+
+type chunk struct {
+	offset  int64
+	length  int64
+	written int64
+}
+
+// download downloads the chunk. A failed attempt is retried from the last
+// byte written.
+func (c *chunk) download(ctx context.Context, w io.WriterAt, fetch FetchFunc, maxRetries int) error {
+	var err error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		err = c.attempt(ctx, w, fetch)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+func (c *chunk) attempt(ctx context.Context, w io.WriterAt, fetch FetchFunc) error {
+	offset := c.offset + c.written
+	length := c.length - c.written
+
+	body, header, err := fetch(ctx, offset, length)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// A server which ignores the Range header sends the whole content.
+	contentRange := header.Get("Content-Range")
+	if !strings.HasPrefix(contentRange, fmt.Sprintf("bytes %d-%d/", offset, offset+length-1)) {
+		return fmt.Errorf("unexpected Content-Range %q for range %d-%d", contentRange, offset, offset+length-1)
+	}
+
+	n, err := io.CopyN(io.NewOffsetWriter(w, offset), body, length)
+	c.written += n
+	return err
+}
+
+// Range returns the value of the Range header requesting length bytes,
+// starting at offset.
+func Range(offset, length int64) string {
+	return fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+}
+
+// Hash returns the hexadecimal checksum of the first size bytes of r.
+func Hash(r io.ReaderAt, size int64, h hash.Hash) (string, error) {
+	if _, err := io.Copy(h, io.NewSectionReader(r, 0, size)); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
@@ -120,5 +120,42 @@ Example to Import a Backup
 	}
 
 	fmt.Println(backup)
+
+Example to Discover the Incremental Backup Chains of a Volume
+
+	chains, err := backups.DiscoverChains(context.TODO(), client, "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, chain := range chains {
+		for _, backup := range chain.Backups {
+			parentID, _ := chain.ParentID(backup.ID)
+			fmt.Printf("%s depends on %q\n", backup.ID, parentID)
+		}
+	}
+
+Example to Delete an Incremental Backup Chain
+
+	err := backups.DeleteChain(context.TODO(), client, chains[0])
+	if err != nil {
+		panic(err)
+	}
+
+Example to Restore a Backup Chain in another Region
+
+	imported, err := backups.ExportImportChain(context.TODO(), region1Client, region2Client, chains[0])
+	if err != nil {
+		panic(err)
+	}
+
+	restoreOpts := backups.RestoreOpts{
+		Name: "restored",
+	}
+
+	restore, err := backups.RestoreFromBackup(context.TODO(), region2Client, imported[len(imported)-1].ID, restoreOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package backups
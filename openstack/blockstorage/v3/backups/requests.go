@@ -157,6 +157,9 @@ type ListDetailOpts struct {
 	// AllTenants will retrieve backups of all tenants/projects.
 	AllTenants bool `q:"all_tenants"`
 
+	// VolumeID will filter by a specified volume ID.
+	VolumeID string `q:"volume_id"`
+
 	// Comma-separated list of sort keys and optional sort directions in the
 	// form of <key>[:<direction>].
 	Sort string `q:"sort"`
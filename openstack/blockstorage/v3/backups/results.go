@@ -53,6 +53,11 @@ type Backup struct {
 	// IsIncremental is whether this is an incremental backup.
 	IsIncremental bool `json:"is_incremental"`
 
+	// ParentID is the ID of the backup an incremental backup depends on. The
+	// Block Storage API does not report it in backup details, but it can be
+	// set from the record of an exported backup (see ImportBackup).
+	ParentID string `json:"parent_id"`
+
 	// DataTimestamp is the time when the data on the volume was first saved.
 	DataTimestamp time.Time `json:"-"`
 
@@ -340,3 +340,210 @@ func MockForceDeleteResponse(t *testing.T, fakeServer th.FakeServer) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+const (
+	chainVolumeID = "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959"
+	fullBackupID  = "a1b2c3d4-0001-4f60-8176-395c859501dd"
+	incr1BackupID = "a1b2c3d4-0002-4f60-8176-395c859501dd"
+	incr2BackupID = "a1b2c3d4-0003-4f60-8176-395c859501dd"
+	errorBackupID = "a1b2c3d4-0004-4f60-8176-395c859501dd"
+	full2BackupID = "a1b2c3d4-0005-4f60-8176-395c859501dd"
+)
+
+// ChainListDetailResponse lists the backups of a volume, in no particular
+// order: two chains, plus a failed backup.
+const ChainListDetailResponse = `
+{
+  "backups": [
+    {
+      "id": "a1b2c3d4-0003-4f60-8176-395c859501dd",
+      "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959",
+      "status": "available",
+      "is_incremental": true,
+      "has_dependent_backups": false,
+      "created_at": "2020-03-13T10:00:00.000000",
+      "data_timestamp": "2020-03-13T10:00:00.000000"
+    },
+    {
+      "id": "a1b2c3d4-0001-4f60-8176-395c859501dd",
+      "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959",
+      "status": "available",
+      "is_incremental": false,
+      "has_dependent_backups": true,
+      "created_at": "2020-03-11T10:00:00.000000",
+      "data_timestamp": "2020-03-11T10:00:00.000000"
+    },
+    {
+      "id": "a1b2c3d4-0004-4f60-8176-395c859501dd",
+      "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959",
+      "status": "error",
+      "is_incremental": true,
+      "has_dependent_backups": false,
+      "created_at": "2020-03-12T12:00:00.000000",
+      "data_timestamp": "2020-03-12T12:00:00.000000"
+    },
+    {
+      "id": "a1b2c3d4-0005-4f60-8176-395c859501dd",
+      "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959",
+      "status": "available",
+      "is_incremental": false,
+      "has_dependent_backups": false,
+      "created_at": "2020-03-14T10:00:00.000000",
+      "data_timestamp": "2020-03-14T10:00:00.000000"
+    },
+    {
+      "id": "a1b2c3d4-0002-4f60-8176-395c859501dd",
+      "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959",
+      "status": "available",
+      "is_incremental": true,
+      "has_dependent_backups": true,
+      "created_at": "2020-03-12T10:00:00.000000",
+      "data_timestamp": "2020-03-12T10:00:00.000000"
+    }
+  ]
+}
+`
+
+func MockChainListDetailResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/backups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"volume_id": chainVolumeID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ChainListDetailResponse)
+	})
+}
+
+// MockChainDeleteResponse serves the given backups until they are deleted, and
+// records the order they were deleted in. Deleting a backup which another
+// existing backup depends on fails, like in the Block Storage service.
+func MockChainDeleteResponse(t *testing.T, fakeServer th.FakeServer, parents map[string]string) *[]string {
+	deleted := []string{}
+	exists := func(id string) bool {
+		for _, d := range deleted {
+			if d == id {
+				return false
+			}
+		}
+		_, ok := parents[id]
+		return ok
+	}
+
+	for id := range parents {
+		fakeServer.Mux.HandleFunc("/backups/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			if !exists(id) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			switch r.Method {
+			case "GET":
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"backup": {"id": "%s", "status": "deleting"}}`, id)
+			case "DELETE":
+				for child, parent := range parents {
+					if parent == id && exists(child) {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+				}
+				deleted = append(deleted, id)
+				w.WriteHeader(http.StatusAccepted)
+			default:
+				t.Fatalf("Unexpected method: %s", r.Method)
+			}
+		})
+	}
+
+	return &deleted
+}
+
+// MockExportRecordResponses serves the export records of the given backups
+// on the source region.
+func MockExportRecordResponses(t *testing.T, fakeServer th.FakeServer, parents map[string]string) {
+	for id, parent := range parents {
+		fakeServer.Mux.HandleFunc("/backups/"+id+"/export_record", func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			record := backups.ImportBackup{
+				ID:       id,
+				VolumeID: chainVolumeID,
+			}
+			if parent != "" {
+				record.ParentID = &parent
+			}
+			backupURL, err := json.Marshal(record)
+			th.AssertNoErr(t, err)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			th.AssertNoErr(t, json.NewEncoder(w).Encode(map[string]any{
+				"backup-record": backups.BackupRecord{
+					BackupService: "cinder.backup.drivers.swift.SwiftBackupDriver",
+					BackupURL:     backupURL,
+				},
+			}))
+		})
+	}
+}
+
+// MockImportRecordResponses accepts backup records on the destination region
+// and records the order they were imported in. Importing a backup whose parent
+// was not imported yet fails.
+func MockImportRecordResponses(t *testing.T, fakeServer th.FakeServer) *[]string {
+	imported := []string{}
+	isImported := func(id string) bool {
+		for _, i := range imported {
+			if i == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	fakeServer.Mux.HandleFunc("/backups/import_record", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		var body struct {
+			Record backups.BackupRecord `json:"backup-record"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		th.AssertEquals(t, "cinder.backup.drivers.swift.SwiftBackupDriver", body.Record.BackupService)
+
+		var backup backups.ImportBackup
+		th.AssertNoErr(t, json.Unmarshal(body.Record.BackupURL, &backup))
+		if backup.ParentID != nil && !isImported(*backup.ParentID) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		imported = append(imported, backup.ID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"backup": {"id": "%s", "name": null}}`, backup.ID)
+	})
+
+	fakeServer.Mux.HandleFunc("/backups/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		id := r.URL.Path[len("/backups/"):]
+		if !isImported(id) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"backup": {"id": "%s", "status": "available"}}`, id)
+	})
+
+	return &imported
+}
@@ -3,9 +3,12 @@ package testing
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 	th "github.com/gophercloud/gophercloud/v2/testhelper"
@@ -211,3 +214,205 @@ func TestForceDelete(t *testing.T) {
 	res := backups.ForceDelete(context.TODO(), client.ServiceClient(fakeServer), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestBuildChains(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2020, 3, d, 10, 0, 0, 0, time.UTC) }
+	otherVolumeID := "0b2e4f1c-8a7d-4b33-9c3e-5f6a7b8c9d0e"
+
+	all := []backups.Backup{
+		{ID: incr2BackupID, VolumeID: chainVolumeID, Status: "available", IsIncremental: true, DataTimestamp: day(13)},
+		{ID: "other-full", VolumeID: otherVolumeID, Status: "available", DataTimestamp: day(12)},
+		{ID: fullBackupID, VolumeID: chainVolumeID, Status: "available", DataTimestamp: day(11)},
+		{ID: errorBackupID, VolumeID: chainVolumeID, Status: "error", IsIncremental: true, DataTimestamp: day(12)},
+		{ID: incr1BackupID, VolumeID: chainVolumeID, Status: "available", IsIncremental: true, CreatedAt: day(12)},
+		{ID: "orphan-incr", VolumeID: otherVolumeID, Status: "available", IsIncremental: true, DataTimestamp: day(11)},
+	}
+
+	chains := backups.BuildChains(all)
+	th.AssertEquals(t, 3, len(chains))
+
+	ids := func(c backups.Chain) []string {
+		var s []string
+		for _, b := range c.Backups {
+			s = append(s, b.ID)
+		}
+		return s
+	}
+
+	// Chains are ordered by volume ID, so the other volume comes first. Its
+	// incremental backup predates its full backup, so the full backup it
+	// depends on is unknown.
+	th.AssertEquals(t, otherVolumeID, chains[0].VolumeID)
+	th.CheckDeepEquals(t, []string{"orphan-incr"}, ids(chains[0]))
+	_, ok := chains[0].Full()
+	th.AssertEquals(t, false, ok)
+	th.CheckDeepEquals(t, []string{"other-full"}, ids(chains[1]))
+
+	th.AssertEquals(t, chainVolumeID, chains[2].VolumeID)
+	th.CheckDeepEquals(t, []string{fullBackupID, incr1BackupID, incr2BackupID}, ids(chains[2]))
+	full, ok := chains[2].Full()
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, fullBackupID, full.ID)
+	th.AssertEquals(t, incr2BackupID, chains[2].Latest().ID)
+
+	parent, ok := chains[2].ParentID(incr2BackupID)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, incr1BackupID, parent)
+	_, ok = chains[2].ParentID(fullBackupID)
+	th.AssertEquals(t, false, ok)
+}
+
+func TestBuildChainsParent(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2020, 3, d, 10, 0, 0, 0, time.UTC) }
+	ids := func(c backups.Chain) []string {
+		var s []string
+		for _, b := range c.Backups {
+			s = append(s, b.ID)
+		}
+		return s
+	}
+
+	// The parent of an incremental backup is its ParentID, when set, rather
+	// than the most recent backup.
+	chains := backups.BuildChains([]backups.Backup{
+		{ID: "full-1", VolumeID: chainVolumeID, Status: "available", DataTimestamp: day(11)},
+		{ID: "full-2", VolumeID: chainVolumeID, Status: "available", DataTimestamp: day(12)},
+		{ID: "incr", VolumeID: chainVolumeID, Status: "available", IsIncremental: true, ParentID: "full-1", DataTimestamp: day(13)},
+	})
+	th.AssertEquals(t, 2, len(chains))
+	th.CheckDeepEquals(t, []string{"full-1", "incr"}, ids(chains[0]))
+	th.CheckDeepEquals(t, []string{"full-2"}, ids(chains[1]))
+
+	// Without ParentID, the most recent backup which has dependent backups is
+	// preferred over a more recent one which has none.
+	chains = backups.BuildChains([]backups.Backup{
+		{ID: "full-1", VolumeID: chainVolumeID, Status: "available", HasDependentBackups: true, DataTimestamp: day(11)},
+		{ID: "full-2", VolumeID: chainVolumeID, Status: "available", DataTimestamp: day(12)},
+		{ID: "incr", VolumeID: chainVolumeID, Status: "available", IsIncremental: true, DataTimestamp: day(13)},
+	})
+	th.AssertEquals(t, 2, len(chains))
+	th.CheckDeepEquals(t, []string{"full-1", "incr"}, ids(chains[0]))
+	th.CheckDeepEquals(t, []string{"full-2"}, ids(chains[1]))
+}
+
+func TestDiscoverChains(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockChainListDetailResponse(t, fakeServer)
+
+	chains, err := backups.DiscoverChains(context.TODO(), client.ServiceClient(fakeServer), chainVolumeID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(chains))
+
+	th.AssertEquals(t, 3, len(chains[0].Backups))
+	th.AssertEquals(t, fullBackupID, chains[0].Backups[0].ID)
+	th.AssertEquals(t, incr1BackupID, chains[0].Backups[1].ID)
+	th.AssertEquals(t, incr2BackupID, chains[0].Backups[2].ID)
+
+	th.AssertEquals(t, 1, len(chains[1].Backups))
+	th.AssertEquals(t, full2BackupID, chains[1].Backups[0].ID)
+
+	var order []string
+	for _, b := range chains[0].DeletionOrder() {
+		order = append(order, b.ID)
+	}
+	th.CheckDeepEquals(t, []string{incr2BackupID, incr1BackupID, fullBackupID}, order)
+}
+
+func TestDeleteChain(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	deleted := MockChainDeleteResponse(t, fakeServer, map[string]string{
+		fullBackupID:  "",
+		incr1BackupID: fullBackupID,
+		incr2BackupID: incr1BackupID,
+	})
+
+	chain := backups.Chain{
+		VolumeID: chainVolumeID,
+		Backups: []backups.Backup{
+			{ID: fullBackupID},
+			{ID: incr1BackupID, IsIncremental: true},
+			{ID: incr2BackupID, IsIncremental: true},
+		},
+	}
+
+	err := backups.DeleteChain(context.TODO(), client.ServiceClient(fakeServer), chain)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{incr2BackupID, incr1BackupID, fullBackupID}, *deleted)
+
+	// Deleting the chain again is a no-op, as every backup is already gone.
+	err = backups.DeleteChain(context.TODO(), client.ServiceClient(fakeServer), chain)
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteChainWithDependentBackups(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	chain := backups.Chain{
+		VolumeID: chainVolumeID,
+		Backups: []backups.Backup{
+			{ID: fullBackupID, HasDependentBackups: true},
+			{ID: incr1BackupID, IsIncremental: true, HasDependentBackups: true},
+		},
+	}
+
+	// No backup is deleted, as the one depending on the newest backup is not
+	// part of the chain.
+	err := backups.DeleteChain(context.TODO(), client.ServiceClient(fakeServer), chain)
+	var invalid gophercloud.ErrInvalidInput
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected an ErrInvalidInput, got %v", err)
+	}
+	th.AssertEquals(t, incr1BackupID, invalid.Value)
+}
+
+func TestExportImportChain(t *testing.T) {
+	sourceRegion := th.SetupHTTP()
+	defer sourceRegion.Teardown()
+	destinationRegion := th.SetupHTTP()
+	defer destinationRegion.Teardown()
+
+	MockExportRecordResponses(t, sourceRegion, map[string]string{
+		fullBackupID:  "",
+		incr1BackupID: fullBackupID,
+	})
+	imported := MockImportRecordResponses(t, destinationRegion)
+
+	chain := backups.Chain{
+		VolumeID: chainVolumeID,
+		Backups: []backups.Backup{
+			{ID: fullBackupID},
+			{ID: incr1BackupID, IsIncremental: true},
+		},
+	}
+
+	actual, err := backups.ExportImportChain(context.TODO(), client.ServiceClient(sourceRegion), client.ServiceClient(destinationRegion), chain)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []backups.ImportResponse{{ID: fullBackupID}, {ID: incr1BackupID}}, actual)
+	th.CheckDeepEquals(t, []string{fullBackupID, incr1BackupID}, *imported)
+}
+
+func TestExportImportChainMissingParent(t *testing.T) {
+	sourceRegion := th.SetupHTTP()
+	defer sourceRegion.Teardown()
+	destinationRegion := th.SetupHTTP()
+	defer destinationRegion.Teardown()
+
+	MockExportRecordResponses(t, sourceRegion, map[string]string{
+		incr1BackupID: fullBackupID,
+	})
+	MockImportRecordResponses(t, destinationRegion)
+
+	chain := backups.Chain{
+		VolumeID: chainVolumeID,
+		Backups:  []backups.Backup{{ID: incr1BackupID, IsIncremental: true}},
+	}
+
+	actual, err := backups.ExportImportChain(context.TODO(), client.ServiceClient(sourceRegion), client.ServiceClient(destinationRegion), chain)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusBadRequest))
+	th.AssertEquals(t, 0, len(actual))
+}
@@ -0,0 +1,252 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// WaitForStatus will continually poll the resource, checking for a particular status.
+func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// waitForDelete will continually poll the resource until it no longer exists.
+func waitForDelete(ctx context.Context, c *gophercloud.ServiceClient, id string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		_, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+
+		return false, nil
+	})
+}
+
+// Chain is an incremental backup chain of a volume: a full backup followed by
+// the incremental backups depending on it.
+type Chain struct {
+	// VolumeID is the ID of the volume the backups of the chain were taken of.
+	VolumeID string
+
+	// Backups are the backups of the chain, oldest first. Every incremental
+	// backup depends on the backup before it. The first backup is the full
+	// backup, unless it was not part of the backups the chain was built from.
+	Backups []Backup
+}
+
+// Full returns the full backup the chain is based on, if it is known.
+func (c Chain) Full() (Backup, bool) {
+	if len(c.Backups) == 0 || c.Backups[0].IsIncremental {
+		return Backup{}, false
+	}
+	return c.Backups[0], true
+}
+
+// Latest returns the most recent backup of the chain.
+func (c Chain) Latest() Backup {
+	if len(c.Backups) == 0 {
+		return Backup{}
+	}
+	return c.Backups[len(c.Backups)-1]
+}
+
+// ParentID returns the ID of the backup the given backup of the chain depends
+// on. It returns false for the first backup of the chain, and for backups
+// which are not part of it.
+func (c Chain) ParentID(id string) (string, bool) {
+	for i, b := range c.Backups {
+		if b.ID == id {
+			if i == 0 {
+				return "", false
+			}
+			return c.Backups[i-1].ID, true
+		}
+	}
+	return "", false
+}
+
+// DeletionOrder returns the backups of the chain in the order they must be
+// deleted in: the Block Storage service refuses to delete a backup as long as
+// other backups depend on it, so the newest backup comes first.
+func (c Chain) DeletionOrder() []Backup {
+	ordered := make([]Backup, len(c.Backups))
+	for i, b := range c.Backups {
+		ordered[len(c.Backups)-1-i] = b
+	}
+	return ordered
+}
+
+// backupTime returns the point in time the data of a backup refers to. The
+// Block Storage service uses it to pick the parent of an incremental backup.
+func backupTime(b Backup) time.Time {
+	if b.DataTimestamp.IsZero() {
+		return b.CreatedAt
+	}
+	return b.DataTimestamp
+}
+
+// BuildChains groups the given backups into incremental backup chains.
+//
+// The parent of an incremental backup is its ParentID, when set. Otherwise it
+// is inferred the same way the service picks the parent of a new incremental
+// backup: it is the most recent backup of the same volume. As a backup the
+// service reports without dependent backups can't be that parent, the most
+// recent one which has dependent backups and no known child yet is preferred,
+// so that the newest backup of a chain is the one to delete first. An
+// incremental backup whose parent is unknown, or is not the newest backup of
+// its chain, starts a new chain. Backups in the error status hold no data and
+// are not part of any chain. Chains are returned ordered by volume ID, then
+// oldest first.
+func BuildChains(backups []Backup) []Chain {
+	byVolume := make(map[string][]Backup)
+	var volumeIDs []string
+	for _, b := range backups {
+		if b.Status == "error" {
+			continue
+		}
+		if _, ok := byVolume[b.VolumeID]; !ok {
+			volumeIDs = append(volumeIDs, b.VolumeID)
+		}
+		byVolume[b.VolumeID] = append(byVolume[b.VolumeID], b)
+	}
+	sort.Strings(volumeIDs)
+
+	var chains []Chain
+	for _, volumeID := range volumeIDs {
+		volumeBackups := byVolume[volumeID]
+		sort.SliceStable(volumeBackups, func(i, j int) bool {
+			return backupTime(volumeBackups[i]).Before(backupTime(volumeBackups[j]))
+		})
+
+		// chainOf is the index in chains of the chain of every backup.
+		chainOf := make(map[string]int)
+		isLatest := func(id string) bool {
+			i, ok := chainOf[id]
+			return ok && chains[i].Latest().ID == id
+		}
+		for i, b := range volumeBackups {
+			parentID := b.ParentID
+			if parentID == "" && b.IsIncremental && i > 0 {
+				parentID = volumeBackups[i-1].ID
+				for j := i - 1; j >= 0; j-- {
+					if volumeBackups[j].HasDependentBackups && isLatest(volumeBackups[j].ID) {
+						parentID = volumeBackups[j].ID
+						break
+					}
+				}
+			}
+
+			if b.IsIncremental && isLatest(parentID) {
+				chainOf[b.ID] = chainOf[parentID]
+			} else {
+				chains = append(chains, Chain{VolumeID: volumeID})
+				chainOf[b.ID] = len(chains) - 1
+			}
+			chains[chainOf[b.ID]].Backups = append(chains[chainOf[b.ID]].Backups, b)
+		}
+	}
+
+	return chains
+}
+
+// DiscoverChains lists the backups of the given volume and groups them into
+// incremental backup chains, oldest first. See BuildChains for how the chains
+// are inferred.
+func DiscoverChains(ctx context.Context, client *gophercloud.ServiceClient, volumeID string) ([]Chain, error) {
+	allPages, err := ListDetail(client, ListDetailOpts{VolumeID: volumeID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allBackups, err := ExtractBackups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildChains(allBackups), nil
+}
+
+// DeleteChain deletes the backups of the chain, newest first. Each backup is
+// only deleted once the one depending on it is gone. Backups which no longer
+// exist are skipped. Nothing is deleted when the newest backup of the chain
+// has dependent backups, as they are not part of the chain.
+func DeleteChain(ctx context.Context, client *gophercloud.ServiceClient, chain Chain) error {
+	if latest := chain.Latest(); latest.HasDependentBackups {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "backups.Chain.Backups"
+		err.Value = latest.ID
+		err.Info = fmt.Sprintf("backup %s has dependent backups which are not part of the chain", latest.ID)
+		return err
+	}
+
+	for _, b := range chain.DeletionOrder() {
+		err := Delete(ctx, client, b.ID).ExtractErr()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if err := waitForDelete(ctx, client, b.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportImport exports the record of a backup from the source client and
+// imports it with the destination client, typically a client of another
+// region. The backup data itself is not copied: the backup service of the
+// destination must be able to reach the storage the backup was written to.
+// The imported backup keeps the ID of the exported one.
+func ExportImport(ctx context.Context, source, destination *gophercloud.ServiceClient, id string) (*ImportResponse, error) {
+	record, err := Export(ctx, source, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return Import(ctx, destination, ImportOpts(*record)).Extract()
+}
+
+// ExportImportChain exports and imports every backup of the chain, oldest
+// first, waiting for each imported backup to be available before importing
+// the backups which depend on it. The latest imported backup can then be
+// restored with RestoreFromBackup against the destination client.
+func ExportImportChain(ctx context.Context, source, destination *gophercloud.ServiceClient, chain Chain) ([]ImportResponse, error) {
+	imported := make([]ImportResponse, 0, len(chain.Backups))
+	for _, b := range chain.Backups {
+		r, err := ExportImport(ctx, source, destination, b.ID)
+		if err != nil {
+			return imported, err
+		}
+
+		if err := WaitForStatus(ctx, destination, r.ID, "available"); err != nil {
+			return imported, err
+		}
+
+		imported = append(imported, *r)
+	}
+
+	return imported, nil
+}
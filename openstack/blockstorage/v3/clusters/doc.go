@@ -0,0 +1,55 @@
+/*
+Package clusters provides information and interaction with the clusters of
+the OpenStack Block Storage service. A cluster groups the services of an
+active/active deployment which share the same backend configuration.
+
+NOTE: Requires at least microversion 3.7
+
+Example to list Clusters with details
+
+	client.Microversion = "3.7"
+
+	isUp := false
+	listOpts := clusters.ListOpts{
+		Binary: "cinder-volume",
+		IsUp:   &isUp,
+	}
+
+	allPages, err := clusters.ListDetail(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allClusters, err := clusters.ExtractClusters(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, cluster := range allClusters {
+		fmt.Printf("%+v\n", cluster)
+	}
+
+Example to get a Cluster
+
+	client.Microversion = "3.7"
+
+	cluster, err := clusters.Get(context.TODO(), client, "cluster@lvmdriver-1", clusters.GetOpts{Binary: "cinder-volume"}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to disable a Cluster
+
+	client.Microversion = "3.7"
+
+	disableOpts := clusters.DisableOpts{
+		Name:           "cluster@lvmdriver-1",
+		DisabledReason: "maintenance",
+	}
+
+	cluster, err := clusters.Disable(context.TODO(), client, disableOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package clusters
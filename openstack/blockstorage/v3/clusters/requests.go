@@ -0,0 +1,190 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToClusterListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Clusters.
+type ListOpts struct {
+	// Filter the cluster list result by cluster name.
+	Name string `q:"name"`
+
+	// Filter the cluster list result by binary name of the clustered services.
+	Binary string `q:"binary"`
+
+	// Filter the cluster list result by whether the cluster is up.
+	IsUp *bool `q:"is_up"`
+
+	// Filter the cluster list result by whether the cluster is disabled.
+	Disabled *bool `q:"disabled"`
+
+	// Filter the cluster list result by the number of hosts.
+	NumHosts *int `q:"num_hosts"`
+
+	// Filter the cluster list result by the number of down hosts.
+	NumDownHosts *int `q:"num_down_hosts"`
+
+	// Filter the cluster list result by replication status.
+	ReplicationStatus string `q:"replication_status"`
+
+	// Filter the cluster list result by whether the cluster is frozen.
+	Frozen *bool `q:"frozen"`
+
+	// Filter the cluster list result by the ID of the active storage backend.
+	ActiveBackendID string `q:"active_backend_id"`
+}
+
+// ToClusterListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToClusterListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List makes a request against the API to list clusters. Only the name,
+// binary, state and status fields of the Cluster are populated.
+// Client must have Microversion set; minimum supported microversion for List is 3.7.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail makes a request against the API to list clusters with all of
+// their details.
+// Client must have Microversion set; minimum supported microversion for ListDetail is 3.7.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToClusterListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ClusterPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to the Get
+// request.
+type GetOptsBuilder interface {
+	ToClusterGetQuery() (string, error)
+}
+
+// GetOpts holds options for retrieving a Cluster.
+type GetOpts struct {
+	// The binary name of the clustered services. Cinder defaults to
+	// cinder-volume when it is not set.
+	Binary string `q:"binary"`
+}
+
+// ToClusterGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToClusterGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get retrieves the Cluster with the provided name.
+// Client must have Microversion set; minimum supported microversion for Get is 3.7.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, name string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, name)
+	if opts != nil {
+		query, err := opts.ToClusterGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// EnableOptsBuilder allows extensions to add additional parameters to the
+// Enable request.
+type EnableOptsBuilder interface {
+	ToClusterEnableMap() (map[string]any, error)
+}
+
+// EnableOpts contains options for enabling a Cluster.
+type EnableOpts struct {
+	// The name of the cluster.
+	Name string `json:"name" required:"true"`
+
+	// The binary name of the clustered services. Cinder defaults to
+	// cinder-volume when it is not set.
+	Binary string `json:"binary,omitempty"`
+}
+
+// ToClusterEnableMap assembles a request body based on the contents of an
+// EnableOpts.
+func (opts EnableOpts) ToClusterEnableMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Enable enables scheduling on the given Cluster.
+// Client must have Microversion set; minimum supported microversion for Enable is 3.7.
+func Enable(ctx context.Context, client *gophercloud.ServiceClient, opts EnableOptsBuilder) (r EnableResult) {
+	b, err := opts.ToClusterEnableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, enableURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DisableOptsBuilder allows extensions to add additional parameters to the
+// Disable request.
+type DisableOptsBuilder interface {
+	ToClusterDisableMap() (map[string]any, error)
+}
+
+// DisableOpts contains options for disabling a Cluster.
+type DisableOpts struct {
+	// The name of the cluster.
+	Name string `json:"name" required:"true"`
+
+	// The binary name of the clustered services. Cinder defaults to
+	// cinder-volume when it is not set.
+	Binary string `json:"binary,omitempty"`
+
+	// The reason for disabling the cluster.
+	DisabledReason string `json:"disabled_reason,omitempty"`
+}
+
+// ToClusterDisableMap assembles a request body based on the contents of a
+// DisableOpts.
+func (opts DisableOpts) ToClusterDisableMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Disable disables scheduling on the given Cluster.
+// Client must have Microversion set; minimum supported microversion for Disable is 3.7.
+func Disable(ctx context.Context, client *gophercloud.ServiceClient, opts DisableOptsBuilder) (r DisableResult) {
+	b, err := opts.ToClusterDisableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, disableURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,130 @@
+package clusters
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Cluster represents a group of Block Storage services sharing the same
+// configuration in an active/active deployment.
+type Cluster struct {
+	// The name of the cluster.
+	Name string `json:"name"`
+
+	// The binary name of the clustered services.
+	Binary string `json:"binary"`
+
+	// The state of the cluster. One of up or down.
+	State string `json:"state"`
+
+	// The status of the cluster. One of enabled or disabled.
+	Status string `json:"status"`
+
+	// The following fields are only returned by ListDetail, Get, Enable
+	// and Disable.
+
+	// The number of hosts in the cluster.
+	NumHosts int `json:"num_hosts"`
+
+	// The number of down hosts in the cluster.
+	NumDownHosts int `json:"num_down_hosts"`
+
+	// The date and time stamp of the last heartbeat of the cluster.
+	LastHeartbeat time.Time `json:"-"`
+
+	// The date and time stamp when the cluster was created.
+	CreatedAt time.Time `json:"-"`
+
+	// The date and time stamp when the cluster was last updated.
+	UpdatedAt time.Time `json:"-"`
+
+	// The reason for disabling the cluster.
+	DisabledReason string `json:"disabled_reason"`
+
+	// The cluster replication status. Only in cinder-volume clusters.
+	ReplicationStatus string `json:"replication_status"`
+
+	// Whether the cluster is frozen. Only in cinder-volume clusters.
+	Frozen bool `json:"frozen"`
+
+	// The ID of active storage backend. Only in cinder-volume clusters.
+	ActiveBackendID string `json:"active_backend_id"`
+}
+
+// UnmarshalJSON to override default
+func (r *Cluster) UnmarshalJSON(b []byte) error {
+	type tmp Cluster
+	var s struct {
+		tmp
+		LastHeartbeat gophercloud.JSONRFC3339MilliNoZ `json:"last_heartbeat"`
+		CreatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Cluster(s.tmp)
+
+	r.LastHeartbeat = time.Time(s.LastHeartbeat)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ClusterPage represents a single page of all Clusters from a List request.
+type ClusterPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a page of Clusters contains any results.
+func (page ClusterPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	clusters, err := ExtractClusters(page)
+	return len(clusters) == 0, err
+}
+
+// ExtractClusters takes a pagination.Page and returns the list of Clusters
+// from it.
+func ExtractClusters(r pagination.Page) ([]Cluster, error) {
+	var s struct {
+		Clusters []Cluster `json:"clusters"`
+	}
+	err := (r.(ClusterPage)).ExtractInto(&s)
+	return s.Clusters, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Cluster object out of the commonResult object.
+func (r commonResult) Extract() (*Cluster, error) {
+	var s struct {
+		Cluster *Cluster `json:"cluster"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Cluster, err
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// EnableResult contains the response body and error from an Enable request.
+type EnableResult struct {
+	commonResult
+}
+
+// DisableResult contains the response body and error from a Disable request.
+type DisableResult struct {
+	commonResult
+}
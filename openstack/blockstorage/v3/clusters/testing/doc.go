@@ -0,0 +1,2 @@
+// clusters unit tests
+package testing
@@ -0,0 +1,188 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/clusters"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "clusters": [
+        {
+            "name": "cluster@lvmdriver-1",
+            "binary": "cinder-volume",
+            "state": "up",
+            "status": "enabled"
+        },
+        {
+            "name": "cluster2@lvmdriver-1",
+            "binary": "cinder-volume",
+            "state": "down",
+            "status": "disabled"
+        }
+    ]
+}
+`
+
+// ListDetailOutput is a sample response to a ListDetail call.
+const ListDetailOutput = `
+{
+    "clusters": [
+        {
+            "name": "cluster@lvmdriver-1",
+            "binary": "cinder-volume",
+            "state": "up",
+            "status": "enabled",
+            "num_hosts": 2,
+            "num_down_hosts": 0,
+            "last_heartbeat": "2016-07-05T11:24:28.000000",
+            "created_at": "2016-06-27T11:24:28.000000",
+            "updated_at": "2016-07-05T11:24:28.000000",
+            "disabled_reason": null,
+            "replication_status": "enabled",
+            "frozen": false,
+            "active_backend_id": null
+        }
+    ]
+}
+`
+
+// ClusterOutput is a sample response to a Get, Enable or Disable call.
+const ClusterOutput = `
+{
+    "cluster": {
+        "name": "cluster@lvmdriver-1",
+        "binary": "cinder-volume",
+        "state": "up",
+        "status": "disabled",
+        "num_hosts": 2,
+        "num_down_hosts": 0,
+        "last_heartbeat": "2016-07-05T11:24:28.000000",
+        "created_at": "2016-06-27T11:24:28.000000",
+        "updated_at": "2016-07-05T11:24:28.000000",
+        "disabled_reason": "maintenance",
+        "replication_status": "enabled",
+        "frozen": false,
+        "active_backend_id": "replication1"
+    }
+}
+`
+
+// FirstClusterSummary is the first cluster in the List request.
+var FirstClusterSummary = clusters.Cluster{
+	Name:   "cluster@lvmdriver-1",
+	Binary: "cinder-volume",
+	State:  "up",
+	Status: "enabled",
+}
+
+// SecondClusterSummary is the second cluster in the List request.
+var SecondClusterSummary = clusters.Cluster{
+	Name:   "cluster2@lvmdriver-1",
+	Binary: "cinder-volume",
+	State:  "down",
+	Status: "disabled",
+}
+
+// ClusterDetail is the cluster in the ListDetail request.
+var ClusterDetail = clusters.Cluster{
+	Name:              "cluster@lvmdriver-1",
+	Binary:            "cinder-volume",
+	State:             "up",
+	Status:            "enabled",
+	NumHosts:          2,
+	NumDownHosts:      0,
+	LastHeartbeat:     time.Date(2016, 7, 5, 11, 24, 28, 0, time.UTC),
+	CreatedAt:         time.Date(2016, 6, 27, 11, 24, 28, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 7, 5, 11, 24, 28, 0, time.UTC),
+	ReplicationStatus: "enabled",
+}
+
+// DisabledCluster is the cluster returned by the Get, Enable and Disable
+// requests.
+var DisabledCluster = clusters.Cluster{
+	Name:              "cluster@lvmdriver-1",
+	Binary:            "cinder-volume",
+	State:             "up",
+	Status:            "disabled",
+	NumHosts:          2,
+	NumDownHosts:      0,
+	LastHeartbeat:     time.Date(2016, 7, 5, 11, 24, 28, 0, time.UTC),
+	CreatedAt:         time.Date(2016, 6, 27, 11, 24, 28, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 7, 5, 11, 24, 28, 0, time.UTC),
+	DisabledReason:    "maintenance",
+	ReplicationStatus: "enabled",
+	ActiveBackendID:   "replication1",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"binary": "cinder-volume"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"is_up": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListDetailOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/cluster@lvmdriver-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"binary": "cinder-volume"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ClusterOutput)
+	})
+}
+
+// HandleEnableSuccessfully configures the test server to respond to an
+// Enable request.
+func HandleEnableSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/enable", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "cluster@lvmdriver-1", "binary": "cinder-volume"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ClusterOutput)
+	})
+}
+
+// HandleDisableSuccessfully configures the test server to respond to a
+// Disable request.
+func HandleDisableSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/disable", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "cluster@lvmdriver-1", "disabled_reason": "maintenance"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ClusterOutput)
+	})
+}
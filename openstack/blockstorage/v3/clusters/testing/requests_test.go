@@ -0,0 +1,84 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/clusters"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	pages := 0
+	err := clusters.List(client.ServiceClient(fakeServer), clusters.ListOpts{Binary: "cinder-volume"}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := clusters.ExtractClusters(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []clusters.Cluster{FirstClusterSummary, SecondClusterSummary}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	isUp := true
+	allPages, err := clusters.ListDetail(client.ServiceClient(fakeServer), clusters.ListOpts{IsUp: &isUp}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := clusters.ExtractClusters(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []clusters.Cluster{ClusterDetail}, actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := clusters.Get(context.TODO(), client.ServiceClient(fakeServer), "cluster@lvmdriver-1", clusters.GetOpts{Binary: "cinder-volume"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DisabledCluster, actual)
+}
+
+func TestEnable(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleEnableSuccessfully(t, fakeServer)
+
+	opts := clusters.EnableOpts{
+		Name:   "cluster@lvmdriver-1",
+		Binary: "cinder-volume",
+	}
+	actual, err := clusters.Enable(context.TODO(), client.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DisabledCluster, actual)
+}
+
+func TestDisable(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDisableSuccessfully(t, fakeServer)
+
+	opts := clusters.DisableOpts{
+		Name:           "cluster@lvmdriver-1",
+		DisabledReason: "maintenance",
+	}
+	actual, err := clusters.Disable(context.TODO(), client.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DisabledCluster, actual)
+}
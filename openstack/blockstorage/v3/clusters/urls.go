@@ -0,0 +1,23 @@
+package clusters
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("clusters")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("clusters", "detail")
+}
+
+func getURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL("clusters", name)
+}
+
+func enableURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("clusters", "enable")
+}
+
+func disableURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("clusters", "disable")
+}
@@ -0,0 +1,47 @@
+/*
+Package defaulttypes provides information and interaction with the
+per-project default volume types of the OpenStack Block Storage service.
+
+NOTE: Requires at least microversion 3.62
+
+Example to set the default volume type of a project
+
+	client.Microversion = "3.62"
+
+	setOpts := defaulttypes.SetOpts{
+		VolumeType: "lvmdriver-1",
+	}
+
+	defaultType, err := defaulttypes.Set(context.TODO(), client, "c0e59f8d7f5a4b0a9f5ae5f6f41b5d0e", setOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to list the default volume types of all projects
+
+	client.Microversion = "3.62"
+
+	allPages, err := defaulttypes.List(client).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allDefaultTypes, err := defaulttypes.ExtractDefaultTypes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, defaultType := range allDefaultTypes {
+		fmt.Printf("%+v\n", defaultType)
+	}
+
+Example to unset the default volume type of a project
+
+	client.Microversion = "3.62"
+
+	err := defaulttypes.Delete(context.TODO(), client, "c0e59f8d7f5a4b0a9f5ae5f6f41b5d0e").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package defaulttypes
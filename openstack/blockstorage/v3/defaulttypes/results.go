@@ -0,0 +1,75 @@
+package defaulttypes
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// DefaultType associates a project with the volume type used when a volume
+// is created without an explicit type.
+type DefaultType struct {
+	// The UUID of the project.
+	ProjectID string `json:"project_id"`
+	// The UUID of the volume type.
+	VolumeTypeID string `json:"volume_type_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the DefaultType object out of the commonResult object.
+func (r commonResult) Extract() (*DefaultType, error) {
+	var s DefaultType
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a default type struct.
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "default_type")
+}
+
+// SetResult contains the response body and error from a Set request.
+type SetResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// DefaultTypePage is a single page of DefaultType results.
+type DefaultTypePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a DefaultTypePage contains no DefaultTypes.
+func (r DefaultTypePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	defaultTypes, err := ExtractDefaultTypes(r)
+	return len(defaultTypes) == 0, err
+}
+
+// ExtractDefaultTypes extracts and returns DefaultTypes. It is used while
+// iterating over a defaulttypes.List call.
+func ExtractDefaultTypes(r pagination.Page) ([]DefaultType, error) {
+	var s []DefaultType
+	err := ExtractDefaultTypesInto(r, &s)
+	return s, err
+}
+
+// ExtractDefaultTypesInto similar to ExtractInto but operates on a `list` of
+// default types.
+func ExtractDefaultTypesInto(r pagination.Page, v any) error {
+	return r.(DefaultTypePage).ExtractIntoSlicePtr(v, "default_types")
+}
@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	fake "github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const projectID = "c0e59f8d7f5a4b0a9f5ae5f6f41b5d0e"
+
+const defaultTypeBody = `
+{
+    "default_type": {
+        "project_id": "c0e59f8d7f5a4b0a9f5ae5f6f41b5d0e",
+        "volume_type_id": "6a65bc1b-197b-45bf-8056-9f3ce3b7b2c5"
+    }
+}
+`
+
+func MockSetResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"default_type": {"volume_type": "lvmdriver-1"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, defaultTypeBody)
+	})
+}
+
+func MockGetResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, defaultTypeBody)
+	})
+}
+
+func MockListResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "default_types": [
+        {
+            "project_id": "c0e59f8d7f5a4b0a9f5ae5f6f41b5d0e",
+            "volume_type_id": "6a65bc1b-197b-45bf-8056-9f3ce3b7b2c5"
+        },
+        {
+            "project_id": "4b1e2e7f0c9d4f0b8a3e5c6d7e8f9a0b",
+            "volume_type_id": "e1d5b3a4-9c2f-4f8e-a7b6-1c0d2e3f4a5b"
+        }
+    ]
+}
+`)
+	})
+}
+
+func MockDeleteResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
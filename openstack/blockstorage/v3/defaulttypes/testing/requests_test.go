@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/defaulttypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var expected = defaulttypes.DefaultType{
+	ProjectID:    projectID,
+	VolumeTypeID: "6a65bc1b-197b-45bf-8056-9f3ce3b7b2c5",
+}
+
+func TestSet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	MockSetResponse(t, fakeServer)
+
+	actual, err := defaulttypes.Set(context.TODO(), client.ServiceClient(fakeServer), projectID, defaulttypes.SetOpts{VolumeType: "lvmdriver-1"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestSetRequiresVolumeType(t *testing.T) {
+	res := defaulttypes.Set(context.TODO(), nil, projectID, defaulttypes.SetOpts{})
+	if res.Err == nil {
+		t.Fatal("expected error for missing volume type")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	MockGetResponse(t, fakeServer)
+
+	actual, err := defaulttypes.Get(context.TODO(), client.ServiceClient(fakeServer), projectID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	MockListResponse(t, fakeServer)
+
+	allPages, err := defaulttypes.List(client.ServiceClient(fakeServer)).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := defaulttypes.ExtractDefaultTypes(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(actual))
+	th.CheckDeepEquals(t, expected, actual[0])
+	th.AssertEquals(t, "4b1e2e7f0c9d4f0b8a3e5c6d7e8f9a0b", actual[1].ProjectID)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	MockDeleteResponse(t, fakeServer)
+
+	err := defaulttypes.Delete(context.TODO(), client.ServiceClient(fakeServer), projectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
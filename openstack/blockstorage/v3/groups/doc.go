@@ -0,0 +1,121 @@
+/*
+Package groups provides information and interaction with generic volume groups
+in the OpenStack Block Storage service. A group is a collection of volumes that
+can be snapshotted together, for example to obtain crash-consistent snapshots
+of a multi-volume application.
+
+Groups require the client to be set to microversion 3.13 or later. Creating a
+group from a source requires 3.14, resetting the status requires 3.20 and the
+replication actions require 3.38.
+
+Example to list Groups
+
+	client.Microversion = "3.13"
+
+	allPages, err := groups.ListDetail(client, groups.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Println(group)
+	}
+
+Example to create a Group
+
+	client.Microversion = "3.13"
+
+	createOpts := groups.CreateOpts{
+		Name:        "db-volumes",
+		GroupType:   "29514915-5208-46ab-9ece-1cc4688ad0c1",
+		VolumeTypes: []string{"4e9e6d23-eed0-426d-b90a-28f87a94b6fe"},
+	}
+
+	group, err := groups.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = groups.WaitForStatus(context.TODO(), client, group.ID, "available")
+	if err != nil {
+		panic(err)
+	}
+
+Example to create a Group from a Group Snapshot
+
+	client.Microversion = "3.14"
+
+	createOpts := groups.CreateFromSrcOpts{
+		Name:            "db-volumes-restored",
+		GroupSnapshotID: "d1aff55d-9a79-4a5a-bb5d-62b7a0ef8b59",
+	}
+
+	group, err := groups.CreateFromSrc(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to add Volumes to and remove Volumes from a Group
+
+	client.Microversion = "3.13"
+
+	updateOpts := groups.UpdateOpts{
+		AddVolumes:    []string{"6edbc2f4-1507-44f8-ac0d-eed1d2608d38"},
+		RemoveVolumes: []string{"a1e5b1b4-7b5c-4f5f-9a7e-8e1f6b1c2d3e"},
+	}
+
+	err := groups.Update(context.TODO(), client, "group-id", updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group and its Volumes
+
+	client.Microversion = "3.13"
+
+	deleteOpts := groups.DeleteOpts{
+		DeleteVolumes: true,
+	}
+
+	err := groups.Delete(context.TODO(), client, "group-id", deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to reset the status of a Group
+
+	client.Microversion = "3.20"
+
+	resetOpts := groups.ResetStatusOpts{
+		Status: "available",
+	}
+
+	err := groups.ResetStatus(context.TODO(), client, "group-id", resetOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to enable replication and fail over a Group
+
+	client.Microversion = "3.38"
+
+	err := groups.EnableReplication(context.TODO(), client, "group-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	failoverOpts := groups.FailoverReplicationOpts{
+		SecondaryBackendID: "vendor-id-1",
+	}
+
+	err = groups.FailoverReplication(context.TODO(), client, "group-id", failoverOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
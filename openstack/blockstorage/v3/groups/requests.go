@@ -0,0 +1,375 @@
+package groups
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToGroupCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains options for creating a Group. This object is passed to
+// the groups.Create function. For more information about these parameters,
+// see the Group object.
+type CreateOpts struct {
+	// GroupType is the ID or name of the group type.
+	GroupType string `json:"group_type" required:"true"`
+	// VolumeTypes is a list of volume type IDs or names that the group
+	// supports.
+	VolumeTypes []string `json:"volume_types" required:"true"`
+	// The group name
+	Name string `json:"name,omitempty"`
+	// The group description
+	Description string `json:"description,omitempty"`
+	// The availability zone
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToGroupCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToGroupCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "group")
+}
+
+// Create will create a new Group based on the values in CreateOpts. To extract
+// the Group object from the response, call the Extract method on the
+// CreateResult.
+// Client must have Microversion set; minimum supported microversion for Create is 3.13.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateFromSrcOptsBuilder allows extensions to add additional parameters to
+// the CreateFromSrc request.
+type CreateFromSrcOptsBuilder interface {
+	ToGroupCreateFromSrcMap() (map[string]any, error)
+}
+
+// CreateFromSrcOpts contains options for creating a Group from a group
+// snapshot or from another group. Exactly one of GroupSnapshotID and
+// SourceGroupID must be set.
+type CreateFromSrcOpts struct {
+	// GroupSnapshotID is the ID of the group snapshot to create the group from.
+	GroupSnapshotID string `json:"group_snapshot_id,omitempty" xor:"SourceGroupID"`
+	// SourceGroupID is the ID of the group to clone.
+	SourceGroupID string `json:"source_group_id,omitempty" xor:"GroupSnapshotID"`
+	// The group name
+	Name string `json:"name,omitempty"`
+	// The group description
+	Description string `json:"description,omitempty"`
+}
+
+// ToGroupCreateFromSrcMap assembles a request body based on the contents of a
+// CreateFromSrcOpts.
+func (opts CreateFromSrcOpts) ToGroupCreateFromSrcMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "create-from-src")
+}
+
+// CreateFromSrc will create a new Group from a group snapshot or from an
+// existing group. To extract the Group object from the response, call the
+// Extract method on the CreateResult.
+// Client must have Microversion set; minimum supported microversion for CreateFromSrc is 3.14.
+func CreateFromSrc(ctx context.Context, client *gophercloud.ServiceClient, opts CreateFromSrcOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateFromSrcMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createFromSrcURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToGroupDeleteMap() (map[string]any, error)
+}
+
+// DeleteOpts contains options for deleting a Group. This object is passed to
+// the groups.Delete function.
+type DeleteOpts struct {
+	// DeleteVolumes deletes the volumes of the group as well. A group that
+	// still contains volumes can only be deleted if this is set.
+	DeleteVolumes bool `json:"delete-volumes"`
+}
+
+// ToGroupDeleteMap assembles a request body based on the contents of a
+// DeleteOpts.
+func (opts DeleteOpts) ToGroupDeleteMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "delete")
+}
+
+// Delete will delete the existing Group with the provided ID.
+// Client must have Microversion set; minimum supported microversion for Delete is 3.13.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	if opts == nil {
+		opts = DeleteOpts{}
+	}
+	b, err := opts.ToGroupDeleteMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the Group with the provided ID. To extract the Group object
+// from the response, call the Extract method on the GetResult.
+// Client must have Microversion set; minimum supported microversion for Get is 3.13.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Groups. It is passed to the groups.List
+// and groups.ListDetail functions.
+type ListOpts struct {
+	// AllTenants will retrieve groups of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// ListVolume will include the IDs of the volumes in each group.
+	// This is supported since 3.25 microversion.
+	ListVolume bool `q:"list_volume"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Groups optionally limited by the conditions provided in
+// ListOpts.
+// Client must have Microversion set; minimum supported microversion for List is 3.13.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListDetail returns Groups with additional details optionally limited by the
+// conditions provided in ListOpts.
+// Client must have Microversion set; minimum supported microversion for ListDetail is 3.13.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listDetailURL(client)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToGroupUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contain options for updating an existing Group. This object is
+// passed to the groups.Update function. For more information about the
+// parameters, see the Group object.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// AddVolumes is a list of volume IDs to add to the group.
+	AddVolumes []string `json:"-"`
+	// RemoveVolumes is a list of volume IDs to remove from the group.
+	RemoveVolumes []string `json:"-"`
+}
+
+// ToGroupUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToGroupUpdateMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "group")
+	if err != nil {
+		return nil, err
+	}
+
+	group := b["group"].(map[string]any)
+	if len(opts.AddVolumes) > 0 {
+		group["add_volumes"] = strings.Join(opts.AddVolumes, ",")
+	}
+	if len(opts.RemoveVolumes) > 0 {
+		group["remove_volumes"] = strings.Join(opts.RemoveVolumes, ",")
+	}
+
+	return b, nil
+}
+
+// Update will update the Group with provided information. It is also used to
+// add volumes to and remove volumes from a group. This operation does not
+// return a response body.
+// Client must have Microversion set; minimum supported microversion for Update is 3.13.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ResetStatusOptsBuilder allows extensions to add additional parameters to the
+// ResetStatus request.
+type ResetStatusOptsBuilder interface {
+	ToGroupResetStatusMap() (map[string]any, error)
+}
+
+// ResetStatusOpts contains options for resetting a Group status.
+type ResetStatusOpts struct {
+	// Status is a group status to reset to.
+	Status string `json:"status" required:"true"`
+}
+
+// ToGroupResetStatusMap assembles a request body based on the contents of a
+// ResetStatusOpts.
+func (opts ResetStatusOpts) ToGroupResetStatusMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "reset_status")
+}
+
+// ResetStatus will reset the existing group status. ResetStatusResult contains
+// only the error. To extract it, call the ExtractErr method on the
+// ResetStatusResult.
+// Client must have Microversion set; minimum supported microversion for ResetStatus is 3.20.
+func ResetStatus(ctx context.Context, client *gophercloud.ServiceClient, id string, opts ResetStatusOptsBuilder) (r ResetStatusResult) {
+	b, err := opts.ToGroupResetStatusMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// EnableReplication will enable replication for the group.
+// Client must have Microversion set; minimum supported microversion for EnableReplication is 3.38.
+func EnableReplication(ctx context.Context, client *gophercloud.ServiceClient, id string) (r EnableReplicationResult) {
+	b := map[string]any{"enable_replication": make(map[string]any)}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DisableReplication will disable replication for the group.
+// Client must have Microversion set; minimum supported microversion for DisableReplication is 3.38.
+func DisableReplication(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DisableReplicationResult) {
+	b := map[string]any{"disable_replication": make(map[string]any)}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// FailoverReplicationOptsBuilder allows extensions to add additional parameters
+// to the FailoverReplication request.
+type FailoverReplicationOptsBuilder interface {
+	ToGroupFailoverReplicationMap() (map[string]any, error)
+}
+
+// FailoverReplicationOpts contains options for failing over a replicated Group.
+type FailoverReplicationOpts struct {
+	// AllowAttachedVolume allows the failover of attached volumes.
+	AllowAttachedVolume bool `json:"allow_attached_volume,omitempty"`
+	// SecondaryBackendID is the ID of the backend to fail over to.
+	SecondaryBackendID string `json:"secondary_backend_id,omitempty"`
+}
+
+// ToGroupFailoverReplicationMap assembles a request body based on the contents
+// of a FailoverReplicationOpts.
+func (opts FailoverReplicationOpts) ToGroupFailoverReplicationMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "failover_replication")
+}
+
+// FailoverReplication will fail over a replicated group to its secondary
+// backend.
+// Client must have Microversion set; minimum supported microversion for FailoverReplication is 3.38.
+func FailoverReplication(ctx context.Context, client *gophercloud.ServiceClient, id string, opts FailoverReplicationOptsBuilder) (r FailoverReplicationResult) {
+	b, err := opts.ToGroupFailoverReplicationMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListReplicationTargets lists the replication targets of the group. To
+// extract the targets from the response, call the Extract method on the
+// ListReplicationTargetsResult.
+// Client must have Microversion set; minimum supported microversion for ListReplicationTargets is 3.38.
+func ListReplicationTargets(ctx context.Context, client *gophercloud.ServiceClient, id string) (r ListReplicationTargetsResult) {
+	b := map[string]any{"list_replication_targets": make(map[string]any)}
+	resp, err := client.Post(ctx, actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
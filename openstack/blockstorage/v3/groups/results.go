@@ -0,0 +1,205 @@
+package groups
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Group contains all the information associated with a generic volume group.
+type Group struct {
+	// Unique identifier for the group.
+	ID string `json:"id"`
+	// Current status of the group.
+	Status string `json:"status"`
+	// AvailabilityZone is which availability zone the group is in.
+	AvailabilityZone string `json:"availability_zone"`
+	// The date when this group was created.
+	CreatedAt time.Time `json:"-"`
+	// Human-readable display name for the group.
+	Name string `json:"name"`
+	// Human-readable description for the group.
+	Description string `json:"description"`
+	// GroupType is the ID of the group type of the group.
+	GroupType string `json:"group_type"`
+	// VolumeTypes is a list of IDs of the volume types supported by the group.
+	VolumeTypes []string `json:"volume_types"`
+	// Volumes is a list of IDs of the volumes in the group.
+	// This field is only populated when ListVolume is set on the request and
+	// is supported since 3.25 microversion.
+	Volumes []string `json:"volumes"`
+	// GroupSnapshotID is the ID of the group snapshot the group was created from.
+	GroupSnapshotID string `json:"group_snapshot_id"`
+	// SourceGroupID is the ID of the group the group was cloned from.
+	SourceGroupID string `json:"source_group_id"`
+	// ReplicationStatus is the status of group replication.
+	// This field is supported since 3.38 microversion.
+	ReplicationStatus string `json:"replication_status"`
+	// ProjectID is the ID of the project that owns the group.
+	// This field is supported since 3.58 microversion.
+	ProjectID string `json:"project_id"`
+}
+
+// UnmarshalJSON converts our JSON API response into our group struct.
+func (r *Group) UnmarshalJSON(b []byte) error {
+	type tmp Group
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Group(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+// GroupPage is a pagination.Pager that is returned from a call to the List function.
+type GroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a GroupPage contains no Groups.
+func (r GroupPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	groups, err := ExtractGroups(r)
+	return len(groups) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r GroupPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractGroups extracts and returns Groups. It is used while iterating over a groups.List call.
+func ExtractGroups(r pagination.Page) ([]Group, error) {
+	var s []Group
+	err := ExtractGroupsInto(r, &s)
+	return s, err
+}
+
+// ExtractGroupsInto similar to ExtractInto but operates on a `list` of groups
+func ExtractGroupsInto(r pagination.Page, v any) error {
+	return r.(GroupPage).ExtractIntoSlicePtr(v, "groups")
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Group object out of the commonResult object.
+func (r commonResult) Extract() (*Group, error) {
+	var s Group
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a group struct
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "group")
+}
+
+// CreateResult contains the response body and error from a Create or
+// CreateFromSrc request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult contains the response error from an Update request.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult contains the response error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ResetStatusResult contains the response error from a ResetStatus request.
+type ResetStatusResult struct {
+	gophercloud.ErrResult
+}
+
+// EnableReplicationResult contains the response error from an
+// EnableReplication request.
+type EnableReplicationResult struct {
+	gophercloud.ErrResult
+}
+
+// DisableReplicationResult contains the response error from a
+// DisableReplication request.
+type DisableReplicationResult struct {
+	gophercloud.ErrResult
+}
+
+// FailoverReplicationResult contains the response error from a
+// FailoverReplication request.
+type FailoverReplicationResult struct {
+	gophercloud.ErrResult
+}
+
+// ReplicationTarget represents a replication target of a group.
+type ReplicationTarget struct {
+	// BackendID is the ID of the replication target backend.
+	BackendID string `json:"backend_id"`
+	// Additional driver specific properties of the target.
+	Properties map[string]any `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our replication target
+// struct.
+func (r *ReplicationTarget) UnmarshalJSON(b []byte) error {
+	type tmp ReplicationTarget
+	var s tmp
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ReplicationTarget(s)
+
+	err = json.Unmarshal(b, &r.Properties)
+	if err != nil {
+		return err
+	}
+	delete(r.Properties, "backend_id")
+
+	return nil
+}
+
+// ListReplicationTargetsResult contains the response body and error from a
+// ListReplicationTargets request.
+type ListReplicationTargetsResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the replication targets out of the
+// ListReplicationTargetsResult object.
+func (r ListReplicationTargetsResult) Extract() ([]ReplicationTarget, error) {
+	var s struct {
+		ReplicationTargets []ReplicationTarget `json:"replication_targets"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ReplicationTargets, err
+}
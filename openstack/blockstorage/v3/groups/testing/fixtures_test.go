@@ -0,0 +1,278 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput is a sample response to a ListDetail call.
+const ListOutput = `
+{
+    "groups": [
+        {
+            "id": "6f519a48-3183-46cf-a32f-41815f813986",
+            "status": "available",
+            "availability_zone": "az1",
+            "created_at": "2015-09-16T09:28:52.000000",
+            "name": "first_group",
+            "description": "my first group",
+            "group_type": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+            "volume_types": [
+                "4e9e6d23-eed0-426d-b90a-28f87a94b6fe",
+                "c4daaf47-c530-4901-b28e-f5f0a359c4e6"
+            ],
+            "volumes": [
+                "6edbc2f4-1507-44f8-ac0d-eed1d2608d38"
+            ],
+            "group_snapshot_id": null,
+            "source_group_id": null,
+            "replication_status": "disabled",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        },
+        {
+            "id": "aed36625-a6d7-4681-ba59-c7ba3d18c148",
+            "status": "error",
+            "availability_zone": "az2",
+            "created_at": "2015-09-16T09:31:15.000000",
+            "name": "second_group",
+            "description": "my second group",
+            "group_type": "f8645498-1323-47a2-9442-5c57724d2e3c",
+            "volume_types": [
+                "c4daaf47-c530-4901-b28e-f5f0a359c4e6"
+            ],
+            "group_snapshot_id": "d1aff55d-9a79-4a5a-bb5d-62b7a0ef8b59",
+            "source_group_id": null,
+            "replication_status": "disabled",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        }
+    ],
+    "groups_links": [
+        {
+            "href": "%s/groups/detail?marker=1",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "group": {
+        "id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "status": "available",
+        "availability_zone": "az1",
+        "created_at": "2015-09-16T09:28:52.000000",
+        "name": "first_group",
+        "description": "my first group",
+        "group_type": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+        "volume_types": [
+            "4e9e6d23-eed0-426d-b90a-28f87a94b6fe",
+            "c4daaf47-c530-4901-b28e-f5f0a359c4e6"
+        ],
+        "volumes": [
+            "6edbc2f4-1507-44f8-ac0d-eed1d2608d38"
+        ],
+        "group_snapshot_id": null,
+        "source_group_id": null,
+        "replication_status": "disabled",
+        "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+    }
+}
+`
+
+// CreateRequest is a sample request to create a group.
+const CreateRequest = `
+{
+    "group": {
+        "name": "first_group",
+        "description": "my first group",
+        "group_type": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+        "volume_types": [
+            "4e9e6d23-eed0-426d-b90a-28f87a94b6fe",
+            "c4daaf47-c530-4901-b28e-f5f0a359c4e6"
+        ],
+        "availability_zone": "az1"
+    }
+}
+`
+
+// CreateOutput is a sample response to a Create call.
+const CreateOutput = `
+{
+    "group": {
+        "id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "name": "first_group"
+    }
+}
+`
+
+// CreateFromSrcRequest is a sample request to create a group from a source.
+const CreateFromSrcRequest = `
+{
+    "create-from-src": {
+        "name": "first_group",
+        "group_snapshot_id": "d1aff55d-9a79-4a5a-bb5d-62b7a0ef8b59"
+    }
+}
+`
+
+// UpdateRequest is a sample request to update a group.
+const UpdateRequest = `
+{
+    "group": {
+        "name": "new_name",
+        "add_volumes": "6edbc2f4-1507-44f8-ac0d-eed1d2608d38,a1e5b1b4-7b5c-4f5f-9a7e-8e1f6b1c2d3e",
+        "remove_volumes": "e3d4ca50-21f0-4ea8-8ac5-6bcf3b0e4a50"
+    }
+}
+`
+
+// ListReplicationTargetsOutput is a sample response to a
+// ListReplicationTargets call.
+const ListReplicationTargetsOutput = `
+{
+    "replication_targets": [
+        {
+            "backend_id": "vendor-id-1",
+            "unique_key": "value1"
+        }
+    ]
+}
+`
+
+// FirstGroup is the first group in the List request.
+var FirstGroup = groups.Group{
+	ID:               "6f519a48-3183-46cf-a32f-41815f813986",
+	Status:           "available",
+	AvailabilityZone: "az1",
+	CreatedAt:        time.Date(2015, 9, 16, 9, 28, 52, 0, time.UTC),
+	Name:             "first_group",
+	Description:      "my first group",
+	GroupType:        "29514915-5208-46ab-9ece-1cc4688ad0c1",
+	VolumeTypes: []string{
+		"4e9e6d23-eed0-426d-b90a-28f87a94b6fe",
+		"c4daaf47-c530-4901-b28e-f5f0a359c4e6",
+	},
+	Volumes: []string{
+		"6edbc2f4-1507-44f8-ac0d-eed1d2608d38",
+	},
+	ReplicationStatus: "disabled",
+	ProjectID:         "7ccf4863071f44aeb8f141f65780c51b",
+}
+
+// SecondGroup is the second group in the List request.
+var SecondGroup = groups.Group{
+	ID:               "aed36625-a6d7-4681-ba59-c7ba3d18c148",
+	Status:           "error",
+	AvailabilityZone: "az2",
+	CreatedAt:        time.Date(2015, 9, 16, 9, 31, 15, 0, time.UTC),
+	Name:             "second_group",
+	Description:      "my second group",
+	GroupType:        "f8645498-1323-47a2-9442-5c57724d2e3c",
+	VolumeTypes: []string{
+		"c4daaf47-c530-4901-b28e-f5f0a359c4e6",
+	},
+	GroupSnapshotID:   "d1aff55d-9a79-4a5a-bb5d-62b7a0ef8b59",
+	ReplicationStatus: "disabled",
+	ProjectID:         "7ccf4863071f44aeb8f141f65780c51b",
+}
+
+func HandleListDetailSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.AssertEquals(t, "true", r.Form.Get("list_volume"))
+			fmt.Fprintf(w, ListOutput, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"groups": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/6f519a48-3183-46cf-a32f-41815f813986", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateOutput)
+	})
+}
+
+func HandleCreateFromSrcSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateFromSrcRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateOutput)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/6f519a48-3183-46cf-a32f-41815f813986", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func HandleActionSuccessfully(t *testing.T, fakeServer th.FakeServer, expectedBody string) {
+	fakeServer.Mux.HandleFunc("/groups/6f519a48-3183-46cf-a32f-41815f813986/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, expectedBody)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func HandleListReplicationTargetsSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/6f519a48-3183-46cf-a32f-41815f813986/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"list_replication_targets": {}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListReplicationTargetsOutput)
+	})
+}
@@ -0,0 +1,193 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	pages := 0
+	err := groups.ListDetail(client.ServiceClient(fakeServer), groups.ListOpts{ListVolume: true}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := groups.ExtractGroups(page)
+		if err != nil {
+			return false, err
+		}
+		th.CheckDeepEquals(t, []groups.Group{FirstGroup, SecondGroup}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := groups.Get(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstGroup, actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	options := groups.CreateOpts{
+		Name:        "first_group",
+		Description: "my first group",
+		GroupType:   "29514915-5208-46ab-9ece-1cc4688ad0c1",
+		VolumeTypes: []string{
+			"4e9e6d23-eed0-426d-b90a-28f87a94b6fe",
+			"c4daaf47-c530-4901-b28e-f5f0a359c4e6",
+		},
+		AvailabilityZone: "az1",
+	}
+
+	actual, err := groups.Create(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "6f519a48-3183-46cf-a32f-41815f813986", actual.ID)
+	th.AssertEquals(t, "first_group", actual.Name)
+}
+
+func TestCreateRequiresVolumeTypes(t *testing.T) {
+	options := groups.CreateOpts{
+		GroupType: "29514915-5208-46ab-9ece-1cc4688ad0c1",
+	}
+	_, err := options.ToGroupCreateMap()
+	th.AssertErr(t, err)
+}
+
+func TestCreateFromSrc(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateFromSrcSuccessfully(t, fakeServer)
+
+	options := groups.CreateFromSrcOpts{
+		Name:            "first_group",
+		GroupSnapshotID: "d1aff55d-9a79-4a5a-bb5d-62b7a0ef8b59",
+	}
+
+	actual, err := groups.CreateFromSrc(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "6f519a48-3183-46cf-a32f-41815f813986", actual.ID)
+}
+
+func TestCreateFromSrcInvalid(t *testing.T) {
+	options := groups.CreateFromSrcOpts{
+		GroupSnapshotID: "d1aff55d-9a79-4a5a-bb5d-62b7a0ef8b59",
+		SourceGroupID:   "6f519a48-3183-46cf-a32f-41815f813986",
+	}
+	_, err := options.ToGroupCreateFromSrcMap()
+	th.AssertErr(t, err)
+
+	_, err = groups.CreateFromSrcOpts{}.ToGroupCreateFromSrcMap()
+	th.AssertErr(t, err)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	name := "new_name"
+	options := groups.UpdateOpts{
+		Name: &name,
+		AddVolumes: []string{
+			"6edbc2f4-1507-44f8-ac0d-eed1d2608d38",
+			"a1e5b1b4-7b5c-4f5f-9a7e-8e1f6b1c2d3e",
+		},
+		RemoveVolumes: []string{
+			"e3d4ca50-21f0-4ea8-8ac5-6bcf3b0e4a50",
+		},
+	}
+
+	err := groups.Update(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986", options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleActionSuccessfully(t, fakeServer, `{"delete": {"delete-volumes": true}}`)
+
+	err := groups.Delete(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986", groups.DeleteOpts{DeleteVolumes: true}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteWithoutOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleActionSuccessfully(t, fakeServer, `{"delete": {"delete-volumes": false}}`)
+
+	err := groups.Delete(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986", nil).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestResetStatus(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleActionSuccessfully(t, fakeServer, `{"reset_status": {"status": "available"}}`)
+
+	err := groups.ResetStatus(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986", groups.ResetStatusOpts{Status: "available"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestEnableReplication(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleActionSuccessfully(t, fakeServer, `{"enable_replication": {}}`)
+
+	err := groups.EnableReplication(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDisableReplication(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleActionSuccessfully(t, fakeServer, `{"disable_replication": {}}`)
+
+	err := groups.DisableReplication(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestFailoverReplication(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleActionSuccessfully(t, fakeServer, `{"failover_replication": {"allow_attached_volume": true, "secondary_backend_id": "vendor-id-1"}}`)
+
+	options := groups.FailoverReplicationOpts{
+		AllowAttachedVolume: true,
+		SecondaryBackendID:  "vendor-id-1",
+	}
+	err := groups.FailoverReplication(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986", options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListReplicationTargets(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListReplicationTargetsSuccessfully(t, fakeServer)
+
+	actual, err := groups.ListReplicationTargets(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f813986").Extract()
+	th.AssertNoErr(t, err)
+
+	expected := []groups.ReplicationTarget{
+		{
+			BackendID:  "vendor-id-1",
+			Properties: map[string]any{"unique_key": "value1"},
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
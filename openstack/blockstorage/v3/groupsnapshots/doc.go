@@ -0,0 +1,69 @@
+/*
+Package groupsnapshots provides information and interaction with group
+snapshots in the OpenStack Block Storage service. A group snapshot is a
+point in time copy of all the volumes of a generic volume group, taken at
+the same time.
+
+Group snapshots require the client to be set to microversion 3.14 or later.
+Resetting the status of a group snapshot requires 3.19.
+
+Example to list Group Snapshots
+
+	client.Microversion = "3.14"
+
+	allPages, err := groupsnapshots.ListDetail(client, groupsnapshots.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroupSnapshots, err := groupsnapshots.ExtractGroupSnapshots(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, groupSnapshot := range allGroupSnapshots {
+		fmt.Println(groupSnapshot)
+	}
+
+Example to create a Group Snapshot
+
+	client.Microversion = "3.14"
+
+	createOpts := groupsnapshots.CreateOpts{
+		GroupID: "6f519a48-3183-46cf-a32f-41815f813986",
+		Name:    "db-snapshot",
+	}
+
+	groupSnapshot, err := groupsnapshots.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = groupsnapshots.WaitForStatus(context.TODO(), client, groupSnapshot.ID, "available")
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group Snapshot
+
+	client.Microversion = "3.14"
+
+	err := groupsnapshots.Delete(context.TODO(), client, "group-snapshot-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to reset the status of a Group Snapshot
+
+	client.Microversion = "3.19"
+
+	resetOpts := groupsnapshots.ResetStatusOpts{
+		Status: "available",
+	}
+
+	err := groupsnapshots.ResetStatus(context.TODO(), client, "group-snapshot-id", resetOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groupsnapshots
@@ -0,0 +1,128 @@
+package groupsnapshots
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// GroupSnapshot contains all the information associated with a Cinder Group
+// Snapshot.
+type GroupSnapshot struct {
+	// Unique identifier.
+	ID string `json:"id"`
+
+	// Date created.
+	CreatedAt time.Time `json:"-"`
+
+	// Display name.
+	Name string `json:"name"`
+
+	// Display description.
+	Description string `json:"description"`
+
+	// Current status of the Group Snapshot.
+	Status string `json:"status"`
+
+	// ID of the Group from which this Group Snapshot was created.
+	GroupID string `json:"group_id"`
+
+	// ID of the Group Type of the Group.
+	GroupTypeID string `json:"group_type_id"`
+
+	// ProjectID is the ID of the project that owns the group snapshot.
+	// This field is supported since 3.58 microversion.
+	ProjectID string `json:"project_id"`
+}
+
+// UnmarshalJSON converts our JSON API response into our group snapshot struct.
+func (r *GroupSnapshot) UnmarshalJSON(b []byte) error {
+	type tmp GroupSnapshot
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = GroupSnapshot(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+// GroupSnapshotPage is a pagination.Pager that is returned from a call to the
+// List function.
+type GroupSnapshotPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a GroupSnapshotPage contains no Group Snapshots.
+func (r GroupSnapshotPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	groupSnapshots, err := ExtractGroupSnapshots(r)
+	return len(groupSnapshots) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r GroupSnapshotPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"group_snapshots_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractGroupSnapshots extracts and returns Group Snapshots. It is used while
+// iterating over a groupsnapshots.List call.
+func ExtractGroupSnapshots(r pagination.Page) ([]GroupSnapshot, error) {
+	var s struct {
+		GroupSnapshots []GroupSnapshot `json:"group_snapshots"`
+	}
+	err := (r.(GroupSnapshotPage)).ExtractInto(&s)
+	return s.GroupSnapshots, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the GroupSnapshot object out of the commonResult object.
+func (r commonResult) Extract() (*GroupSnapshot, error) {
+	var s struct {
+		GroupSnapshot *GroupSnapshot `json:"group_snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.GroupSnapshot, err
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ResetStatusResult contains the response error from a ResetStatus request.
+type ResetStatusResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// group_snapshots unit tests
+package testing
@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput is a sample response to a ListDetail call.
+const ListOutput = `
+{
+    "group_snapshots": [
+        {
+            "id": "6f519a48-3183-46cf-a32f-41815f816666",
+            "created_at": "2015-09-16T09:28:52.000000",
+            "name": "first_group_snapshot",
+            "description": "my first group snapshot",
+            "status": "available",
+            "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+            "group_type_id": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        },
+        {
+            "id": "aed36625-a6d7-4681-ba59-c7ba3d18dddd",
+            "created_at": "2015-09-16T09:31:15.000000",
+            "name": "second_group_snapshot",
+            "description": "my second group snapshot",
+            "status": "creating",
+            "group_id": "aed36625-a6d7-4681-ba59-c7ba3d18c148",
+            "group_type_id": "f8645498-1323-47a2-9442-5c57724d2e3c",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        }
+    ],
+    "group_snapshots_links": [
+        {
+            "href": "%s/group_snapshots/detail?marker=1",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "group_snapshot": {
+        "id": "6f519a48-3183-46cf-a32f-41815f816666",
+        "created_at": "2015-09-16T09:28:52.000000",
+        "name": "first_group_snapshot",
+        "description": "my first group snapshot",
+        "status": "available",
+        "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "group_type_id": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+        "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+    }
+}
+`
+
+// CreateRequest is a sample request to create a group snapshot.
+const CreateRequest = `
+{
+    "group_snapshot": {
+        "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "name": "first_group_snapshot",
+        "description": "my first group snapshot"
+    }
+}
+`
+
+// CreateOutput is a sample response to a Create call.
+const CreateOutput = `
+{
+    "group_snapshot": {
+        "id": "6f519a48-3183-46cf-a32f-41815f816666",
+        "name": "first_group_snapshot",
+        "group_type_id": "29514915-5208-46ab-9ece-1cc4688ad0c1"
+    }
+}
+`
+
+// FirstGroupSnapshot is the first group snapshot in the List request.
+var FirstGroupSnapshot = groupsnapshots.GroupSnapshot{
+	ID:          "6f519a48-3183-46cf-a32f-41815f816666",
+	CreatedAt:   time.Date(2015, 9, 16, 9, 28, 52, 0, time.UTC),
+	Name:        "first_group_snapshot",
+	Description: "my first group snapshot",
+	Status:      "available",
+	GroupID:     "6f519a48-3183-46cf-a32f-41815f813986",
+	GroupTypeID: "29514915-5208-46ab-9ece-1cc4688ad0c1",
+	ProjectID:   "7ccf4863071f44aeb8f141f65780c51b",
+}
+
+// SecondGroupSnapshot is the second group snapshot in the List request.
+var SecondGroupSnapshot = groupsnapshots.GroupSnapshot{
+	ID:          "aed36625-a6d7-4681-ba59-c7ba3d18dddd",
+	CreatedAt:   time.Date(2015, 9, 16, 9, 31, 15, 0, time.UTC),
+	Name:        "second_group_snapshot",
+	Description: "my second group snapshot",
+	Status:      "creating",
+	GroupID:     "aed36625-a6d7-4681-ba59-c7ba3d18c148",
+	GroupTypeID: "f8645498-1323-47a2-9442-5c57724d2e3c",
+	ProjectID:   "7ccf4863071f44aeb8f141f65780c51b",
+}
+
+func HandleListDetailSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, ListOutput, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"group_snapshots": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/6f519a48-3183-46cf-a32f-41815f816666", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateOutput)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/6f519a48-3183-46cf-a32f-41815f816666", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func HandleResetStatusSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/6f519a48-3183-46cf-a32f-41815f816666/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"reset_status": {"status": "error"}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	pages := 0
+	err := groupsnapshots.ListDetail(client.ServiceClient(fakeServer), nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := groupsnapshots.ExtractGroupSnapshots(page)
+		if err != nil {
+			return false, err
+		}
+		th.CheckDeepEquals(t, []groupsnapshots.GroupSnapshot{FirstGroupSnapshot, SecondGroupSnapshot}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := groupsnapshots.Get(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f816666").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstGroupSnapshot, actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	options := groupsnapshots.CreateOpts{
+		GroupID:     "6f519a48-3183-46cf-a32f-41815f813986",
+		Name:        "first_group_snapshot",
+		Description: "my first group snapshot",
+	}
+
+	actual, err := groupsnapshots.Create(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "6f519a48-3183-46cf-a32f-41815f816666", actual.ID)
+	th.AssertEquals(t, "29514915-5208-46ab-9ece-1cc4688ad0c1", actual.GroupTypeID)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	res := groupsnapshots.Delete(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f816666")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestResetStatus(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleResetStatusSuccessfully(t, fakeServer)
+
+	err := groupsnapshots.ResetStatus(context.TODO(), client.ServiceClient(fakeServer), "6f519a48-3183-46cf-a32f-41815f816666", groupsnapshots.ResetStatusOpts{Status: "error"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,115 @@
+/*
+Package grouptypes provides information and interaction with group types in the
+OpenStack Block Storage service. A group type defines the set of group specs
+applied to generic volume groups.
+
+Group types require the client to be set to microversion 3.11 or later.
+
+Example to list Group Types
+
+	client.Microversion = "3.11"
+
+	allPages, err := grouptypes.List(client, grouptypes.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	groupTypes, err := grouptypes.ExtractGroupTypes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, gt := range groupTypes {
+		fmt.Println(gt)
+	}
+
+Example to create a Group Type
+
+	client.Microversion = "3.11"
+
+	isPublic := true
+	createOpts := grouptypes.CreateOpts{
+		Name:     "consistent-group",
+		IsPublic: &isPublic,
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> True",
+		},
+	}
+
+	groupType, err := grouptypes.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(groupType)
+
+Example to update a Group Type
+
+	client.Microversion = "3.11"
+
+	name := "new-name"
+	updateOpts := grouptypes.UpdateOpts{
+		Name: &name,
+	}
+
+	groupType, err := grouptypes.Update(context.TODO(), client, "group-type-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group Type
+
+	client.Microversion = "3.11"
+
+	err := grouptypes.Delete(context.TODO(), client, "group-type-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to list the Group Specs of a Group Type
+
+	client.Microversion = "3.11"
+
+	groupSpecs, err := grouptypes.ListGroupSpecs(context.TODO(), client, "group-type-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v", groupSpecs)
+
+Example to create Group Specs for a Group Type
+
+	client.Microversion = "3.11"
+
+	createOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_snapshot_enabled": "<is> True",
+	}
+
+	groupSpecs, err := grouptypes.CreateGroupSpecs(context.TODO(), client, "group-type-id", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to update a Group Spec of a Group Type
+
+	client.Microversion = "3.11"
+
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_snapshot_enabled": "<is> False",
+	}
+
+	groupSpec, err := grouptypes.UpdateGroupSpec(context.TODO(), client, "group-type-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group Spec of a Group Type
+
+	client.Microversion = "3.11"
+
+	err := grouptypes.DeleteGroupSpec(context.TODO(), client, "group-type-id", "consistent_group_snapshot_enabled").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package grouptypes
@@ -0,0 +1,2 @@
+// group_types unit tests
+package testing
@@ -0,0 +1,301 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "group_types": [
+        {
+            "id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+            "name": "grp-type-001",
+            "description": "group type 0001",
+            "is_public": true,
+            "group_specs": {
+                "consistent_group_snapshot_enabled": "<is> False"
+            }
+        },
+        {
+            "id": "8eb69a46-df97-4e41-9586-9a40a7533803",
+            "name": "grp-type-002",
+            "description": "group type 0002",
+            "is_public": false,
+            "group_specs": {}
+        }
+    ],
+    "group_type_links": [
+        {
+            "href": "%s/group_types?marker=1",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "group_type": {
+        "id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+        "name": "grp-type-001",
+        "description": "group type 0001",
+        "is_public": true,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> False"
+        }
+    }
+}
+`
+
+// CreateRequest is a sample request to create a group type.
+const CreateRequest = `
+{
+    "group_type": {
+        "name": "grp-type-001",
+        "description": "group type 0001",
+        "is_public": true,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> False"
+        }
+    }
+}
+`
+
+// UpdateRequest is a sample request to update a group type.
+const UpdateRequest = `
+{
+    "group_type": {
+        "name": "grp-type-002",
+        "description": "group type 0002",
+        "is_public": false
+    }
+}
+`
+
+// UpdateOutput is a sample response to an Update call.
+const UpdateOutput = `
+{
+    "group_type": {
+        "id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+        "name": "grp-type-002",
+        "description": "group type 0002",
+        "is_public": false,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> False"
+        }
+    }
+}
+`
+
+// GroupSpecsGetBody provides a GET result of the group_specs for a group type.
+const GroupSpecsGetBody = `
+{
+    "group_specs" : {
+        "consistent_group_snapshot_enabled": "<is> False",
+        "replication_enabled": "<is> True"
+    }
+}
+`
+
+// GetGroupSpecBody provides a GET result of a particular group_spec for a group type.
+const GetGroupSpecBody = `
+{
+    "consistent_group_snapshot_enabled": "<is> False"
+}
+`
+
+// UpdatedGroupSpecBody provides a PUT result of a particular updated group_spec for a group type.
+const UpdatedGroupSpecBody = `
+{
+    "consistent_group_snapshot_enabled": "<is> True"
+}
+`
+
+// FirstGroupType is the first group type in the List request.
+var FirstGroupType = grouptypes.GroupType{
+	ID:          "6685584b-1eac-4da6-b5c3-555430cf68ff",
+	Name:        "grp-type-001",
+	Description: "group type 0001",
+	IsPublic:    true,
+	GroupSpecs: map[string]string{
+		"consistent_group_snapshot_enabled": "<is> False",
+	},
+}
+
+// SecondGroupType is the second group type in the List request.
+var SecondGroupType = grouptypes.GroupType{
+	ID:          "8eb69a46-df97-4e41-9586-9a40a7533803",
+	Name:        "grp-type-002",
+	Description: "group type 0002",
+	IsPublic:    false,
+	GroupSpecs:  map[string]string{},
+}
+
+// GroupSpecs is the expected group_specs returned from GET on a group type's group_specs.
+var GroupSpecs = map[string]string{
+	"consistent_group_snapshot_enabled": "<is> False",
+	"replication_enabled":               "<is> True",
+}
+
+// GroupSpec is the expected group_spec returned from GET on a group type's group_specs.
+var GroupSpec = map[string]string{
+	"consistent_group_snapshot_enabled": "<is> False",
+}
+
+// UpdatedGroupSpec is the expected group_spec returned from PUT on a group type's group_specs.
+var UpdatedGroupSpec = map[string]string{
+	"consistent_group_snapshot_enabled": "<is> True",
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, ListOutput, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"group_types": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/6685584b-1eac-4da6-b5c3-555430cf68ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleGetDefaultSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/default", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/6685584b-1eac-4da6-b5c3-555430cf68ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/6685584b-1eac-4da6-b5c3-555430cf68ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+func HandleGroupSpecsListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/1/group_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GroupSpecsGetBody)
+	})
+}
+
+func HandleGroupSpecGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/1/group_specs/consistent_group_snapshot_enabled", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetGroupSpecBody)
+	})
+}
+
+func HandleGroupSpecsCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/1/group_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `{
+				"group_specs": {
+					"consistent_group_snapshot_enabled": "<is> False",
+					"replication_enabled": "<is> True"
+				}
+			}`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GroupSpecsGetBody)
+	})
+}
+
+func HandleGroupSpecUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/1/group_specs/consistent_group_snapshot_enabled", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `{
+				"consistent_group_snapshot_enabled": "<is> True"
+			}`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdatedGroupSpecBody)
+	})
+}
+
+func HandleGroupSpecDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/1/group_specs/consistent_group_snapshot_enabled", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
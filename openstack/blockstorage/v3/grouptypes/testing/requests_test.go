@@ -0,0 +1,165 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	pages := 0
+	err := grouptypes.List(client.ServiceClient(fakeServer), nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := grouptypes.ExtractGroupTypes(page)
+		if err != nil {
+			return false, err
+		}
+		th.CheckDeepEquals(t, []grouptypes.GroupType{FirstGroupType, SecondGroupType}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := grouptypes.Get(context.TODO(), client.ServiceClient(fakeServer), "6685584b-1eac-4da6-b5c3-555430cf68ff").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstGroupType, actual)
+}
+
+func TestGetDefault(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetDefaultSuccessfully(t, fakeServer)
+
+	actual, err := grouptypes.GetDefault(context.TODO(), client.ServiceClient(fakeServer)).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstGroupType, actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	isPublic := true
+	options := grouptypes.CreateOpts{
+		Name:        "grp-type-001",
+		Description: "group type 0001",
+		IsPublic:    &isPublic,
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> False",
+		},
+	}
+
+	actual, err := grouptypes.Create(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstGroupType, actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	res := grouptypes.Delete(context.TODO(), client.ServiceClient(fakeServer), "6685584b-1eac-4da6-b5c3-555430cf68ff")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	name := "grp-type-002"
+	description := "group type 0002"
+	isPublic := false
+	options := grouptypes.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+		IsPublic:    &isPublic,
+	}
+
+	actual, err := grouptypes.Update(context.TODO(), client.ServiceClient(fakeServer), "6685584b-1eac-4da6-b5c3-555430cf68ff", options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "grp-type-002", actual.Name)
+	th.AssertEquals(t, "group type 0002", actual.Description)
+	th.AssertEquals(t, false, actual.IsPublic)
+}
+
+func TestGroupSpecsList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecsListSuccessfully(t, fakeServer)
+
+	actual, err := grouptypes.ListGroupSpecs(context.TODO(), client.ServiceClient(fakeServer), "1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpecs, actual)
+}
+
+func TestGroupSpecGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecGetSuccessfully(t, fakeServer)
+
+	actual, err := grouptypes.GetGroupSpec(context.TODO(), client.ServiceClient(fakeServer), "1", "consistent_group_snapshot_enabled").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpec, actual)
+}
+
+func TestGroupSpecsCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecsCreateSuccessfully(t, fakeServer)
+
+	createOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_snapshot_enabled": "<is> False",
+		"replication_enabled":               "<is> True",
+	}
+	actual, err := grouptypes.CreateGroupSpecs(context.TODO(), client.ServiceClient(fakeServer), "1", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpecs, actual)
+}
+
+func TestGroupSpecUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecUpdateSuccessfully(t, fakeServer)
+
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_snapshot_enabled": "<is> True",
+	}
+	actual, err := grouptypes.UpdateGroupSpec(context.TODO(), client.ServiceClient(fakeServer), "1", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, UpdatedGroupSpec, actual)
+}
+
+func TestGroupSpecUpdateInvalid(t *testing.T) {
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"a": "1",
+		"b": "2",
+	}
+	_, _, err := updateOpts.ToGroupTypeGroupSpecUpdateMap()
+	th.AssertErr(t, err)
+}
+
+func TestGroupSpecDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecDeleteSuccessfully(t, fakeServer)
+
+	res := grouptypes.DeleteGroupSpec(context.TODO(), client.ServiceClient(fakeServer), "1", "consistent_group_snapshot_enabled")
+	th.AssertNoErr(t, res.Err)
+}
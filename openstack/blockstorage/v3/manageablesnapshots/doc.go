@@ -0,0 +1,47 @@
+/*
+Package manageablesnapshots information and interaction with manageable snapshots
+for the OpenStack Block Storage service.
+
+NOTE: Requires at least microversion 3.8
+
+Example to list the manageable snapshots of a host
+
+	listOpts := manageablesnapshots.ListOpts{
+		Host: "host@lvm#LVM",
+	}
+
+	allPages, err := manageablesnapshots.ListDetail(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	allSnapshots, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, snapshot := range allSnapshots {
+		if !snapshot.SafeToManage {
+			fmt.Printf("%v: %s\n", snapshot.Reference, snapshot.ReasonNotSafe)
+		}
+	}
+
+Example to manage an existing snapshot
+
+	manageOpts := manageablesnapshots.ManageExistingOpts{
+		VolumeID: "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+		Ref: map[string]string{
+			"source-name": "snapshot-a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+		},
+		Name:        "New Snapshot",
+		Description: "Snapshot imported from existing LV",
+	}
+
+	managedSnapshot, err := manageablesnapshots.ManageExisting(context.TODO(), client, manageOpts).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Managed snapshot: %+v\n", managedSnapshot)
+*/
+package manageablesnapshots
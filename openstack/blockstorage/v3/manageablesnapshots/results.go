@@ -0,0 +1,72 @@
+package manageablesnapshots
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+type ManageExistingResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Snapshot object out of the ManageExistingResult object.
+func (r ManageExistingResult) Extract() (*snapshots.Snapshot, error) {
+	var s snapshots.Snapshot
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a snapshot struct
+func (r ManageExistingResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "snapshot")
+}
+
+// ManageableSnapshot describes a snapshot found on a backend which may be
+// brought under the management of the Block Storage service.
+type ManageableSnapshot struct {
+	// A reference to the snapshot on the backend.
+	Reference map[string]string `json:"reference"`
+	// A reference to the volume the snapshot was taken from.
+	SourceReference map[string]string `json:"source_reference"`
+	// The size of the snapshot, in GiB.
+	Size int `json:"size"`
+	// Whether the snapshot is safe to manage.
+	SafeToManage bool `json:"safe_to_manage"`
+
+	// The following fields are only returned by ListDetail.
+
+	// The reason the snapshot is not safe to manage.
+	ReasonNotSafe string `json:"reason_not_safe"`
+	// The UUID of the snapshot if it is already managed.
+	CinderID string `json:"cinder_id"`
+	// Driver specific information about the snapshot.
+	ExtraInfo map[string]any `json:"extra_info"`
+}
+
+// ManageableSnapshotPage represents a single page of all ManageableSnapshots
+// from a List request.
+type ManageableSnapshotPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a page of ManageableSnapshots contains
+// any results.
+func (page ManageableSnapshotPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	manageableSnapshots, err := ExtractManageableSnapshots(page)
+	return len(manageableSnapshots) == 0, err
+}
+
+// ExtractManageableSnapshots takes a pagination.Page and returns the list of
+// ManageableSnapshots from it.
+func ExtractManageableSnapshots(r pagination.Page) ([]ManageableSnapshot, error) {
+	var s struct {
+		ManageableSnapshots []ManageableSnapshot `json:"manageable-snapshots"`
+	}
+	err := (r.(ManageableSnapshotPage)).ExtractInto(&s)
+	return s.ManageableSnapshots, err
+}
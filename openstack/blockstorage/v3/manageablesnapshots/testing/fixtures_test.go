@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	fake "github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func MockManageExistingResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/manageable_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "snapshot": {
+        "volume_id": "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+        "ref": {
+            "source-name": "snapshot-a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+        },
+        "name": "New Snapshot",
+        "description": "Snapshot imported from existing LV",
+        "metadata": {
+            "key1": "value1"
+        }
+    }
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprint(w, `
+{
+    "snapshot": {
+        "id": "b6e0a1f2-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+        "status": "creating",
+        "size": 1,
+        "volume_id": "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+        "name": "New Snapshot",
+        "description": "Snapshot imported from existing LV",
+        "metadata": {
+            "key1": "value1"
+        },
+        "created_at": "2025-03-20T11:58:05.000000",
+        "updated_at": null
+    }
+}
+		`)
+	})
+}
+
+func MockListResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/manageable_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": "host@lvm#LVM"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+{
+    "manageable-snapshots": [
+        {
+            "source_reference": {
+                "source-name": "volume-23cf872b-c781-4cd4-847d-5f2ec8cbd91c"
+            },
+            "safe_to_manage": true,
+            "reference": {
+                "source-name": "snapshot-a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+            },
+            "size": 1
+        }
+    ]
+}
+		`)
+	})
+}
+
+func MockListDetailResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/manageable_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"cluster": "cluster@lvm", "limit": "1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+{
+    "manageable-snapshots": [
+        {
+            "cinder_id": "b6e0a1f2-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+            "source_reference": {
+                "source-name": "volume-23cf872b-c781-4cd4-847d-5f2ec8cbd91c"
+            },
+            "safe_to_manage": false,
+            "reason_not_safe": "already managed",
+            "reference": {
+                "source-name": "snapshot-b6e0a1f2-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+            },
+            "size": 1,
+            "extra_info": null
+        }
+    ]
+}
+		`)
+	})
+}
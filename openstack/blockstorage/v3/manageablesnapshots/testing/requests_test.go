@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/manageablesnapshots"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestManageExisting(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockManageExistingResponse(t, fakeServer)
+
+	options := &manageablesnapshots.ManageExistingOpts{
+		VolumeID:    "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+		Ref:         map[string]string{"source-name": "snapshot-a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"},
+		Name:        "New Snapshot",
+		Description: "Snapshot imported from existing LV",
+		Metadata:    map[string]string{"key1": "value1"},
+	}
+	s, err := manageablesnapshots.ManageExisting(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "b6e0a1f2-3c4d-4e5f-8a9b-0c1d2e3f4a5b", s.ID)
+	th.AssertEquals(t, "creating", s.Status)
+	th.AssertEquals(t, "23cf872b-c781-4cd4-847d-5f2ec8cbd91c", s.VolumeID)
+	th.AssertEquals(t, "New Snapshot", s.Name)
+}
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListResponse(t, fakeServer)
+
+	allPages, err := manageablesnapshots.List(client.ServiceClient(fakeServer), manageablesnapshots.ListOpts{Host: "host@lvm#LVM"}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []manageablesnapshots.ManageableSnapshot{
+		{
+			Reference:       map[string]string{"source-name": "snapshot-a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"},
+			SourceReference: map[string]string{"source-name": "volume-23cf872b-c781-4cd4-847d-5f2ec8cbd91c"},
+			Size:            1,
+			SafeToManage:    true,
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListDetailResponse(t, fakeServer)
+
+	allPages, err := manageablesnapshots.ListDetail(client.ServiceClient(fakeServer), manageablesnapshots.ListOpts{Cluster: "cluster@lvm", Limit: 1}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []manageablesnapshots.ManageableSnapshot{
+		{
+			Reference:       map[string]string{"source-name": "snapshot-b6e0a1f2-3c4d-4e5f-8a9b-0c1d2e3f4a5b"},
+			SourceReference: map[string]string{"source-name": "volume-23cf872b-c781-4cd4-847d-5f2ec8cbd91c"},
+			Size:            1,
+			SafeToManage:    false,
+			ReasonNotSafe:   "already managed",
+			CinderID:        "b6e0a1f2-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
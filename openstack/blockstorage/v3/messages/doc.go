@@ -0,0 +1,53 @@
+/*
+Package messages provides information and interaction with the user messages
+of the OpenStack Block Storage service. Messages are created when an
+asynchronous operation fails, and explain, for example, why a volume went
+to the error state.
+
+Messages require the client to be set to microversion 3.3 or later. Filtering
+and paginating messages requires 3.5.
+
+Example to list the Messages of a Volume
+
+	client.Microversion = "3.5"
+
+	listOpts := messages.ListOpts{
+		ResourceType: "VOLUME",
+		ResourceUUID: "2a6c6a2b-9f45-4c9b-8b5d-9e1c2f3a4b5c",
+	}
+
+	allPages, err := messages.List(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMessages, err := messages.ExtractMessages(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, message := range allMessages {
+		fmt.Println(message.UserMessage)
+	}
+
+Example to get a Message
+
+	client.Microversion = "3.3"
+
+	message, err := messages.Get(context.TODO(), client, "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(message)
+
+Example to delete a Message
+
+	client.Microversion = "3.3"
+
+	err := messages.Delete(context.TODO(), client, "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package messages
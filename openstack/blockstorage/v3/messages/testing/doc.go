@@ -0,0 +1,2 @@
+// messages unit tests
+package testing
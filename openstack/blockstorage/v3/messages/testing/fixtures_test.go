@@ -0,0 +1,134 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/messages"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "messages": [
+        {
+            "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+            "event_id": "VOLUME_000002",
+            "message_level": "ERROR",
+            "request_id": "req-88bc2a6d-96c0-4e8b-bb2b-6dd4c4fd2c7b",
+            "resource_type": "VOLUME",
+            "resource_uuid": "2a6c6a2b-9f45-4c9b-8b5d-9e1c2f3a4b5c",
+            "user_message": "create volume: No storage could be allocated for this volume request.",
+            "created_at": "2016-10-03T11:24:28.000000",
+            "guaranteed_until": "2016-11-02T11:24:28.000000"
+        },
+        {
+            "id": "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00",
+            "event_id": "VOLUME_000001",
+            "message_level": "ERROR",
+            "request_id": "req-9a1c2b5e-7d3f-4b8e-8f1c-0e2d3c4b5a69",
+            "resource_type": "VOLUME",
+            "resource_uuid": "2a6c6a2b-9f45-4c9b-8b5d-9e1c2f3a4b5c",
+            "user_message": "schedule allocate volume: Could not find any available weighted backend.",
+            "created_at": "2016-10-03T11:20:11.000000",
+            "guaranteed_until": "2016-11-02T11:20:11.000000"
+        }
+    ],
+    "messages_links": [
+        {
+            "href": "%s/messages?marker=1",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "message": {
+        "id": "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00",
+        "event_id": "VOLUME_000001",
+        "message_level": "ERROR",
+        "request_id": "req-9a1c2b5e-7d3f-4b8e-8f1c-0e2d3c4b5a69",
+        "resource_type": "VOLUME",
+        "resource_uuid": "2a6c6a2b-9f45-4c9b-8b5d-9e1c2f3a4b5c",
+        "user_message": "schedule allocate volume: Could not find any available weighted backend.",
+        "created_at": "2016-10-03T11:20:11.000000",
+        "guaranteed_until": "2016-11-02T11:20:11.000000"
+    }
+}
+`
+
+// FirstMessage is the first message in the List request.
+var FirstMessage = messages.Message{
+	ID:              "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+	EventID:         "VOLUME_000002",
+	MessageLevel:    "ERROR",
+	RequestID:       "req-88bc2a6d-96c0-4e8b-bb2b-6dd4c4fd2c7b",
+	ResourceType:    "VOLUME",
+	ResourceUUID:    "2a6c6a2b-9f45-4c9b-8b5d-9e1c2f3a4b5c",
+	UserMessage:     "create volume: No storage could be allocated for this volume request.",
+	CreatedAt:       time.Date(2016, 10, 3, 11, 24, 28, 0, time.UTC),
+	GuaranteedUntil: time.Date(2016, 11, 2, 11, 24, 28, 0, time.UTC),
+}
+
+// SecondMessage is the second message in the List request.
+var SecondMessage = messages.Message{
+	ID:              "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00",
+	EventID:         "VOLUME_000001",
+	MessageLevel:    "ERROR",
+	RequestID:       "req-9a1c2b5e-7d3f-4b8e-8f1c-0e2d3c4b5a69",
+	ResourceType:    "VOLUME",
+	ResourceUUID:    "2a6c6a2b-9f45-4c9b-8b5d-9e1c2f3a4b5c",
+	UserMessage:     "schedule allocate volume: Could not find any available weighted backend.",
+	CreatedAt:       time.Date(2016, 10, 3, 11, 20, 11, 0, time.UTC),
+	GuaranteedUntil: time.Date(2016, 11, 2, 11, 20, 11, 0, time.UTC),
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.AssertEquals(t, "VOLUME", r.Form.Get("resource_type"))
+			fmt.Fprintf(w, ListOutput, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"messages": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/messages/2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/messages/2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
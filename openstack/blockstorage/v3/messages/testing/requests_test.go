@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/messages"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	pages := 0
+	err := messages.List(client.ServiceClient(fakeServer), messages.ListOpts{ResourceType: "VOLUME"}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := messages.ExtractMessages(page)
+		if err != nil {
+			return false, err
+		}
+		th.CheckDeepEquals(t, []messages.Message{FirstMessage, SecondMessage}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := messages.Get(context.TODO(), client.ServiceClient(fakeServer), "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &SecondMessage, actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	res := messages.Delete(context.TODO(), client.ServiceClient(fakeServer), "2150b9a6-3d5e-4a4f-a9b2-1c3e5e7d9f00")
+	th.AssertNoErr(t, res.Err)
+}
@@ -164,5 +164,40 @@ Example of Unmanaging a Volume
 	if err != nil {
 		panic(err)
 	}
+
+Example of Reverting a Volume to its latest Snapshot
+
+	revertOpts := volumes.RevertToSnapshotOpts{
+		SnapshotID: snapshot.ID,
+	}
+
+	client.Microversion = "3.40"
+	err := volumes.RevertToSnapshot(context.TODO(), client, volume.ID, revertOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Migrating a Volume to another Backend
+
+	migrateOpts := volumes.MigrateOpts{
+		Host: "node2@lvm#LVM",
+	}
+
+	err := volumes.Migrate(context.TODO(), client, volume.ID, migrateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumes.WaitForMigration(context.TODO(), client, volume.ID)
+	if err != nil {
+		panic(err)
+	}
+
+Example of Setting a Volume Read-only
+
+	err := volumes.SetReadonly(context.TODO(), client, volume.ID, volumes.ReadonlyOpts{Readonly: true}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package volumes
@@ -0,0 +1,18 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrMigrationFailed is the error returned by WaitForMigration when the
+// migration of a volume ends in the error migration status.
+type ErrMigrationFailed struct {
+	gophercloud.BaseError
+	VolumeID string
+}
+
+func (e ErrMigrationFailed) Error() string {
+	return fmt.Sprintf("migration of volume %s failed", e.VolumeID)
+}
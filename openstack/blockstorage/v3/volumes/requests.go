@@ -786,3 +786,161 @@ func Unmanage(ctx context.Context, client *gophercloud.ServiceClient, id string)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// RevertToSnapshotOptsBuilder allows extensions to add additional parameters
+// to the RevertToSnapshot request.
+type RevertToSnapshotOptsBuilder interface {
+	ToVolumeRevertToSnapshotMap() (map[string]any, error)
+}
+
+// RevertToSnapshotOpts contains options for reverting a Volume to a snapshot.
+type RevertToSnapshotOpts struct {
+	// SnapshotID is the ID of the snapshot to revert to. It must be the
+	// latest snapshot of the volume.
+	SnapshotID string `json:"snapshot_id" required:"true"`
+}
+
+// ToVolumeRevertToSnapshotMap assembles a request body based on the contents
+// of a RevertToSnapshotOpts.
+func (opts RevertToSnapshotOpts) ToVolumeRevertToSnapshotMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "revert")
+}
+
+// RevertToSnapshot will revert the volume to its latest snapshot. The volume
+// and the snapshot must both be in the available state.
+// Client must have Microversion set; minimum supported microversion for RevertToSnapshot is 3.40.
+func RevertToSnapshot(ctx context.Context, client *gophercloud.ServiceClient, id string, opts RevertToSnapshotOptsBuilder) (r RevertToSnapshotResult) {
+	b, err := opts.ToVolumeRevertToSnapshotMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// MigrateOptsBuilder allows extensions to add additional parameters to the
+// Migrate request.
+type MigrateOptsBuilder interface {
+	ToVolumeMigrateMap() (map[string]any, error)
+}
+
+// MigrateOpts contains options for migrating a Volume to another backend.
+// Exactly one of Host and Cluster must be set.
+type MigrateOpts struct {
+	// Host is the target host of the migration, in the host@backend#pool
+	// form.
+	Host string `json:"host,omitempty" xor:"Cluster"`
+
+	// Cluster is the target cluster of the migration.
+	// This requires microversion 3.16 or later.
+	Cluster string `json:"cluster,omitempty" xor:"Host"`
+
+	// ForceHostCopy disables the driver optimized migration and copies the
+	// data through the volume service host.
+	ForceHostCopy bool `json:"force_host_copy,omitempty"`
+
+	// LockVolume prevents other processes from aborting the migration of an
+	// available volume.
+	LockVolume bool `json:"lock_volume,omitempty"`
+}
+
+// ToVolumeMigrateMap assembles a request body based on the contents of a
+// MigrateOpts.
+func (opts MigrateOpts) ToVolumeMigrateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "os-migrate_volume")
+}
+
+// Migrate will migrate the volume to another host or cluster. The migration
+// is asynchronous, use WaitForMigration to wait for it to complete.
+// This is an admin-only action.
+func Migrate(ctx context.Context, client *gophercloud.ServiceClient, id string, opts MigrateOptsBuilder) (r MigrateResult) {
+	b, err := opts.ToVolumeMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// MigrationCompletionOptsBuilder allows extensions to add additional
+// parameters to the CompleteMigration request.
+type MigrationCompletionOptsBuilder interface {
+	ToVolumeMigrationCompletionMap() (map[string]any, error)
+}
+
+// MigrationCompletionOpts contains options for completing the migration of a
+// Volume.
+type MigrationCompletionOpts struct {
+	// NewVolumeID is the ID of the volume the data was migrated to.
+	NewVolumeID string `json:"new_volume" required:"true"`
+
+	// Error signals that the migration failed, in which case the source
+	// volume is kept.
+	Error bool `json:"error,omitempty"`
+}
+
+// ToVolumeMigrationCompletionMap assembles a request body based on the
+// contents of a MigrationCompletionOpts.
+func (opts MigrationCompletionOpts) ToVolumeMigrationCompletionMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "os-migrate_volume_completion")
+}
+
+// CompleteMigration completes the migration of a volume to the new volume.
+// It is called by the Compute service when it copied the data of an attached
+// volume, and only needs to be called directly when driving such a copy by
+// other means. To extract the ID of the volume to keep, call the Extract
+// method on the CompleteMigrationResult.
+// This is an admin-only action.
+func CompleteMigration(ctx context.Context, client *gophercloud.ServiceClient, id string, opts MigrationCompletionOptsBuilder) (r CompleteMigrationResult) {
+	b, err := opts.ToVolumeMigrationCompletionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ReadonlyOptsBuilder allows extensions to add additional parameters to the
+// SetReadonly request.
+type ReadonlyOptsBuilder interface {
+	ToVolumeReadonlyMap() (map[string]any, error)
+}
+
+// ReadonlyOpts contains options for updating the read-only flag of a Volume.
+type ReadonlyOpts struct {
+	// Readonly enables or disables the read-only access mode of the volume.
+	Readonly bool `json:"readonly"`
+}
+
+// ToVolumeReadonlyMap assembles a request body based on the contents of a
+// ReadonlyOpts.
+func (opts ReadonlyOpts) ToVolumeReadonlyMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "os-update_readonly_flag")
+}
+
+// SetReadonly will update the read-only flag of a volume based on the values
+// in ReadonlyOpts. The flag only applies to new attachments of the volume.
+func SetReadonly(ctx context.Context, client *gophercloud.ServiceClient, id string, opts ReadonlyOptsBuilder) (r SetReadonlyResult) {
+	b, err := opts.ToVolumeReadonlyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
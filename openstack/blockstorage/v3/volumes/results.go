@@ -86,6 +86,13 @@ type Volume struct {
 	Host string `json:"os-vol-host-attr:host"`
 	// TenantID is the id of the project that owns the volume.
 	TenantID string `json:"os-vol-tenant-attr:tenant_id"`
+	// MigrationStatus is the status of the latest migration of the volume.
+	// This is an admin-only field.
+	MigrationStatus string `json:"os-vol-mig-status-attr:migstat"`
+	// NameID is the ID of the volume whose backend storage object holds the
+	// data of this volume, which differs from ID after a migration.
+	// This is an admin-only field.
+	NameID string `json:"os-vol-mig-status-attr:name_id"`
 }
 
 // UnmarshalJSON another unmarshalling function
@@ -404,3 +411,35 @@ type ResetStatusResult struct {
 type UnmanageResult struct {
 	gophercloud.ErrResult
 }
+
+// RevertToSnapshotResult contains the response error from a RevertToSnapshot
+// request.
+type RevertToSnapshotResult struct {
+	gophercloud.ErrResult
+}
+
+// MigrateResult contains the response error from a Migrate request.
+type MigrateResult struct {
+	gophercloud.ErrResult
+}
+
+// CompleteMigrationResult contains the response body and error from a
+// CompleteMigration request.
+type CompleteMigrationResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the ID of the volume which was kept out of the
+// CompleteMigrationResult object.
+func (r CompleteMigrationResult) Extract() (string, error) {
+	var s struct {
+		SaveVolumeID string `json:"save_volume_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SaveVolumeID, err
+}
+
+// SetReadonlyResult contains the response error from a SetReadonly request.
+type SetReadonlyResult struct {
+	gophercloud.ErrResult
+}
@@ -664,3 +664,115 @@ func MockUnmanageResponse(t *testing.T, fakeServer th.FakeServer) {
 			w.WriteHeader(http.StatusAccepted)
 		})
 }
+
+func MockRevertToSnapshotResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestHeader(t, r, "Content-Type", "application/json")
+			th.TestJSONRequest(t, r, `
+{
+	"revert": {
+		"snapshot_id": "d8a0e4c5-3f5e-4b5c-8d5a-2b0c1e9f0a11"
+	}
+}
+			`)
+
+			w.WriteHeader(http.StatusAccepted)
+		})
+}
+
+func MockMigrateResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestHeader(t, r, "Content-Type", "application/json")
+			th.TestJSONRequest(t, r, `
+{
+    "os-migrate_volume":
+    {
+        "host": "node2@lvm#LVM",
+        "force_host_copy": true,
+        "lock_volume": true
+    }
+}
+          `)
+
+			w.WriteHeader(http.StatusAccepted)
+		})
+}
+
+func MockCompleteMigrationResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestHeader(t, r, "Content-Type", "application/json")
+			th.TestJSONRequest(t, r, `
+{
+    "os-migrate_volume_completion":
+    {
+        "new_volume": "2b955850-f177-45f7-9f49-ecb2c256d161"
+    }
+}
+          `)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, `{"save_volume_id": "cd281d77-8217-4830-be95-9528227c105c"}`)
+		})
+}
+
+func MockSetReadonlyResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestHeader(t, r, "Content-Type", "application/json")
+			th.TestJSONRequest(t, r, `
+{
+    "os-update_readonly_flag":
+    {
+        "readonly": false
+    }
+}
+          `)
+
+			w.WriteHeader(http.StatusAccepted)
+		})
+}
+
+// MockMigrationStatusResponse serves the volume with each of the given
+// migration statuses in turn, then with the last one.
+func MockMigrationStatusResponse(t *testing.T, fakeServer th.FakeServer, statuses ...string) {
+	calls := 0
+	fakeServer.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			status := statuses[len(statuses)-1]
+			if calls < len(statuses) {
+				status = statuses[calls]
+			}
+			calls++
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprintf(w, `
+{
+    "volume": {
+        "id": "cd281d77-8217-4830-be95-9528227c105c",
+        "status": "available",
+        "os-vol-host-attr:host": "node2@lvm#LVM",
+        "os-vol-mig-status-attr:migstat": "%s",
+        "os-vol-mig-status-attr:name_id": "2b955850-f177-45f7-9f49-ecb2c256d161"
+    }
+}
+`, status)
+		})
+}
@@ -2,6 +2,7 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -548,3 +549,99 @@ func TestUnmanage(t *testing.T) {
 	err := volumes.Unmanage(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestRevertToSnapshot(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockRevertToSnapshotResponse(t, fakeServer)
+
+	options := volumes.RevertToSnapshotOpts{
+		SnapshotID: "d8a0e4c5-3f5e-4b5c-8d5a-2b0c1e9f0a11",
+	}
+
+	err := volumes.RevertToSnapshot(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c", options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestMigrate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockMigrateResponse(t, fakeServer)
+
+	options := &volumes.MigrateOpts{
+		Host:          "node2@lvm#LVM",
+		ForceHostCopy: true,
+		LockVolume:    true,
+	}
+
+	err := volumes.Migrate(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c", options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestMigrateHostXorCluster(t *testing.T) {
+	_, err := volumes.MigrateOpts{}.ToVolumeMigrateMap()
+	if err == nil {
+		t.Fatal("expected an error when neither host nor cluster is set")
+	}
+
+	_, err = volumes.MigrateOpts{Host: "node2@lvm#LVM", Cluster: "cluster@lvm"}.ToVolumeMigrateMap()
+	if err == nil {
+		t.Fatal("expected an error when both host and cluster are set")
+	}
+}
+
+func TestCompleteMigration(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockCompleteMigrationResponse(t, fakeServer)
+
+	options := &volumes.MigrationCompletionOpts{
+		NewVolumeID: "2b955850-f177-45f7-9f49-ecb2c256d161",
+	}
+
+	saveVolumeID, err := volumes.CompleteMigration(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c", options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "cd281d77-8217-4830-be95-9528227c105c", saveVolumeID)
+}
+
+func TestSetReadonly(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockSetReadonlyResponse(t, fakeServer)
+
+	err := volumes.SetReadonly(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c", volumes.ReadonlyOpts{Readonly: false}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForMigration(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockMigrationStatusResponse(t, fakeServer, "migrating", "success")
+
+	err := volumes.WaitForMigration(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c")
+	th.AssertNoErr(t, err)
+
+	v, err := volumes.Get(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "success", v.MigrationStatus)
+	th.AssertEquals(t, "2b955850-f177-45f7-9f49-ecb2c256d161", v.NameID)
+}
+
+func TestWaitForMigrationError(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockMigrationStatusResponse(t, fakeServer, "error")
+
+	err := volumes.WaitForMigration(context.TODO(), client.ServiceClient(fakeServer), "cd281d77-8217-4830-be95-9528227c105c")
+	var migrationErr volumes.ErrMigrationFailed
+	if !errors.As(err, &migrationErr) {
+		t.Fatalf("expected ErrMigrationFailed, got %v", err)
+	}
+	th.AssertEquals(t, "cd281d77-8217-4830-be95-9528227c105c", migrationErr.VolumeID)
+}
@@ -21,3 +21,26 @@ func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status
 		return false, nil
 	})
 }
+
+// WaitForMigration will continually poll the volume until its migration
+// status is success, or returns an ErrMigrationFailed when it is error.
+// Migrate resets the migration status before returning, so a previous
+// migration is not mistaken for the current one.
+// Reading the migration status requires admin credentials.
+func WaitForMigration(ctx context.Context, c *gophercloud.ServiceClient, id string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch current.MigrationStatus {
+		case "success":
+			return true, nil
+		case "error":
+			return false, ErrMigrationFailed{VolumeID: id}
+		}
+
+		return false, nil
+	})
+}
@@ -164,21 +164,51 @@ Example to Remove/Revoke Access to a Volume Type
 Example to Create the Encryption of a Volume Type
 
 	typeID := "7ffaca22-f646-41d4-b79d-d7e4452ef8cc"
-	volumeType, err := volumetypes.CreateEncryption(context.TODO(), client, typeID, .CreateEncryptionOpts{
-		KeySize:      256,
-		Provider:    "luks",
-		ControlLocation: "front-end",
-		Cipher:  "aes-xts-plain64",
+	volumeType, err := volumetypes.CreateEncryption(context.TODO(), client, typeID, volumetypes.CreateEncryptionOpts{
+		KeySize:         volumetypes.EncryptionKeySize256,
+		Provider:        volumetypes.EncryptionProviderLUKS,
+		ControlLocation: volumetypes.EncryptionControlLocationFrontEnd,
+		Cipher:          volumetypes.EncryptionCipherAESXTSPlain64,
 	}).Extract()
 	if err != nil{
 		panic(err)
 	}
 	fmt.Println(volumeType)
 
+Example to Create an Encrypted Private Volume Type
+
+	// The encryption keys of the volumes of this type are created by Cinder
+	// in the Key Manager service, see the keymanager/v1 packages.
+	isPublic := false
+	volumeType, err := volumetypes.Create(context.TODO(), client, volumetypes.CreateOpts{
+		Name:     "LUKS",
+		IsPublic: &isPublic,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = volumetypes.CreateEncryption(context.TODO(), client, volumeType.ID, volumetypes.CreateEncryptionOpts{
+		KeySize:         volumetypes.EncryptionKeySize256,
+		Provider:        volumetypes.EncryptionProviderLUKS,
+		ControlLocation: volumetypes.EncryptionControlLocationFrontEnd,
+		Cipher:          volumetypes.EncryptionCipherAESXTSPlain64,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumetypes.AddAccess(context.TODO(), client, volumeType.ID, volumetypes.AddAccessOpts{
+		Project: "15153a0979884b59b0592248ef947921",
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete the Encryption of a Volume Type
 
 	typeID := "7ffaca22-f646-41d4-b79d-d7e4452ef8cc"
-	encryptionID := ""81e069c6-7394-4856-8df7-3b237ca61f74
+	encryptionID := "81e069c6-7394-4856-8df7-3b237ca61f74"
 	err := volumetypes.DeleteEncryption(context.TODO(), client, typeID, encryptionID).ExtractErr()
 	if err != nil{
 		panic(err)
@@ -187,11 +217,12 @@ Example to Delete the Encryption of a Volume Type
 Example to Update the Encryption of a Volume Type
 
 	typeID := "7ffaca22-f646-41d4-b79d-d7e4452ef8cc"
-	volumetype, err = volumetypes.UpdateEncryption(context.TODO(), client, typeID, volumetypes.UpdateEncryptionOpts{
-		KeySize:      256,
-		Provider:    "luks",
-		ControlLocation: "front-end",
-		Cipher:  "aes-xts-plain64",
+	encryptionID := "81e069c6-7394-4856-8df7-3b237ca61f74"
+	volumetype, err = volumetypes.UpdateEncryption(context.TODO(), client, typeID, encryptionID, volumetypes.UpdateEncryptionOpts{
+		KeySize:         volumetypes.EncryptionKeySize256,
+		Provider:        volumetypes.EncryptionProviderLUKS,
+		ControlLocation: volumetypes.EncryptionControlLocationBackEnd,
+		Cipher:          volumetypes.EncryptionCipherAESXTSPlain64,
 	}).Extract()
 	if err != nil{
 		panic(err)
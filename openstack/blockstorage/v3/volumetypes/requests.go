@@ -328,6 +328,41 @@ func RemoveAccess(ctx context.Context, client *gophercloud.ServiceClient, id str
 	return
 }
 
+// Providers of the encryption of a volume type, for the Provider field of
+// CreateEncryptionOpts and UpdateEncryptionOpts.
+const (
+	// EncryptionProviderLUKS encrypts volumes with LUKS version 1.
+	EncryptionProviderLUKS = "luks"
+	// EncryptionProviderPlain encrypts volumes with dm-crypt plain mode.
+	EncryptionProviderPlain = "plain"
+)
+
+// Ciphers of the encryption of a volume type, for the Cipher field of
+// CreateEncryptionOpts and UpdateEncryptionOpts.
+const (
+	EncryptionCipherAESXTSPlain64     = "aes-xts-plain64"
+	EncryptionCipherAESCBCEssivSHA256 = "aes-cbc-essiv:sha256"
+)
+
+// Control locations of the encryption of a volume type, for the
+// ControlLocation field of CreateEncryptionOpts and UpdateEncryptionOpts.
+const (
+	// EncryptionControlLocationFrontEnd performs encryption in the compute
+	// service.
+	EncryptionControlLocationFrontEnd = "front-end"
+	// EncryptionControlLocationBackEnd performs encryption in the block
+	// storage backend.
+	EncryptionControlLocationBackEnd = "back-end"
+)
+
+// Key sizes, in bits, of the encryption of a volume type, for the KeySize
+// field of CreateEncryptionOpts and UpdateEncryptionOpts.
+const (
+	EncryptionKeySize128 = 128
+	EncryptionKeySize256 = 256
+	EncryptionKeySize512 = 512
+)
+
 // CreateEncryptionOptsBuilder allows extensions to add additional parameters to the
 // Create Encryption request.
 type CreateEncryptionOptsBuilder interface {
@@ -421,6 +421,18 @@ func TestUpdateEncryption(t *testing.T) {
 	th.AssertEquals(t, "aes-xts-plain64", n.Cipher)
 }
 
+func TestCreateEncryptionOptsRequiresProvider(t *testing.T) {
+	options := volumetypes.CreateEncryptionOpts{
+		KeySize: volumetypes.EncryptionKeySize256,
+		Cipher:  volumetypes.EncryptionCipherAESXTSPlain64,
+	}
+
+	_, err := options.ToEncryptionCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for missing provider")
+	}
+}
+
 func TestGetEncryption(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
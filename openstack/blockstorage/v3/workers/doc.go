@@ -0,0 +1,27 @@
+/*
+Package workers provides the ability to clean up the resources left behind
+by Block Storage services which died while processing them, for example in
+an active/active cluster.
+
+NOTE: Requires at least microversion 3.24
+
+Example to clean up the resources of the down services of a Cluster
+
+	client.Microversion = "3.24"
+
+	isUp := false
+	cleanupOpts := workers.CleanupOpts{
+		ClusterName: "cluster@lvmdriver-1",
+		IsUp:        &isUp,
+	}
+
+	cleanup, err := workers.Cleanup(context.TODO(), client, cleanupOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, service := range cleanup.Cleaning {
+		fmt.Printf("Cleaning up %s on %s\n", service.Binary, service.Host)
+	}
+*/
+package workers
@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// CleanupOptsBuilder allows extensions to add additional parameters to the
+// Cleanup request.
+type CleanupOptsBuilder interface {
+	ToWorkerCleanupMap() (map[string]any, error)
+}
+
+// CleanupOpts contains options for cleaning up the resources left behind by
+// Block Storage services which died while processing them. All fields are
+// filters; when none is set every service is cleaned up.
+type CleanupOpts struct {
+	// Filter by cluster name.
+	ClusterName string `json:"cluster_name,omitempty"`
+
+	// Filter by the ID of the service.
+	ServiceID int `json:"service_id,omitempty"`
+
+	// Filter by host name.
+	Host string `json:"host,omitempty"`
+
+	// Filter by binary name of the service.
+	Binary string `json:"binary,omitempty"`
+
+	// Filter by whether the service is up.
+	IsUp *bool `json:"is_up,omitempty"`
+
+	// Filter by whether the service is disabled.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Filter by the UUID of the resource to clean up.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// Filter by the type of resource to clean up, either Volume or Snapshot.
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// Only clean up services which last sent a heartbeat before this time.
+	Until *time.Time `json:"-"`
+}
+
+// ToWorkerCleanupMap assembles a request body based on the contents of a
+// CleanupOpts.
+func (opts CleanupOpts) ToWorkerCleanupMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Until != nil {
+		b["until"] = opts.Until.Format(time.RFC3339)
+	}
+
+	return b, nil
+}
+
+// Cleanup requests the cleanup of the resources of the services matching the
+// provided filters. The cleanup itself is asynchronous; the response only
+// lists which services were asked to clean up and which were unavailable.
+// Client must have Microversion set; minimum supported microversion for Cleanup is 3.24.
+func Cleanup(ctx context.Context, client *gophercloud.ServiceClient, opts CleanupOptsBuilder) (r CleanupResult) {
+	b, err := opts.ToWorkerCleanupMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, cleanupURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Service describes a Block Storage service targeted by a Cleanup request.
+type Service struct {
+	// The ID of the service.
+	ID int `json:"id"`
+
+	// The name of the host.
+	Host string `json:"host"`
+
+	// The binary name of the service.
+	Binary string `json:"binary"`
+
+	// The cluster name of the service.
+	ClusterName string `json:"cluster_name"`
+}
+
+// CleanupResponse is the response of a Cleanup request.
+type CleanupResponse struct {
+	// The services which were asked to clean up their resources.
+	Cleaning []Service `json:"cleaning"`
+
+	// The services which matched the filters but could not be asked to
+	// clean up, because no other service was available to do it.
+	Unavailable []Service `json:"unavailable"`
+}
+
+// CleanupResult contains the response body and error from a Cleanup request.
+type CleanupResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the CleanupResponse object out of the CleanupResult object.
+func (r CleanupResult) Extract() (*CleanupResponse, error) {
+	var s CleanupResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
@@ -0,0 +1,2 @@
+// workers unit tests
+package testing
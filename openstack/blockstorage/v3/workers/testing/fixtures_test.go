@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	fake "github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func MockCleanupResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/workers/cleanup", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "cluster_name": "cluster@lvmdriver-1",
+    "is_up": false,
+    "resource_type": "Volume",
+    "until": "2017-01-31T23:59:59Z"
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `
+{
+    "cleaning": [
+        {
+            "id": 1,
+            "host": "host1@lvmdriver-1",
+            "binary": "cinder-volume",
+            "cluster_name": "cluster@lvmdriver-1"
+        }
+    ],
+    "unavailable": [
+        {
+            "id": 2,
+            "host": "host2@lvmdriver-1",
+            "binary": "cinder-volume",
+            "cluster_name": "cluster@lvmdriver-1"
+        }
+    ]
+}
+		`)
+	})
+}
@@ -0,0 +1,39 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/workers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestCleanup(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	MockCleanupResponse(t, fakeServer)
+
+	isUp := false
+	until := time.Date(2017, 1, 31, 23, 59, 59, 0, time.UTC)
+	opts := workers.CleanupOpts{
+		ClusterName:  "cluster@lvmdriver-1",
+		IsUp:         &isUp,
+		ResourceType: "Volume",
+		Until:        &until,
+	}
+
+	actual, err := workers.Cleanup(context.TODO(), client.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &workers.CleanupResponse{
+		Cleaning: []workers.Service{
+			{ID: 1, Host: "host1@lvmdriver-1", Binary: "cinder-volume", ClusterName: "cluster@lvmdriver-1"},
+		},
+		Unavailable: []workers.Service{
+			{ID: 2, Host: "host2@lvmdriver-1", Binary: "cinder-volume", ClusterName: "cluster@lvmdriver-1"},
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
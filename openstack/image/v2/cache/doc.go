@@ -0,0 +1,45 @@
+/*
+Package cache enables management of the image cache of the Image service. It
+requires admin privileges, and is available since the Image API v2.14.
+
+Example to List the Cached and Queued Images
+
+	state, err := cache.List(context.TODO(), imagesClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, image := range state.CachedImages {
+		fmt.Printf("%s: %d bytes, %d hits\n", image.ImageID, image.Size, image.Hits)
+	}
+
+Example to Queue an Image for Caching
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	err := cache.Queue(context.TODO(), imagesClient, imageID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Image from the Cache
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	err := cache.Delete(context.TODO(), imagesClient, imageID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Clear the Queue of Images to Cache
+
+	clearOpts := cache.ClearOpts{
+		Target: cache.ClearTargetQueue,
+	}
+
+	err := cache.Clear(context.TODO(), imagesClient, clearOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package cache
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// List retrieves the images which are cached, and the ones which are queued
+// for caching.
+func List(ctx context.Context, client *gophercloud.ServiceClient) (r ListResult) {
+	resp, err := client.Get(ctx, listURL(client), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Queue queues an image for caching.
+func Queue(ctx context.Context, client *gophercloud.ServiceClient, imageID string) (r QueueResult) {
+	resp, err := client.Put(ctx, imageURL(client, imageID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes an image from the cache, or from the queue of images to
+// cache.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, imageID string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, imageURL(client, imageID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ClearTarget is the part of the cache cleared by Clear.
+type ClearTarget string
+
+const (
+	// ClearTargetCache deletes the cached images.
+	ClearTargetCache ClearTarget = "cache"
+
+	// ClearTargetQueue deletes the images queued for caching.
+	ClearTargetQueue ClearTarget = "queue"
+)
+
+// ClearOptsBuilder allows extensions to add additional parameters to the
+// Clear request.
+type ClearOptsBuilder interface {
+	ToCacheClearHeaders() (map[string]string, error)
+}
+
+// ClearOpts represents options used to clear the cache.
+type ClearOpts struct {
+	// Target is the part of the cache to clear. Both the cached images and
+	// the queued images are deleted when it is empty.
+	Target ClearTarget `h:"x-image-cache-clear-target"`
+}
+
+// ToCacheClearHeaders formats a ClearOpts into a map of headers.
+func (opts ClearOpts) ToCacheClearHeaders() (map[string]string, error) {
+	return gophercloud.BuildHeaders(opts)
+}
+
+// Clear deletes all the cached images and the images queued for caching, or
+// only one of them depending on the ClearOpts.
+func Clear(ctx context.Context, client *gophercloud.ServiceClient, opts ClearOptsBuilder) (r ClearResult) {
+	h := make(map[string]string)
+	if opts != nil {
+		headers, err := opts.ToCacheClearHeaders()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		for k, v := range headers {
+			h[k] = v
+		}
+	}
+	resp, err := client.Delete(ctx, clearURL(client), &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
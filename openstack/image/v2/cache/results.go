@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Cache represents the state of the image cache.
+type Cache struct {
+	// CachedImages are the images which are cached.
+	CachedImages []CachedImage `json:"cached_images"`
+
+	// QueuedImages are the IDs of the images which are queued for caching.
+	QueuedImages []string `json:"queued_images"`
+}
+
+// CachedImage represents an image in the cache.
+type CachedImage struct {
+	// ImageID is the ID of the image.
+	ImageID string `json:"image_id"`
+
+	// Hits is the number of times the image was served from the cache.
+	Hits int `json:"hits"`
+
+	// LastAccessed is the last time the image was served from the cache.
+	LastAccessed time.Time `json:"-"`
+
+	// LastModified is the time the image was cached.
+	LastModified time.Time `json:"-"`
+
+	// Size is the size of the image, in bytes.
+	Size int64 `json:"size"`
+}
+
+func (r *CachedImage) UnmarshalJSON(b []byte) error {
+	type tmp CachedImage
+	var s struct {
+		tmp
+		LastAccessed float64 `json:"last_accessed"`
+		LastModified float64 `json:"last_modified"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = CachedImage(s.tmp)
+
+	r.LastAccessed = unixTime(s.LastAccessed)
+	r.LastModified = unixTime(s.LastModified)
+
+	return nil
+}
+
+// unixTime converts a UNIX timestamp with a fractional part into a time.
+func unixTime(t float64) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	sec, frac := math.Modf(t)
+	return time.Unix(int64(sec), int64(math.Round(frac*1e6))*1e3).UTC()
+}
+
+// ListResult represents the result of a List operation. Call its Extract
+// method to interpret it as a Cache.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as a Cache.
+func (r ListResult) Extract() (*Cache, error) {
+	var s *Cache
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// QueueResult represents the result of a Queue operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type QueueResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ClearResult represents the result of a Clear operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type ClearResult struct {
+	gophercloud.ErrResult
+}
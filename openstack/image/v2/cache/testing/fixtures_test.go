@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListResult is the response of a List request.
+const ListResult = `
+{
+    "cached_images": [
+        {
+            "image_id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+            "hits": 3,
+            "last_accessed": 1651504844.25,
+            "last_modified": 1651504800.0,
+            "size": 12716032
+        }
+    ],
+    "queued_images": [
+        "c6fa4bd0-0b1c-4c4d-9e8e-5f3a8d7e1b2a"
+    ]
+}
+`
+
+// HandleListSuccessfully sets up the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/cache", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResult)
+	})
+}
+
+// HandleQueueSuccessfully sets up the test server to respond to a Queue
+// request.
+func HandleQueueSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/cache/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleDeleteSuccessfully sets up the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/cache/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleClearSuccessfully sets up the test server to respond to a Clear
+// request, expecting the given clear target header, or no header when it is
+// empty.
+func HandleClearSuccessfully(t *testing.T, fakeServer th.FakeServer, target string) {
+	fakeServer.Mux.HandleFunc("/cache", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		if target == "" {
+			th.TestHeaderUnset(t, r, "X-Image-Cache-Clear-Target")
+		} else {
+			th.TestHeader(t, r, "X-Image-Cache-Clear-Target", target)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/cache"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	actual, err := cache.List(context.TODO(), client.ServiceClient(fakeServer)).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &cache.Cache{
+		CachedImages: []cache.CachedImage{
+			{
+				ImageID:      "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				Hits:         3,
+				LastAccessed: time.Date(2022, time.May, 2, 15, 20, 44, 250000000, time.UTC),
+				LastModified: time.Date(2022, time.May, 2, 15, 20, 0, 0, time.UTC),
+				Size:         12716032,
+			},
+		},
+		QueuedImages: []string{"c6fa4bd0-0b1c-4c4d-9e8e-5f3a8d7e1b2a"},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestQueue(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleQueueSuccessfully(t, fakeServer)
+
+	err := cache.Queue(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := cache.Delete(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestClear(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleClearSuccessfully(t, fakeServer, "queue")
+
+	err := cache.Clear(context.TODO(), client.ServiceClient(fakeServer), cache.ClearOpts{Target: cache.ClearTargetQueue}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestClearAll(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleClearSuccessfully(t, fakeServer, "")
+
+	err := cache.Clear(context.TODO(), client.ServiceClient(fakeServer), nil).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,17 @@
+package cache
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const rootPath = "cache"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func clearURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func imageURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL(rootPath, imageID)
+}
@@ -0,0 +1,53 @@
+/*
+Package formatinspector detects the format and the virtual size of disk images
+before they are uploaded to the Image service, and rejects the images the
+format inspector of the Image service would reject, like qcow2 images with a
+backing file.
+
+The qcow2, VMDK, VHD, VHDX and ISO formats are detected. Other images are
+detected as raw images.
+
+Example to Inspect a Local Image
+
+	f, err := os.Open("cirros-0.6.2-x86_64-disk.img")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		panic(err)
+	}
+
+	info, err := formatinspector.Inspect(f, fi.Size())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%s image of %d bytes\n", info.Format, info.VirtualSize)
+
+Example to Upload an Image with its Detected Format and Minimum Disk Size
+
+	resp, err := http.Get("https://download.cirros-cloud.net/0.6.2/cirros-0.6.2-x86_64-disk.img")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+
+	uploadOpts := formatinspector.UploadOpts{
+		AllowedFormats: []formatinspector.Format{
+			formatinspector.FormatQCOW2,
+			formatinspector.FormatRaw,
+		},
+		Size: resp.ContentLength,
+	}
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	info, err := formatinspector.Upload(context.TODO(), imageClient, imageID, resp.Body, uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package formatinspector
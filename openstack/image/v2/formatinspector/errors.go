@@ -0,0 +1,55 @@
+package formatinspector
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrInvalidImage is the error returned when the header of an image is
+// truncated or malformed.
+type ErrInvalidImage struct {
+	gophercloud.BaseError
+	Format Format
+	Reason string
+}
+
+func (e ErrInvalidImage) Error() string {
+	return fmt.Sprintf("invalid %s image: %s", e.Format, e.Reason)
+}
+
+// ErrUnsafeImage is the error returned when an image references other files,
+// like a qcow2 backing file, or has features the Image service rejects.
+type ErrUnsafeImage struct {
+	gophercloud.BaseError
+	Format Format
+	Reason string
+}
+
+func (e ErrUnsafeImage) Error() string {
+	return fmt.Sprintf("unsafe %s image: %s", e.Format, e.Reason)
+}
+
+// ErrFormatNotAllowed is the error returned by Upload when the detected
+// format is not one of UploadOpts.AllowedFormats.
+type ErrFormatNotAllowed struct {
+	gophercloud.BaseError
+	Format Format
+}
+
+func (e ErrFormatNotAllowed) Error() string {
+	return fmt.Sprintf("image format %s is not allowed", e.Format)
+}
+
+// ErrFormatMismatch is the error returned by Upload when the disk format of
+// the image does not match the detected format.
+type ErrFormatMismatch struct {
+	gophercloud.BaseError
+	ImageID  string
+	Expected Format
+	Actual   Format
+}
+
+func (e ErrFormatMismatch) Error() string {
+	return fmt.Sprintf("image %s has disk format %s, but the data is in the %s format", e.ImageID, e.Expected, e.Actual)
+}
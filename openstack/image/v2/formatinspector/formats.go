@@ -0,0 +1,255 @@
+package formatinspector
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+const (
+	qcow2Magic = "QFI\xfb"
+	vmdkMagic  = "KDMV"
+	vhdMagic   = "conectix"
+	vhdxMagic  = "vhdxfile"
+	isoMagic   = "CD001"
+)
+
+// qcow2 incompatible feature bits.
+const (
+	qcow2DataFileFeature = 1 << 2
+	// qcow2KnownFeatures are the dirty, corrupt, external data file,
+	// compression type and extended L2 entries bits.
+	qcow2KnownFeatures = 1<<5 - 1
+)
+
+// inspectQCOW2 parses the header of a qcow2 image.
+func inspectQCOW2(header []byte) (*Info, error) {
+	if len(header) < 72 {
+		return nil, ErrInvalidImage{Format: FormatQCOW2, Reason: "truncated header"}
+	}
+	version := binary.BigEndian.Uint32(header[4:8])
+	if version != 2 && version != 3 {
+		return nil, ErrInvalidImage{Format: FormatQCOW2, Reason: fmt.Sprintf("unsupported version %d", version)}
+	}
+	if backingFileOffset := binary.BigEndian.Uint64(header[8:16]); backingFileOffset != 0 {
+		return nil, ErrUnsafeImage{Format: FormatQCOW2, Reason: "the image has a backing file"}
+	}
+	if version == 3 {
+		if len(header) < 104 {
+			return nil, ErrInvalidImage{Format: FormatQCOW2, Reason: "truncated header"}
+		}
+		features := binary.BigEndian.Uint64(header[72:80])
+		if features&qcow2DataFileFeature != 0 {
+			return nil, ErrUnsafeImage{Format: FormatQCOW2, Reason: "the image has an external data file"}
+		}
+		if features&^qcow2KnownFeatures != 0 {
+			return nil, ErrUnsafeImage{Format: FormatQCOW2, Reason: fmt.Sprintf("unknown incompatible features %#x", features&^qcow2KnownFeatures)}
+		}
+	}
+	return &Info{
+		Format:      FormatQCOW2,
+		VirtualSize: int64(binary.BigEndian.Uint64(header[24:32])),
+	}, nil
+}
+
+// maxVMDKDescriptorSize is the maximum size of the embedded descriptor of a
+// VMDK image.
+const maxVMDKDescriptorSize = 1024 * 1024
+
+var (
+	vmdkCreateType = regexp.MustCompile(`(?m)^\s*createType\s*=\s*"([^"]*)"`)
+	vmdkExtent     = regexp.MustCompile(`(?m)^\s*(RW|RDONLY|NOACCESS)\s+\d+`)
+)
+
+// inspectVMDK parses the header and the embedded descriptor of a sparse VMDK
+// image. Only monolithic images, made of a single extent, are safe.
+func inspectVMDK(r io.ReaderAt, header []byte) (*Info, error) {
+	if len(header) < 44 {
+		return nil, ErrInvalidImage{Format: FormatVMDK, Reason: "truncated header"}
+	}
+	capacity := binary.LittleEndian.Uint64(header[12:20])
+	descriptorOffset := binary.LittleEndian.Uint64(header[28:36])
+	descriptorSize := binary.LittleEndian.Uint64(header[36:44])
+	if descriptorOffset == 0 || descriptorSize == 0 {
+		return nil, ErrInvalidImage{Format: FormatVMDK, Reason: "the image has no embedded descriptor"}
+	}
+	if descriptorSize > maxVMDKDescriptorSize/512 || descriptorOffset > MaxHeaderSize/512 {
+		return nil, ErrInvalidImage{Format: FormatVMDK, Reason: "the descriptor is too large"}
+	}
+
+	descriptor, err := readAt(r, int64(descriptorOffset*512), int64(descriptorSize*512))
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrInvalidImage{Format: FormatVMDK, Reason: "truncated descriptor"}
+		}
+		return nil, err
+	}
+	if i := bytes.IndexByte(descriptor, 0); i >= 0 {
+		descriptor = descriptor[:i]
+	}
+
+	m := vmdkCreateType.FindSubmatch(descriptor)
+	if m == nil {
+		return nil, ErrInvalidImage{Format: FormatVMDK, Reason: "the descriptor has no createType"}
+	}
+	switch createType := string(m[1]); createType {
+	case "monolithicSparse", "streamOptimized":
+	default:
+		return nil, ErrUnsafeImage{Format: FormatVMDK, Reason: fmt.Sprintf("unsupported createType %s", createType)}
+	}
+	if extents := len(vmdkExtent.FindAll(descriptor, -1)); extents != 1 {
+		return nil, ErrUnsafeImage{Format: FormatVMDK, Reason: fmt.Sprintf("the descriptor references %d extents", extents)}
+	}
+
+	return &Info{
+		Format:      FormatVMDK,
+		VirtualSize: int64(capacity * 512),
+	}, nil
+}
+
+// inspectVHD parses the copy of the footer at the start of a dynamic VHD
+// image.
+func inspectVHD(header []byte) (*Info, error) {
+	if len(header) < 512 {
+		return nil, ErrInvalidImage{Format: FormatVHD, Reason: "truncated footer"}
+	}
+	return &Info{
+		Format:      FormatVHD,
+		VirtualSize: int64(binary.BigEndian.Uint64(header[48:56])),
+	}, nil
+}
+
+const (
+	vhdxRegionTableOffset = 192 * 1024
+	vhdxMaxEntries        = 2047
+)
+
+var (
+	// vhdxMetadataRegion is the GUID of the metadata region,
+	// 8B7CA206-4790-4B9A-B8FE-575F050F886E, in its on-disk byte order.
+	vhdxMetadataRegion = []byte{0x06, 0xa2, 0x7c, 0x8b, 0x90, 0x47, 0x9a, 0x4b, 0xb8, 0xfe, 0x57, 0x5f, 0x05, 0x0f, 0x88, 0x6e}
+
+	// vhdxVirtualDiskSize is the GUID of the virtual disk size metadata
+	// item, 2FA54224-CD1B-4876-B211-5DBED83BF4B8, in its on-disk byte order.
+	vhdxVirtualDiskSize = []byte{0x24, 0x42, 0xa5, 0x2f, 0x1b, 0xcd, 0x76, 0x48, 0xb2, 0x11, 0x5d, 0xbe, 0xd8, 0x3b, 0xf4, 0xb8}
+)
+
+// inspectVHDX reads the virtual disk size of a VHDX image from its metadata
+// region.
+func inspectVHDX(r io.ReaderAt) (*Info, error) {
+	invalid := func(reason string) error {
+		return ErrInvalidImage{Format: FormatVHDX, Reason: reason}
+	}
+	read := func(off, n int64, what string) ([]byte, error) {
+		b, err := readAt(r, off, n)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, invalid("truncated " + what)
+		}
+		return b, err
+	}
+
+	table, err := read(vhdxRegionTableOffset, 16, "region table")
+	if err != nil {
+		return nil, err
+	}
+	if string(table[:4]) != "regi" {
+		return nil, invalid("bad region table signature")
+	}
+	count := binary.LittleEndian.Uint32(table[8:12])
+	if count > vhdxMaxEntries {
+		return nil, invalid("too many region table entries")
+	}
+	entries, err := read(vhdxRegionTableOffset+16, int64(count)*32, "region table")
+	if err != nil {
+		return nil, err
+	}
+	var metadataOffset int64 = -1
+	for i := 0; i < len(entries); i += 32 {
+		if bytes.Equal(entries[i:i+16], vhdxMetadataRegion) {
+			metadataOffset = int64(binary.LittleEndian.Uint64(entries[i+16 : i+24]))
+			break
+		}
+	}
+	if metadataOffset < 0 {
+		return nil, invalid("no metadata region")
+	}
+
+	table, err = read(metadataOffset, 32, "metadata table")
+	if err != nil {
+		return nil, err
+	}
+	if string(table[:8]) != "metadata" {
+		return nil, invalid("bad metadata table signature")
+	}
+	count = uint32(binary.LittleEndian.Uint16(table[10:12]))
+	if count > vhdxMaxEntries {
+		return nil, invalid("too many metadata table entries")
+	}
+	entries, err = read(metadataOffset+32, int64(count)*32, "metadata table")
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(entries); i += 32 {
+		if bytes.Equal(entries[i:i+16], vhdxVirtualDiskSize) {
+			offset := int64(binary.LittleEndian.Uint32(entries[i+16 : i+20]))
+			size, err := read(metadataOffset+offset, 8, "virtual disk size")
+			if err != nil {
+				return nil, err
+			}
+			return &Info{
+				Format:      FormatVHDX,
+				VirtualSize: int64(binary.LittleEndian.Uint64(size)),
+			}, nil
+		}
+	}
+	return nil, invalid("no virtual disk size")
+}
+
+const (
+	isoSectorSize = 2048
+	// isoFirstDescriptor is the sector of the first volume descriptor.
+	isoFirstDescriptor = 16
+	// isoMaxDescriptors bounds the number of volume descriptors read to find
+	// the primary one.
+	isoMaxDescriptors = 32
+)
+
+// inspectISO reads the volume descriptors of an ISO image. It returns nil
+// when the image is not an ISO image.
+func inspectISO(r io.ReaderAt) (*Info, error) {
+	for i := int64(0); i < isoMaxDescriptors; i++ {
+		descriptor, err := readAt(r, (isoFirstDescriptor+i)*isoSectorSize, 136)
+		if err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, errHeaderTooLarge) {
+				err = nil
+				if i > 0 {
+					err = ErrInvalidImage{Format: FormatISO, Reason: "no primary volume descriptor"}
+				}
+			}
+			return nil, err
+		}
+		if string(descriptor[1:6]) != isoMagic {
+			if i > 0 {
+				return nil, ErrInvalidImage{Format: FormatISO, Reason: "bad volume descriptor"}
+			}
+			return nil, nil
+		}
+		switch descriptor[0] {
+		case 1:
+			// The primary volume descriptor holds the number of logical
+			// blocks and their size, in little and big endian.
+			blocks := binary.LittleEndian.Uint32(descriptor[80:84])
+			blockSize := binary.LittleEndian.Uint16(descriptor[128:130])
+			return &Info{
+				Format:      FormatISO,
+				VirtualSize: int64(blocks) * int64(blockSize),
+			}, nil
+		case 255:
+			return nil, ErrInvalidImage{Format: FormatISO, Reason: "no primary volume descriptor"}
+		}
+	}
+	return nil, ErrInvalidImage{Format: FormatISO, Reason: "no primary volume descriptor"}
+}
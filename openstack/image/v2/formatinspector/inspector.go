@@ -0,0 +1,183 @@
+package formatinspector
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// Format is a disk format, named after the disk_format values of the Image
+// service.
+type Format string
+
+const (
+	// FormatRaw is a raw disk image, or any data in an unknown format.
+	FormatRaw Format = "raw"
+
+	// FormatQCOW2 is a QEMU copy-on-write version 2 or 3 image.
+	FormatQCOW2 Format = "qcow2"
+
+	// FormatVMDK is a VMware sparse disk image.
+	FormatVMDK Format = "vmdk"
+
+	// FormatVHD is a Microsoft Virtual Hard Disk image. Only dynamic and
+	// differencing images, which have a copy of their footer at their start,
+	// are detected.
+	FormatVHD Format = "vhd"
+
+	// FormatVHDX is a Microsoft Hyper-V Virtual Hard Disk image.
+	FormatVHDX Format = "vhdx"
+
+	// FormatISO is an ISO 9660 optical disc image.
+	FormatISO Format = "iso"
+)
+
+// MaxHeaderSize is the number of bytes InspectReader may read ahead to
+// inspect an image. The metadata of VHDX images usually starts after 2 MiB.
+const MaxHeaderSize = 8 * 1024 * 1024
+
+const gibibyte = 1024 * 1024 * 1024
+
+// Info describes an inspected image.
+type Info struct {
+	// Format is the detected format.
+	Format Format
+
+	// VirtualSize is the size of the disk, in bytes. It is 0 when it is not
+	// known, like for raw images inspected with InspectReader.
+	VirtualSize int64
+}
+
+// MinDisk returns the virtual size of the disk in GiB, rounded up, which is
+// the minimum size of a disk created from the image.
+func (i Info) MinDisk() int {
+	return int((i.VirtualSize + gibibyte - 1) / gibibyte)
+}
+
+// Inspect detects the format of an image of the given size and reads its
+// virtual size. Images which are not in a known format are detected as raw
+// images, of a virtual size equal to size.
+//
+// Inspect returns an ErrInvalidImage when the header of the image is
+// malformed, and an ErrUnsafeImage when the image would be rejected by the
+// format inspector of the Image service: qcow2 images with a backing file or
+// an external data file, VMDK images referencing other extents, or images
+// matching several formats.
+func Inspect(r io.ReaderAt, size int64) (*Info, error) {
+	info, err := inspect(r)
+	if err != nil {
+		return nil, err
+	}
+	if info.Format == FormatRaw {
+		info.VirtualSize = size
+	}
+	return info, nil
+}
+
+// InspectReader detects the format of an image read from r, like Inspect. It
+// reads at most MaxHeaderSize bytes from r, and returns a reader which reads
+// the whole image again, so that it can be uploaded.
+func InspectReader(r io.Reader) (*Info, io.Reader, error) {
+	b := &bufferedReaderAt{r: r}
+	info, err := inspect(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Format == FormatRaw && b.err == io.EOF {
+		// The whole image was read.
+		info.VirtualSize = int64(len(b.buf))
+	}
+	return info, io.MultiReader(bytes.NewReader(b.buf), r), nil
+}
+
+func inspect(r io.ReaderAt) (*Info, error) {
+	// Images smaller than a sector are inspected as well.
+	header, err := readAt(r, 0, 512)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var info *Info
+	switch {
+	case bytes.HasPrefix(header, []byte(qcow2Magic)):
+		info, err = inspectQCOW2(header)
+	case bytes.HasPrefix(header, []byte(vmdkMagic)):
+		info, err = inspectVMDK(r, header)
+	case bytes.HasPrefix(header, []byte(vhdMagic)):
+		info, err = inspectVHD(header)
+	case bytes.HasPrefix(header, []byte(vhdxMagic)):
+		info, err = inspectVHDX(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	iso, err := inspectISO(r)
+	if err != nil {
+		return nil, err
+	}
+	if iso != nil {
+		if info != nil {
+			return nil, ErrUnsafeImage{Format: info.Format, Reason: "the image is also an ISO image"}
+		}
+		info = iso
+	}
+
+	if info == nil {
+		info = &Info{Format: FormatRaw}
+	}
+	return info, nil
+}
+
+// readAt reads n bytes at off. It returns the bytes read along with
+// io.ErrUnexpectedEOF when fewer bytes are available.
+func readAt(r io.ReaderAt, off, n int64) ([]byte, error) {
+	b := make([]byte, n)
+	read, err := r.ReadAt(b, off)
+	if int64(read) == n {
+		return b, nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return b[:read], err
+}
+
+// errHeaderTooLarge is returned by bufferedReaderAt when a read goes past
+// MaxHeaderSize.
+var errHeaderTooLarge = errors.New("the image header is larger than the inspection limit")
+
+// bufferedReaderAt implements io.ReaderAt over an io.Reader by keeping the
+// bytes read from it.
+type bufferedReaderAt struct {
+	r   io.Reader
+	buf []byte
+	err error
+}
+
+func (b *bufferedReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	end := off + int64(len(p))
+	if end > MaxHeaderSize {
+		return 0, errHeaderTooLarge
+	}
+	for int64(len(b.buf)) < end && b.err == nil {
+		chunk := make([]byte, end-int64(len(b.buf)))
+		n, err := io.ReadFull(b.r, chunk)
+		b.buf = append(b.buf, chunk[:n]...)
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		b.err = err
+	}
+	var n int
+	if off < int64(len(b.buf)) {
+		n = copy(p, b.buf[off:])
+	}
+	if n < len(p) {
+		return n, b.err
+	}
+	return n, nil
+}
@@ -0,0 +1,90 @@
+package formatinspector
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imagedata"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+)
+
+// UploadOpts is a structure that holds parameters for uploading image data
+// with Upload.
+type UploadOpts struct {
+	// AllowedFormats, if set, are the formats which can be uploaded. An
+	// ErrFormatNotAllowed is returned for the other ones.
+	AllowedFormats []Format
+
+	// Size is the size of the data. It is used as the virtual size of raw
+	// images, which cannot be read from the data.
+	Size int64
+
+	// ContainerFormat is set on the image when it has no container format.
+	// Defaults to bare.
+	ContainerFormat string
+
+	// SkipMinDisk does not set the min_disk attribute of the image.
+	SkipMinDisk bool
+}
+
+// Upload inspects the data of an image before uploading it with
+// imagedata.Upload. The detected format is set as the disk_format of the
+// image, and the virtual size, in GiB rounded up, as its min_disk, unless the
+// image already has a larger one.
+//
+// The data is not uploaded when it fails the inspection, as described by
+// Inspect, or when the image already has a disk format which does not match
+// the detected one.
+func Upload(ctx context.Context, client *gophercloud.ServiceClient, id string, data io.Reader, opts UploadOpts) (*Info, error) {
+	info, data, err := InspectReader(data)
+	if err != nil {
+		return nil, err
+	}
+	if info.Format == FormatRaw && info.VirtualSize == 0 {
+		info.VirtualSize = opts.Size
+	}
+	if len(opts.AllowedFormats) > 0 && !slices.Contains(opts.AllowedFormats, info.Format) {
+		return info, ErrFormatNotAllowed{Format: info.Format}
+	}
+
+	image, err := images.Get(ctx, client, id).Extract()
+	if err != nil {
+		return info, err
+	}
+
+	var updateOpts images.UpdateOpts
+	switch image.DiskFormat {
+	case "":
+		updateOpts = append(updateOpts, images.UpdateImageProperty{
+			Op:    images.ReplaceOp,
+			Name:  "disk_format",
+			Value: string(info.Format),
+		})
+	case string(info.Format):
+	default:
+		return info, ErrFormatMismatch{ImageID: id, Expected: Format(image.DiskFormat), Actual: info.Format}
+	}
+	if image.ContainerFormat == "" {
+		containerFormat := opts.ContainerFormat
+		if containerFormat == "" {
+			containerFormat = "bare"
+		}
+		updateOpts = append(updateOpts, images.UpdateImageProperty{
+			Op:    images.ReplaceOp,
+			Name:  "container_format",
+			Value: containerFormat,
+		})
+	}
+	if minDisk := info.MinDisk(); !opts.SkipMinDisk && minDisk > image.MinDiskGigabytes {
+		updateOpts = append(updateOpts, images.ReplaceImageMinDisk{NewMinDisk: minDisk})
+	}
+	if len(updateOpts) > 0 {
+		if _, err := images.Update(ctx, client, id, updateOpts).Extract(); err != nil {
+			return info, err
+		}
+	}
+
+	return info, imagedata.Upload(ctx, client, id, data).ExtractErr()
+}
@@ -0,0 +1,2 @@
+// formatinspector unit tests
+package testing
@@ -0,0 +1,133 @@
+package testing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// qcow2Image returns the header of a qcow2 image.
+func qcow2Image(version uint32, backingFileOffset, features, size uint64) []byte {
+	b := make([]byte, 512)
+	copy(b, "QFI\xfb")
+	binary.BigEndian.PutUint32(b[4:], version)
+	binary.BigEndian.PutUint64(b[8:], backingFileOffset)
+	binary.BigEndian.PutUint32(b[20:], 16)
+	binary.BigEndian.PutUint64(b[24:], size)
+	binary.BigEndian.PutUint64(b[72:], features)
+	binary.BigEndian.PutUint32(b[100:], 104)
+	return b
+}
+
+// vmdkImage returns the header and the embedded descriptor of a sparse VMDK
+// image.
+func vmdkImage(capacity uint64, descriptor string) []byte {
+	b := make([]byte, 512+1024)
+	copy(b, "KDMV")
+	binary.LittleEndian.PutUint32(b[4:], 1)
+	binary.LittleEndian.PutUint64(b[12:], capacity)
+	binary.LittleEndian.PutUint64(b[20:], 128)
+	binary.LittleEndian.PutUint64(b[28:], 1)
+	binary.LittleEndian.PutUint64(b[36:], 2)
+	copy(b[512:], descriptor)
+	return b
+}
+
+// vmdkDescriptor returns a descriptor of the given type and extents.
+func vmdkDescriptor(createType string, extents ...string) string {
+	d := "# Disk DescriptorFile\nversion=1\nCID=fffffffe\nparentCID=ffffffff\n"
+	d += fmt.Sprintf("createType=\"%s\"\n\n# Extent description\n", createType)
+	for _, extent := range extents {
+		d += extent + "\n"
+	}
+	return d
+}
+
+// vhdImage returns the footer copy of a dynamic VHD image.
+func vhdImage(size uint64) []byte {
+	b := make([]byte, 1024)
+	copy(b, "conectix")
+	binary.BigEndian.PutUint64(b[40:], size)
+	binary.BigEndian.PutUint64(b[48:], size)
+	return b
+}
+
+// vhdxImage returns the headers and the metadata of a VHDX image.
+func vhdxImage(size uint64) []byte {
+	const (
+		regionTable = 192 * 1024
+		metadata    = 256 * 1024
+	)
+	b := make([]byte, metadata+65536+8)
+	copy(b, "vhdxfile")
+
+	copy(b[regionTable:], "regi")
+	binary.LittleEndian.PutUint32(b[regionTable+8:], 2)
+	// The BAT region, then the metadata region.
+	copy(b[regionTable+16:], []byte{0x66, 0x77, 0xc2, 0x2d, 0x23, 0xf6, 0x00, 0x42, 0x9d, 0x64, 0x11, 0x5e, 0x9b, 0xfd, 0x4a, 0x08})
+	binary.LittleEndian.PutUint64(b[regionTable+32:], 1024*1024)
+	copy(b[regionTable+48:], []byte{0x06, 0xa2, 0x7c, 0x8b, 0x90, 0x47, 0x9a, 0x4b, 0xb8, 0xfe, 0x57, 0x5f, 0x05, 0x0f, 0x88, 0x6e})
+	binary.LittleEndian.PutUint64(b[regionTable+64:], metadata)
+
+	copy(b[metadata:], "metadata")
+	binary.LittleEndian.PutUint16(b[metadata+10:], 1)
+	copy(b[metadata+32:], []byte{0x24, 0x42, 0xa5, 0x2f, 0x1b, 0xcd, 0x76, 0x48, 0xb2, 0x11, 0x5d, 0xbe, 0xd8, 0x3b, 0xf4, 0xb8})
+	binary.LittleEndian.PutUint32(b[metadata+48:], 65536)
+	binary.LittleEndian.PutUint64(b[metadata+65536:], size)
+	return b
+}
+
+// isoImage returns the system area and the volume descriptors of an ISO
+// image, starting with the given bytes.
+func isoImage(start []byte, blocks uint32) []byte {
+	b := make([]byte, 18*2048)
+	copy(b, start)
+	pvd := b[16*2048:]
+	pvd[0] = 1
+	copy(pvd[1:], "CD001")
+	binary.LittleEndian.PutUint32(pvd[80:], blocks)
+	binary.BigEndian.PutUint32(pvd[84:], blocks)
+	binary.LittleEndian.PutUint16(pvd[128:], 2048)
+	binary.BigEndian.PutUint16(pvd[130:], 2048)
+	terminator := b[17*2048:]
+	terminator[0] = 255
+	copy(terminator[1:], "CD001")
+	return b
+}
+
+// HandleUploadSuccessfully sets up the test server to respond to the
+// requests of Upload, for an image with the given disk format. The body of
+// the update request and the uploaded data are written to the given
+// pointers.
+func HandleUploadSuccessfully(t *testing.T, fakeServer th.FakeServer, diskFormat string, update *string, data *[]byte) {
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", "status": "queued", "disk_format": %q, "min_disk": 1}`, diskFormat)
+		case "PATCH":
+			th.TestHeader(t, r, "Content-Type", "application/openstack-images-v2.1-json-patch")
+			b, err := io.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			*update = string(b)
+			fmt.Fprint(w, `{"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", "status": "queued"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+		b, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		*data = b
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
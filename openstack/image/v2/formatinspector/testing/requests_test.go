@@ -0,0 +1,187 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/formatinspector"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const gib = 1024 * 1024 * 1024
+
+func TestInspect(t *testing.T) {
+	monolithic := vmdkDescriptor("monolithicSparse", `RW 2097152 SPARSE "disk.vmdk"`)
+
+	for name, tc := range map[string]struct {
+		data     []byte
+		expected formatinspector.Info
+	}{
+		"qcow2 v2": {qcow2Image(2, 0, 0, 10*gib), formatinspector.Info{Format: formatinspector.FormatQCOW2, VirtualSize: 10 * gib}},
+		"qcow2 v3": {qcow2Image(3, 0, 1, 112197632), formatinspector.Info{Format: formatinspector.FormatQCOW2, VirtualSize: 112197632}},
+		"vmdk":     {vmdkImage(2097152, monolithic), formatinspector.Info{Format: formatinspector.FormatVMDK, VirtualSize: gib}},
+		"vhd":      {vhdImage(5 * gib), formatinspector.Info{Format: formatinspector.FormatVHD, VirtualSize: 5 * gib}},
+		"vhdx":     {vhdxImage(20 * gib), formatinspector.Info{Format: formatinspector.FormatVHDX, VirtualSize: 20 * gib}},
+		"iso":      {isoImage(nil, 1000), formatinspector.Info{Format: formatinspector.FormatISO, VirtualSize: 1000 * 2048}},
+		"raw":      {bytes.Repeat([]byte{0xeb, 0x63, 0x90}, 1000), formatinspector.Info{Format: formatinspector.FormatRaw, VirtualSize: 3000}},
+		"empty":    {nil, formatinspector.Info{Format: formatinspector.FormatRaw}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			info, err := formatinspector.Inspect(bytes.NewReader(tc.data), int64(len(tc.data)))
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, tc.expected, *info)
+
+			info, r, err := formatinspector.InspectReader(iotest.HalfReader(bytes.NewReader(tc.data)))
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, tc.expected, *info)
+			replayed, err := io.ReadAll(r)
+			th.AssertNoErr(t, err)
+			th.AssertByteArrayEquals(t, tc.data, replayed)
+		})
+	}
+}
+
+func TestInspectReaderRawSize(t *testing.T) {
+	data := bytes.Repeat([]byte{0xeb, 0x63, 0x90}, 1024*1024)
+	info, r, err := formatinspector.InspectReader(bytes.NewReader(data))
+	th.AssertNoErr(t, err)
+
+	// The size of the raw image is not known until it is fully read.
+	th.AssertEquals(t, formatinspector.Info{Format: formatinspector.FormatRaw}, *info)
+	replayed, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, len(data), len(replayed))
+}
+
+func TestInspectUnsafe(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"qcow2 backing file":     qcow2Image(3, 0x200, 0, gib),
+		"qcow2 data file":        qcow2Image(3, 0, 1<<2, gib),
+		"qcow2 unknown features": qcow2Image(3, 0, 1<<10, gib),
+		"vmdk split":             vmdkImage(2097152, vmdkDescriptor("twoGbMaxExtentSparse", `RW 4192256 SPARSE "disk-s001.vmdk"`, `RW 4192256 SPARSE "disk-s002.vmdk"`)),
+		"vmdk flat":              vmdkImage(2097152, vmdkDescriptor("monolithicFlat", `RW 2097152 FLAT "/etc/shadow" 0`)),
+		"vmdk extents":           vmdkImage(2097152, vmdkDescriptor("monolithicSparse", `RW 2097152 SPARSE "disk.vmdk"`, `RW 2097152 FLAT "/etc/shadow" 0`)),
+		"qcow2 iso polyglot":     isoImage(qcow2Image(3, 0, 0, gib), 1000),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := formatinspector.Inspect(bytes.NewReader(data), int64(len(data)))
+			var unsafe formatinspector.ErrUnsafeImage
+			if !errors.As(err, &unsafe) {
+				t.Fatalf("expected an ErrUnsafeImage, got %v", err)
+			}
+
+			_, _, err = formatinspector.InspectReader(bytes.NewReader(data))
+			if !errors.As(err, &unsafe) {
+				t.Fatalf("expected an ErrUnsafeImage from InspectReader, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInspectInvalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"qcow2 truncated": qcow2Image(3, 0, 0, gib)[:64],
+		"qcow2 version":   qcow2Image(4, 0, 0, gib),
+		"vmdk descriptor": vmdkImage(2097152, "# Disk DescriptorFile\n"),
+		"vmdk truncated":  vmdkImage(2097152, "")[:600],
+		"vhd truncated":   vhdImage(gib)[:100],
+		"vhdx truncated":  vhdxImage(gib)[:200*1024],
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := formatinspector.Inspect(bytes.NewReader(data), int64(len(data)))
+			var invalid formatinspector.ErrInvalidImage
+			if !errors.As(err, &invalid) {
+				t.Fatalf("expected an ErrInvalidImage, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInfoMinDisk(t *testing.T) {
+	th.AssertEquals(t, 0, formatinspector.Info{}.MinDisk())
+	th.AssertEquals(t, 1, formatinspector.Info{VirtualSize: 1}.MinDisk())
+	th.AssertEquals(t, 1, formatinspector.Info{VirtualSize: gib}.MinDisk())
+	th.AssertEquals(t, 3, formatinspector.Info{VirtualSize: 2*gib + 1}.MinDisk())
+}
+
+func TestUpload(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var update string
+	var uploaded []byte
+	HandleUploadSuccessfully(t, fakeServer, "", &update, &uploaded)
+
+	data := qcow2Image(3, 0, 0, 10*gib)
+	info, err := formatinspector.Upload(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", bytes.NewReader(data), formatinspector.UploadOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, formatinspector.FormatQCOW2, info.Format)
+	th.AssertJSONEquals(t, `[
+		{"op": "replace", "path": "/disk_format", "value": "qcow2"},
+		{"op": "replace", "path": "/container_format", "value": "bare"},
+		{"op": "replace", "path": "/min_disk", "value": 10}
+	]`, json.RawMessage(update))
+	th.AssertByteArrayEquals(t, data, uploaded)
+}
+
+func TestUploadRaw(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var update string
+	var uploaded []byte
+	HandleUploadSuccessfully(t, fakeServer, "raw", &update, &uploaded)
+
+	data := bytes.Repeat([]byte{0xeb, 0x63, 0x90}, 1024*1024)
+	info, err := formatinspector.Upload(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", bytes.NewReader(data), formatinspector.UploadOpts{
+		Size:            2*gib + 1,
+		ContainerFormat: "ovf",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, formatinspector.Info{Format: formatinspector.FormatRaw, VirtualSize: 2*gib + 1}, *info)
+	th.AssertJSONEquals(t, `[
+		{"op": "replace", "path": "/container_format", "value": "ovf"},
+		{"op": "replace", "path": "/min_disk", "value": 3}
+	]`, json.RawMessage(update))
+	th.AssertEquals(t, len(data), len(uploaded))
+}
+
+func TestUploadRejected(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var update string
+	var uploaded []byte
+	HandleUploadSuccessfully(t, fakeServer, "raw", &update, &uploaded)
+
+	_, err := formatinspector.Upload(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", bytes.NewReader(qcow2Image(3, 0, 0, gib)), formatinspector.UploadOpts{})
+	var mismatch formatinspector.ErrFormatMismatch
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected an ErrFormatMismatch, got %v", err)
+	}
+	th.AssertEquals(t, formatinspector.FormatRaw, mismatch.Expected)
+	th.AssertEquals(t, formatinspector.FormatQCOW2, mismatch.Actual)
+
+	_, err = formatinspector.Upload(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", bytes.NewReader(vhdImage(gib)), formatinspector.UploadOpts{
+		AllowedFormats: []formatinspector.Format{formatinspector.FormatQCOW2, formatinspector.FormatRaw},
+	})
+	var notAllowed formatinspector.ErrFormatNotAllowed
+	if !errors.As(err, &notAllowed) {
+		t.Fatalf("expected an ErrFormatNotAllowed, got %v", err)
+	}
+
+	_, err = formatinspector.Upload(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", bytes.NewReader(qcow2Image(3, 0x200, 0, gib)), formatinspector.UploadOpts{})
+	var unsafe formatinspector.ErrUnsafeImage
+	if !errors.As(err, &unsafe) {
+		t.Fatalf("expected an ErrUnsafeImage, got %v", err)
+	}
+
+	th.AssertEquals(t, "", update)
+	th.AssertEquals(t, 0, len(uploaded))
+}
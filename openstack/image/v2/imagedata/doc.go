@@ -47,5 +47,26 @@ Example to Download Image Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Download Image Data in Parallel
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	f, err := os.Create("/path/to/image/file")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	// The data is checked against the os_hash_value of the image once
+	// downloaded.
+	downloadOpts := imagedata.DownloadParallelOpts{
+		Concurrency: 8,
+	}
+
+	_, err = imagedata.DownloadParallel(context.TODO(), imageClient, imageID, f, downloadOpts)
+	if err != nil {
+		panic(err)
+	}
 */
 package imagedata
@@ -1,9 +1,12 @@
 package testing
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	th "github.com/gophercloud/gophercloud/v2/testhelper"
 	"github.com/gophercloud/gophercloud/v2/testhelper/client"
@@ -55,3 +58,38 @@ func HandleGetImageDataSuccessfully(t *testing.T, fakeServer th.FakeServer) {
 		th.AssertNoErr(t, err)
 	})
 }
+
+// ParallelDownloadContent is the image data served by
+// HandleDownloadParallelSuccessfully.
+var ParallelDownloadContent = []byte("Successful download with Gophercloud")
+
+// HandleDownloadParallelSuccessfully creates HTTP handlers which serve an
+// image, with the given hash, and ranges of ParallelDownloadContent as its
+// data.
+func HandleDownloadParallelSuccessfully(t *testing.T, fakeServer th.FakeServer, hashAlgo, hashValue string) {
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+			"status": "active",
+			"size": %d,
+			"checksum": "088f69db1a253bb6fe8963a95671f5ab",
+			"os_hash_algo": %q,
+			"os_hash_value": %q
+		}`, len(ParallelDownloadContent), hashAlgo, hashValue)
+	})
+
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		if r.Header.Get("Range") == "" {
+			t.Errorf("expected a Range header")
+		}
+
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(ParallelDownloadContent))
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imagedata"
@@ -104,3 +106,55 @@ func TestDownload(t *testing.T) {
 
 	th.AssertByteArrayEquals(t, []byte{34, 87, 0, 23, 23, 23, 56, 255, 254, 0}, bs)
 }
+
+func TestDownloadParallel(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDownloadParallelSuccessfully(t, fakeServer, "sha512", "62ee60f8e1c23c92e456a2acb7b97bf8402355e1e1c3cb2857bf02af352d8ae9a9c666ae8a4dbc31c03654602155d2730a9813e67fa4f1d79112ab49e8415a75")
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "image"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	n, err := imagedata.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", f, imagedata.DownloadParallelOpts{
+		ChunkSize:   8,
+		Concurrency: 3,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(ParallelDownloadContent)), n)
+
+	content, err := os.ReadFile(f.Name())
+	th.AssertNoErr(t, err)
+	th.AssertByteArrayEquals(t, ParallelDownloadContent, content)
+}
+
+func TestDownloadParallelChecksumMismatch(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDownloadParallelSuccessfully(t, fakeServer, "sha256", "c3514bf0056180d09376462a7a1b4f213c1d6e8ea67fae5c25099c6fd3d8274b")
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "image"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	_, err = imagedata.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", f, imagedata.DownloadParallelOpts{})
+	mismatch, ok := err.(imagedata.ErrChecksumMismatch)
+	if !ok {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	th.AssertEquals(t, "sha256", mismatch.Algorithm)
+	th.AssertEquals(t, "c3514bf0056180d09376462a7a1b4f213c1d6e8ea67fae5c25099c6fd3d8274b", mismatch.Expected)
+}
+
+func TestDownloadParallelFallsBackToChecksum(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDownloadParallelSuccessfully(t, fakeServer, "unsupported", "abc")
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "image"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	_, err = imagedata.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", f, imagedata.DownloadParallelOpts{})
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,130 @@
+package imagedata
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/rangedownload"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+)
+
+// hashes are the os_hash_algo values which can be verified by
+// DownloadParallel.
+var hashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// ErrChecksumMismatch is the error returned when the checksum of downloaded
+// image data does not match the one of the image.
+type ErrChecksumMismatch struct {
+	gophercloud.BaseError
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("downloaded image data has %s checksum %q, expected %q", e.Algorithm, e.Actual, e.Expected)
+}
+
+// DownloadParallelOpts is a structure that holds parameters for downloading
+// image data with DownloadParallel.
+type DownloadParallelOpts struct {
+	// ChunkSize is the size of the ranges requested. Defaults to 16 MiB.
+	ChunkSize int64
+
+	// Concurrency is the number of ranges downloaded concurrently. Defaults
+	// to 4.
+	Concurrency int
+
+	// MaxRetries is the number of times the download of a range is retried,
+	// starting from the last byte received. Defaults to 3. Set it to a
+	// negative value to disable retries.
+	MaxRetries int
+
+	// Offset resumes an interrupted download: the data before Offset is
+	// expected to be already written.
+	Offset int64
+
+	// SkipVerify disables the verification of the checksum of the data.
+	SkipVerify bool
+}
+
+// DownloadParallel downloads the data of an image into w by requesting
+// concurrent ranges of it.
+//
+// Unless SkipVerify is set, w must also implement io.ReaderAt, like *os.File,
+// so that the checksum of the data can be read back and checked against the
+// os_hash_value of the image, or against its MD5 checksum when the hash
+// algorithm is not supported.
+//
+// DownloadParallel returns the offset up to which the data is written without
+// gap. When the download fails, it can be passed as DownloadParallelOpts.Offset
+// to resume it.
+func DownloadParallel(ctx context.Context, client *gophercloud.ServiceClient, id string, w io.WriterAt, opts DownloadParallelOpts) (int64, error) {
+	r, verify := w.(io.ReaderAt)
+	if !verify && !opts.SkipVerify {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "w"
+		err.Value = "io.WriterAt which does not implement io.ReaderAt"
+		err.Info = "the destination must implement io.ReaderAt to verify the checksum of the image data, unless SkipVerify is set"
+		return opts.Offset, err
+	}
+
+	image, err := images.Get(ctx, client, id).Extract()
+	if err != nil {
+		return opts.Offset, err
+	}
+
+	fetch := func(ctx context.Context, offset, length int64) (io.ReadCloser, http.Header, error) {
+		resp, err := client.Get(ctx, downloadURL(client, id), nil, &gophercloud.RequestOpts{
+			MoreHeaders:      map[string]string{"Range": rangedownload.Range(offset, length)},
+			OkCodes:          []int{206},
+			KeepResponseBody: true,
+		})
+		return gophercloud.ParseResponse(resp, err)
+	}
+
+	n, err := rangedownload.Download(ctx, w, fetch, rangedownload.Opts{
+		Size:        image.SizeBytes,
+		Offset:      opts.Offset,
+		ChunkSize:   opts.ChunkSize,
+		Concurrency: opts.Concurrency,
+		MaxRetries:  opts.MaxRetries,
+	})
+	if err != nil || opts.SkipVerify {
+		return n, err
+	}
+
+	algorithm, expected := "md5", image.Checksum
+	hashAlgo, _ := image.Properties["os_hash_algo"].(string)
+	hashValue, _ := image.Properties["os_hash_value"].(string)
+	if _, ok := hashes[hashAlgo]; ok && hashValue != "" {
+		algorithm, expected = hashAlgo, hashValue
+	}
+	if expected == "" {
+		return n, nil
+	}
+
+	sum, err := rangedownload.Hash(r, image.SizeBytes, hashes[algorithm]())
+	if err != nil {
+		return n, err
+	}
+	if sum != expected {
+		return 0, ErrChecksumMismatch{Algorithm: algorithm, Expected: expected, Actual: sum}
+	}
+
+	return n, nil
+}
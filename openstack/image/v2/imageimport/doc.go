@@ -23,5 +23,60 @@ Example to Create a new image import
 	if err != nil {
 	  panic(err)
 	}
+
+Example to Publish an Image from a Local File
+
+	f, err := os.Open("cirros-0.4.0-x86_64-disk.img")
+	if err != nil {
+	  panic(err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+	  panic(err)
+	}
+
+	publishOpts := imageimport.PublishOpts{
+	  Image: images.CreateOpts{
+	    Name:            "cirros",
+	    ContainerFormat: "bare",
+	    DiskFormat:      "qcow2",
+	  },
+	  Method: imageimport.GlanceDirectMethod,
+	  Data:   f,
+	  Size:   fi.Size(),
+	  Progress: func(staged, size int64) {
+	    fmt.Printf("staged %d/%d bytes\n", staged, size)
+	  },
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Minute)
+	defer cancel()
+
+	image, err := imageimport.Publish(ctx, imagesClient, publishOpts)
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Copy an Image to Additional Stores
+
+	allStoresMustSucceed := false
+	publishOpts := imageimport.PublishOpts{
+	  ImageID:              "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+	  Method:               imageimport.CopyImageMethod,
+	  Stores:               []string{"ceph", "swift"},
+	  AllStoresMustSucceed: &allStoresMustSucceed,
+	}
+
+	image, err := imageimport.Publish(context.TODO(), imagesClient, publishOpts)
+	if err != nil {
+	  var importErr imageimport.ErrImportFailed
+	  if errors.As(err, &importErr) {
+	    fmt.Printf("image %s could not be copied to %v\n", importErr.ImageID, importErr.FailedStores)
+	  } else {
+	    panic(err)
+	  }
+	}
 */
 package imageimport
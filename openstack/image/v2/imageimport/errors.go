@@ -0,0 +1,46 @@
+package imageimport
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+)
+
+// ErrImportFailed is the error returned by WaitForImport and Publish when
+// the import of an image fails, or when its data could not be imported to
+// some of the stores.
+type ErrImportFailed struct {
+	gophercloud.BaseError
+	ImageID string
+
+	// Status is the status of the image after the failure.
+	Status images.ImageStatus
+
+	// FailedStores are the stores the data could not be imported to, as
+	// reported in the os_glance_failed_import property of the image.
+	FailedStores []string
+}
+
+func (e ErrImportFailed) Error() string {
+	msg := fmt.Sprintf("import of image %s failed", e.ImageID)
+	if len(e.FailedStores) > 0 {
+		msg += fmt.Sprintf(" in stores %s", strings.Join(e.FailedStores, ", "))
+	}
+	return msg + fmt.Sprintf(" (image status: %s)", e.Status)
+}
+
+// ErrChecksumMismatch is the error returned by Publish when the checksum of
+// the imported image does not match the one of the uploaded data.
+type ErrChecksumMismatch struct {
+	gophercloud.BaseError
+	ImageID   string
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("imported image %s has %s checksum %q, expected %q", e.ImageID, e.Algorithm, e.Actual, e.Expected)
+}
@@ -15,6 +15,15 @@ const (
 
 	// WebDownloadMethod represents web-download Import API method.
 	WebDownloadMethod ImportMethod = "web-download"
+
+	// CopyImageMethod represents copy-image Import API method, which copies
+	// the data of an active image to additional stores.
+	CopyImageMethod ImportMethod = "copy-image"
+
+	// GlanceDownloadMethod represents glance-download Import API method,
+	// which downloads the data of an image from the Image service of another
+	// region.
+	GlanceDownloadMethod ImportMethod = "glance-download"
 )
 
 // Get retrieves Import API information data.
@@ -32,7 +41,33 @@ type CreateOptsBuilder interface {
 // CreateOpts specifies parameters of a new image import.
 type CreateOpts struct {
 	Name ImportMethod `json:"name"`
-	URI  string       `json:"uri"`
+
+	// URI is the URL of the data, for the web-download method.
+	URI string `json:"uri,omitempty"`
+
+	// GlanceImageID is the ID of the image in the other region, for the
+	// glance-download method.
+	GlanceImageID string `json:"glance_image_id,omitempty"`
+
+	// GlanceRegion is the region of the image, for the glance-download
+	// method.
+	GlanceRegion string `json:"glance_region,omitempty"`
+
+	// GlanceServiceInterface is the interface of the Image service endpoint
+	// of the other region, for the glance-download method. Defaults to
+	// public.
+	GlanceServiceInterface string `json:"glance_service_interface,omitempty"`
+
+	// Stores are the stores to import the data to, when multiple stores are
+	// enabled. The copy-image method requires Stores or AllStores.
+	Stores []string `json:"-"`
+
+	// AllStores imports the data to all the stores.
+	AllStores *bool `json:"-"`
+
+	// AllStoresMustSucceed fails the import when the data cannot be imported
+	// to one of the stores. Glance defaults to true.
+	AllStoresMustSucceed *bool `json:"-"`
 }
 
 // ToImportCreateMap constructs a request body from CreateOpts.
@@ -41,7 +76,17 @@ func (opts CreateOpts) ToImportCreateMap() (map[string]any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return map[string]any{"method": b}, nil
+	r := map[string]any{"method": b}
+	if len(opts.Stores) > 0 {
+		r["stores"] = opts.Stores
+	}
+	if opts.AllStores != nil {
+		r["all_stores"] = *opts.AllStores
+	}
+	if opts.AllStoresMustSucceed != nil {
+		r["all_stores_must_succeed"] = *opts.AllStoresMustSucceed
+	}
+	return r, nil
 }
 
 // Create requests the creation of a new image import on the server.
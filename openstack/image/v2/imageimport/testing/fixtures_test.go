@@ -1,5 +1,16 @@
 package testing
 
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
 // ImportGetResult represents raw server response on a Get request.
 const ImportGetResult = `
 {
@@ -23,3 +34,67 @@ const ImportCreateRequest = `
     }
 }
 `
+
+// fakeGlance is an Image service holding a single image, which serves the
+// requests of the import workflow.
+type fakeGlance struct {
+	mu sync.Mutex
+
+	// image is the current representation of the image.
+	image map[string]any
+
+	// staged is the data staged for the image.
+	staged []byte
+
+	// importRequest is the body of the import request.
+	importRequest json.RawMessage
+
+	// afterImport are the representations of the image returned by the
+	// successive get requests after the import request.
+	afterImport []map[string]any
+}
+
+func (g *fakeGlance) register(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.image = map[string]any{"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", "status": "queued"}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(g.image)
+	})
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/stage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+		b, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.staged = b
+		g.image["status"] = "uploading"
+		w.WriteHeader(http.StatusNoContent)
+	})
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		b, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.importRequest = b
+		g.image["status"] = "importing"
+		w.WriteHeader(http.StatusAccepted)
+	})
+	fakeServer.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if g.importRequest != nil && len(g.afterImport) > 0 {
+			g.image = g.afterImport[0]
+			g.afterImport = g.afterImport[1:]
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(g.image)
+	})
+}
@@ -1,12 +1,18 @@
 package testing
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imageimport"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
 	th "github.com/gophercloud/gophercloud/v2/testhelper"
 	"github.com/gophercloud/gophercloud/v2/testhelper/client"
 )
@@ -59,3 +65,237 @@ func TestCreate(t *testing.T) {
 	err := imageimport.Create(context.TODO(), client.ServiceClient(fakeServer), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", opts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateOpts(t *testing.T) {
+	allStoresMustSucceed := false
+	opts := imageimport.CreateOpts{
+		Name:                 imageimport.CopyImageMethod,
+		Stores:               []string{"ceph", "swift"},
+		AllStoresMustSucceed: &allStoresMustSucceed,
+	}
+	b, err := opts.ToImportCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]any{
+		"method":                  map[string]any{"name": "copy-image"},
+		"stores":                  []string{"ceph", "swift"},
+		"all_stores_must_succeed": false,
+	}, b)
+
+	opts = imageimport.CreateOpts{
+		Name:          imageimport.GlanceDownloadMethod,
+		GlanceImageID: "c6fa4bd0-0b1c-4c4d-9e8e-5f3a8d7e1b2a",
+		GlanceRegion:  "RegionTwo",
+	}
+	b, err = opts.ToImportCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]any{
+		"method": map[string]any{
+			"name":            "glance-download",
+			"glance_image_id": "c6fa4bd0-0b1c-4c4d-9e8e-5f3a8d7e1b2a",
+			"glance_region":   "RegionTwo",
+		},
+	}, b)
+}
+
+func TestPublish(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	data := bytes.Repeat([]byte("image data"), 1000)
+	sha := sha512.Sum512(data)
+	md := md5.Sum(data)
+
+	glance := &fakeGlance{
+		afterImport: []map[string]any{
+			{
+				"id":                            "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				"status":                        "importing",
+				"os_glance_importing_to_stores": "ceph",
+			},
+			{
+				"id":                            "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				"status":                        "active",
+				"checksum":                      hex.EncodeToString(md[:]),
+				"os_hash_algo":                  "sha512",
+				"os_hash_value":                 hex.EncodeToString(sha[:]),
+				"os_glance_importing_to_stores": "",
+				"os_glance_failed_import":       "",
+				"stores":                        "ceph",
+			},
+		},
+	}
+	glance.register(t, fakeServer)
+
+	var staged int64
+	image, err := imageimport.Publish(context.TODO(), client.ServiceClient(fakeServer), imageimport.PublishOpts{
+		Image: images.CreateOpts{
+			Name:            "cirros",
+			ContainerFormat: "bare",
+			DiskFormat:      "qcow2",
+		},
+		Data: bytes.NewReader(data),
+		Size: int64(len(data)),
+		Progress: func(n, size int64) {
+			th.AssertEquals(t, int64(len(data)), size)
+			staged = n
+		},
+		Stores: []string{"ceph"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", image.ID)
+	th.AssertEquals(t, images.ImageStatusActive, image.Status)
+	th.AssertEquals(t, int64(len(data)), staged)
+	th.AssertByteArrayEquals(t, data, glance.staged)
+	th.AssertJSONEquals(t, `{"method": {"name": "glance-direct"}, "stores": ["ceph"]}`, glance.importRequest)
+}
+
+func TestPublishChecksumMismatch(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	glance := &fakeGlance{
+		afterImport: []map[string]any{
+			{
+				"id":            "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				"status":        "active",
+				"checksum":      "7c8d5b3a1c2e4f6a8b9d0e1f2a3b4c5d",
+				"os_hash_algo":  "sha256",
+				"os_hash_value": "c3514bf0056180d09376462a7a1b4f213c1d6e8ea67fae5c25099c6fd3d8274b",
+			},
+		},
+	}
+	glance.register(t, fakeServer)
+
+	data := []byte("image data")
+	md := md5.Sum(data)
+
+	image, err := imageimport.Publish(context.TODO(), client.ServiceClient(fakeServer), imageimport.PublishOpts{
+		Image: images.CreateOpts{Name: "cirros"},
+		Data:  bytes.NewReader(data),
+	})
+	th.AssertEquals(t, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", image.ID)
+
+	// The image was hashed with another algorithm, so its MD5 checksum is
+	// compared.
+	var mismatch imageimport.ErrChecksumMismatch
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected an ErrChecksumMismatch, got %v", err)
+	}
+	th.AssertEquals(t, "md5", mismatch.Algorithm)
+	th.AssertEquals(t, hex.EncodeToString(md[:]), mismatch.Expected)
+	th.AssertEquals(t, "7c8d5b3a1c2e4f6a8b9d0e1f2a3b4c5d", mismatch.Actual)
+}
+
+func TestPublishStoreFailure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	glance := &fakeGlance{
+		image: map[string]any{"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", "status": "active"},
+		afterImport: []map[string]any{
+			{
+				"id":                            "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				"status":                        "active",
+				"os_glance_importing_to_stores": "",
+				"os_glance_failed_import":       "swift,file",
+				"stores":                        "ceph",
+			},
+		},
+	}
+	glance.register(t, fakeServer)
+
+	allStoresMustSucceed := false
+	image, err := imageimport.Publish(context.TODO(), client.ServiceClient(fakeServer), imageimport.PublishOpts{
+		ImageID:              "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+		Method:               imageimport.CopyImageMethod,
+		AllStores:            true,
+		AllStoresMustSucceed: &allStoresMustSucceed,
+	})
+	th.AssertEquals(t, images.ImageStatusActive, image.Status)
+	th.AssertJSONEquals(t, `{"method": {"name": "copy-image"}, "all_stores": true, "all_stores_must_succeed": false}`, glance.importRequest)
+
+	var failed imageimport.ErrImportFailed
+	if !errors.As(err, &failed) {
+		t.Fatalf("expected an ErrImportFailed, got %v", err)
+	}
+	th.AssertDeepEquals(t, []string{"swift", "file"}, failed.FailedStores)
+	th.AssertEquals(t, "import of image da3b75d9-3f4a-40e7-8a2c-bfab23927dea failed in stores swift, file (image status: active)", err.Error())
+}
+
+func TestPublishImportQueued(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	glance := &fakeGlance{
+		afterImport: []map[string]any{
+			// The import task is not started yet.
+			{"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", "status": "queued"},
+			{"id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", "status": "active"},
+		},
+	}
+	glance.register(t, fakeServer)
+
+	image, err := imageimport.Publish(context.TODO(), client.ServiceClient(fakeServer), imageimport.PublishOpts{
+		Image:  images.CreateOpts{Name: "cirros"},
+		Method: imageimport.WebDownloadMethod,
+		URI:    "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, images.ImageStatusActive, image.Status)
+}
+
+func TestPublishImportFailed(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	glance := &fakeGlance{
+		afterImport: []map[string]any{
+			// The image is still queued while the import task is not
+			// started.
+			{
+				"id":                            "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				"status":                        "queued",
+				"os_glance_importing_to_stores": "file",
+				"os_glance_failed_import":       "",
+			},
+			// The image goes back to queued when the import fails.
+			{
+				"id":                            "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+				"status":                        "queued",
+				"os_glance_importing_to_stores": "",
+				"os_glance_failed_import":       "file",
+			},
+		},
+	}
+	glance.register(t, fakeServer)
+
+	_, err := imageimport.Publish(context.TODO(), client.ServiceClient(fakeServer), imageimport.PublishOpts{
+		Image:  images.CreateOpts{Name: "cirros"},
+		Method: imageimport.WebDownloadMethod,
+		URI:    "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img",
+	})
+	var failed imageimport.ErrImportFailed
+	if !errors.As(err, &failed) {
+		t.Fatalf("expected an ErrImportFailed, got %v", err)
+	}
+	th.AssertEquals(t, images.ImageStatusQueued, failed.Status)
+	th.AssertDeepEquals(t, []string{"file"}, failed.FailedStores)
+	th.AssertJSONEquals(t, `{"method": {"name": "web-download", "uri": "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img"}}`, glance.importRequest)
+}
+
+func TestPublishOpts(t *testing.T) {
+	for _, opts := range []imageimport.PublishOpts{
+		{Data: bytes.NewReader(nil)},
+		{Image: images.CreateOpts{}},
+		{Image: images.CreateOpts{}, Method: imageimport.WebDownloadMethod},
+		{Image: images.CreateOpts{}, Method: imageimport.GlanceDownloadMethod, GlanceImageID: "c6fa4bd0-0b1c-4c4d-9e8e-5f3a8d7e1b2a"},
+		{Image: images.CreateOpts{}, Method: imageimport.CopyImageMethod, Stores: []string{"ceph"}},
+		{ImageID: "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", Method: imageimport.CopyImageMethod},
+		{Image: images.CreateOpts{}, Data: bytes.NewReader(nil), HashAlgorithm: "crc32"},
+		{Image: images.CreateOpts{}, Method: "unknown"},
+	} {
+		if _, err := imageimport.Publish(context.TODO(), nil, opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package imageimport
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imagedata"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+)
+
+// DefaultHashAlgorithm is the hash algorithm computed by Publish when
+// PublishOpts.HashAlgorithm is not set. It is the default os_hash_algo of
+// Glance.
+const DefaultHashAlgorithm = "sha512"
+
+// hashes are the os_hash_algo values which can be computed by Publish.
+var hashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// WaitForImport will continually poll the image until the import of its data
+// completes, that is until the image is active and no longer importing data
+// to any store. It must be called once Create returned. It returns an
+// ErrImportFailed when the image is killed or deleted, or when the data
+// could not be imported to some of the stores, as reported by the
+// os_glance_failed_import property of the image.
+func WaitForImport(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := waitForImport(ctx, client, id)
+	return err
+}
+
+func waitForImport(ctx context.Context, client *gophercloud.ServiceClient, id string) (*images.Image, error) {
+	var image *images.Image
+	err := gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		current, err := images.Get(ctx, client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		image = current
+
+		failed := storeList(current, "os_glance_failed_import")
+		if len(failed) > 0 {
+			return false, ErrImportFailed{ImageID: id, Status: current.Status, FailedStores: failed}
+		}
+
+		switch current.Status {
+		case images.ImageStatusKilled, images.ImageStatusDeleted:
+			return false, ErrImportFailed{ImageID: id, Status: current.Status}
+		case images.ImageStatusActive:
+			// The data of an active image may still be copied to other
+			// stores.
+			return len(storeList(current, "os_glance_importing_to_stores")) == 0, nil
+		}
+
+		// The image stays queued or uploading until the import task
+		// starts, and is importing while it runs.
+		return false, nil
+	})
+	return image, err
+}
+
+// storeList returns the stores listed in a comma-separated property of an
+// image.
+func storeList(image *images.Image, property string) []string {
+	v, _ := image.Properties[property].(string)
+	var stores []string
+	for _, store := range strings.Split(v, ",") {
+		if store = strings.TrimSpace(store); store != "" {
+			stores = append(stores, store)
+		}
+	}
+	return stores
+}
+
+// PublishOpts is a structure that holds parameters for publishing an image
+// with Publish.
+type PublishOpts struct {
+	// Image creates the image record. Either Image or ImageID must be set.
+	Image images.CreateOptsBuilder
+
+	// ImageID is the ID of an existing image, which must be queued, or
+	// active for the copy-image method.
+	ImageID string
+
+	// Method is the import method. Defaults to glance-direct.
+	Method ImportMethod
+
+	// Data is the data of the image, staged for the glance-direct method.
+	Data io.Reader
+
+	// Size is the size of Data, if known. It is only passed to Progress.
+	Size int64
+
+	// Progress, if set, is called with the number of bytes staged so far
+	// each time a part of Data is read.
+	Progress func(staged, size int64)
+
+	// HashAlgorithm is the algorithm of the hash computed over Data and
+	// compared to the os_hash_value of the imported image. It should match
+	// the hashing_algorithm configured in Glance. The MD5 checksum of the
+	// image is compared instead when the image has a different os_hash_algo.
+	// Defaults to sha512.
+	HashAlgorithm string
+
+	// SkipVerify disables the comparison of the checksum of Data with the one
+	// of the imported image.
+	SkipVerify bool
+
+	// URI is the URL of the data, for the web-download method.
+	URI string
+
+	// GlanceImageID, GlanceRegion and GlanceServiceInterface identify the
+	// image to download from another region, for the glance-download method.
+	GlanceImageID          string
+	GlanceRegion           string
+	GlanceServiceInterface string
+
+	// Stores are the stores to import the data to, when multiple stores are
+	// enabled. The copy-image method requires Stores or AllStores.
+	Stores []string
+
+	// AllStores imports the data to all the stores.
+	AllStores bool
+
+	// AllStoresMustSucceed fails the import when the data cannot be imported
+	// to one of the stores. Glance defaults to true.
+	AllStoresMustSucceed *bool
+}
+
+func (opts PublishOpts) validate() error {
+	if opts.Image == nil && opts.ImageID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "imageimport.PublishOpts.Image"
+		return err
+	}
+	switch opts.Method {
+	case GlanceDirectMethod:
+		if opts.Data == nil {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "imageimport.PublishOpts.Data"
+			return err
+		}
+	case WebDownloadMethod:
+		if opts.URI == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "imageimport.PublishOpts.URI"
+			return err
+		}
+	case GlanceDownloadMethod:
+		if opts.GlanceImageID == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "imageimport.PublishOpts.GlanceImageID"
+			return err
+		}
+		if opts.GlanceRegion == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "imageimport.PublishOpts.GlanceRegion"
+			return err
+		}
+	case CopyImageMethod:
+		if opts.ImageID == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "imageimport.PublishOpts.ImageID"
+			return err
+		}
+		if len(opts.Stores) == 0 && !opts.AllStores {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "imageimport.PublishOpts.Stores"
+			return err
+		}
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "imageimport.PublishOpts.Method"
+		err.Value = opts.Method
+		return err
+	}
+	if opts.Method == GlanceDirectMethod && !opts.SkipVerify {
+		if _, ok := hashes[opts.HashAlgorithm]; !ok {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "imageimport.PublishOpts.HashAlgorithm"
+			err.Value = opts.HashAlgorithm
+			return err
+		}
+	}
+	return nil
+}
+
+// Publish runs the whole workflow of publishing an image with the import
+// API: it creates the image record unless PublishOpts.ImageID is set, stages
+// the data for the glance-direct method, requests the import, and waits for
+// the import to complete.
+//
+// For the glance-direct method, the hash of the data is computed while it is
+// staged, and compared to the one of the imported image. An
+// ErrChecksumMismatch is returned when they differ.
+//
+// The image is returned along with an error when the failure happens after
+// it is created, so that it can be inspected or deleted. An ErrImportFailed
+// is returned when the import fails, or when the data could not be imported
+// to some of the stores while AllStoresMustSucceed is false.
+//
+// Use a context with a deadline to bound the wait for the import.
+func Publish(ctx context.Context, client *gophercloud.ServiceClient, opts PublishOpts) (*images.Image, error) {
+	if opts.Method == "" {
+		opts.Method = GlanceDirectMethod
+	}
+	if opts.HashAlgorithm == "" {
+		opts.HashAlgorithm = DefaultHashAlgorithm
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	id := opts.ImageID
+	var image *images.Image
+	if id == "" {
+		var err error
+		image, err = images.Create(ctx, client, opts.Image).Extract()
+		if err != nil {
+			return nil, err
+		}
+		id = image.ID
+	}
+
+	var checksum, digest hash.Hash
+	if opts.Method == GlanceDirectMethod {
+		var w []io.Writer
+		if !opts.SkipVerify {
+			checksum, digest = md5.New(), hashes[opts.HashAlgorithm]()
+			w = append(w, checksum, digest)
+		}
+		data := &progressReader{r: opts.Data, size: opts.Size, progress: opts.Progress}
+		var r io.Reader = data
+		if len(w) > 0 {
+			r = io.TeeReader(data, io.MultiWriter(w...))
+		}
+		if err := imagedata.Stage(ctx, client, id, r).ExtractErr(); err != nil {
+			return image, err
+		}
+	}
+
+	createOpts := CreateOpts{
+		Name:                   opts.Method,
+		URI:                    opts.URI,
+		GlanceImageID:          opts.GlanceImageID,
+		GlanceRegion:           opts.GlanceRegion,
+		GlanceServiceInterface: opts.GlanceServiceInterface,
+		Stores:                 opts.Stores,
+		AllStoresMustSucceed:   opts.AllStoresMustSucceed,
+	}
+	if opts.AllStores {
+		createOpts.AllStores = &opts.AllStores
+	}
+	if err := Create(ctx, client, id, createOpts).ExtractErr(); err != nil {
+		return image, err
+	}
+
+	current, err := waitForImport(ctx, client, id)
+	if current != nil {
+		image = current
+	}
+	if err != nil {
+		return image, err
+	}
+
+	if digest != nil {
+		if err := verify(image, opts.HashAlgorithm, digest, checksum); err != nil {
+			return image, err
+		}
+	}
+	return image, nil
+}
+
+// verify compares the hashes computed over the staged data with the ones of
+// the imported image.
+func verify(image *images.Image, algorithm string, digest, checksum hash.Hash) error {
+	algo, _ := image.Properties["os_hash_algo"].(string)
+	value, _ := image.Properties["os_hash_value"].(string)
+	if algo == algorithm && value != "" {
+		if actual := hex.EncodeToString(digest.Sum(nil)); value != actual {
+			return ErrChecksumMismatch{ImageID: image.ID, Algorithm: algo, Expected: actual, Actual: value}
+		}
+		return nil
+	}
+	if image.Checksum != "" {
+		if actual := hex.EncodeToString(checksum.Sum(nil)); image.Checksum != actual {
+			return ErrChecksumMismatch{ImageID: image.ID, Algorithm: "md5", Expected: actual, Actual: image.Checksum}
+		}
+	}
+	return nil
+}
+
+// progressReader reports the number of bytes read from r.
+type progressReader struct {
+	r        io.Reader
+	n        int64
+	size     int64
+	progress func(staged, size int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.n += int64(n)
+		if r.progress != nil {
+			r.progress(r.n, r.size)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,92 @@
+/*
+Package namespaces enables management of the metadata definition namespaces of
+the Image service. A namespace groups the definitions of properties and
+objects which can be applied to some types of resources, like images or
+flavors, and are used by dashboards to help setting them.
+
+The properties, objects and resource type associations of a namespace are
+managed by the properties, objects and resourcetypes packages.
+
+Example to List Namespaces
+
+	listOpts := namespaces.ListOpts{
+		ResourceTypes: []string{"OS::Glance::Image"},
+		Visibility:    namespaces.VisibilityPublic,
+	}
+
+	allPages, err := namespaces.List(imagesClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allNamespaces, err := namespaces.ExtractNamespaces(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, namespace := range allNamespaces {
+		fmt.Printf("%+v\n", namespace)
+	}
+
+Example to Get a Namespace with the Property Names of a Resource Type
+
+	getOpts := namespaces.GetOpts{
+		ResourceType: "OS::Glance::Image",
+	}
+
+	namespace, err := namespaces.Get(context.TODO(), imagesClient, "OS::Compute::Watchdog", getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, property := range properties.ToList(namespace.Properties) {
+		fmt.Printf("%s: %s\n", property.Name, property.Title)
+	}
+
+Example to Create a Namespace
+
+	createOpts := namespaces.CreateOpts{
+		Namespace:   "OS::Compute::Watchdog",
+		DisplayName: "Watchdog Behavior",
+		Visibility:  namespaces.VisibilityPublic,
+		ResourceTypeAssociations: []resourcetypes.AssociateOpts{
+			{Name: "OS::Glance::Image"},
+			{Name: "OS::Nova::Flavor", Prefix: "hw:"},
+		},
+		Properties: map[string]properties.Property{
+			"watchdog_action": {
+				Title: "Watchdog Action",
+				Type:  "string",
+				Enum:  []any{"disabled", "reset", "poweroff", "pause", "none"},
+			},
+		},
+	}
+
+	namespace, err := namespaces.Create(context.TODO(), imagesClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Namespace
+
+	protected := true
+	updateOpts := namespaces.UpdateOpts{
+		Namespace:   "OS::Compute::Watchdog",
+		DisplayName: "Watchdog Behavior",
+		Visibility:  namespaces.VisibilityPublic,
+		Protected:   &protected,
+	}
+
+	namespace, err := namespaces.Update(context.TODO(), imagesClient, "OS::Compute::Watchdog", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Namespace
+
+	err := namespaces.Delete(context.TODO(), imagesClient, "OS::Compute::Watchdog").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package namespaces
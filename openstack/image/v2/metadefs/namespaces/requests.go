@@ -0,0 +1,225 @@
+package namespaces
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/resourcetypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Visibility is the visibility of a namespace.
+type Visibility string
+
+const (
+	// VisibilityPublic makes a namespace visible to all the projects.
+	VisibilityPublic Visibility = "public"
+
+	// VisibilityPrivate makes a namespace visible to its owner only.
+	VisibilityPrivate Visibility = "private"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNamespaceListQuery() (string, error)
+}
+
+// ListOpts allows the filtering, sorting and paging of the namespaces.
+type ListOpts struct {
+	// Limit is the maximum number of namespaces per page.
+	Limit int `q:"limit"`
+
+	// Marker is the namespace after which the page starts.
+	Marker string `q:"marker"`
+
+	// SortKey is the attribute to sort by: namespace, created_at or
+	// updated_at.
+	SortKey string `q:"sort_key"`
+
+	// SortDir is the sort direction: asc or desc.
+	SortDir string `q:"sort_dir"`
+
+	// Visibility filters the namespaces by visibility.
+	Visibility Visibility `q:"visibility"`
+
+	// ResourceTypes filters the namespaces associated with any of the
+	// resource types.
+	ResourceTypes []string
+}
+
+// ToNamespaceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNamespaceListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	params := q.Query()
+	if len(opts.ResourceTypes) > 0 {
+		params.Add("resource_types", strings.Join(opts.ResourceTypes, ","))
+	}
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List returns the namespaces visible to the user.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToNamespaceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NamespacePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to the Get
+// request.
+type GetOptsBuilder interface {
+	ToNamespaceGetQuery() (string, error)
+}
+
+// GetOpts represents options used to retrieve a namespace.
+type GetOpts struct {
+	// ResourceType returns the names of the properties with the prefix of
+	// the association of the namespace with the resource type.
+	ResourceType string `q:"resource_type"`
+}
+
+// ToNamespaceGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToNamespaceGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get retrieves a namespace, with its properties and objects.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, namespace string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, namespace)
+	if opts != nil {
+		query, err := opts.ToNamespaceGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNamespaceCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a namespace.
+type CreateOpts struct {
+	// Namespace is the name of the namespace, like OS::Compute::Watchdog.
+	Namespace string `json:"namespace" required:"true"`
+
+	// DisplayName is the display name of the namespace.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// Description is the description of the namespace.
+	Description string `json:"description,omitempty"`
+
+	// Visibility is the visibility of the namespace. Defaults to private.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// Protected prevents the deletion of the namespace.
+	Protected *bool `json:"protected,omitempty"`
+
+	// ResourceTypeAssociations are the resource types to associate the
+	// namespace with.
+	ResourceTypeAssociations []resourcetypes.AssociateOpts `json:"resource_type_associations,omitempty"`
+
+	// Properties are the properties of the namespace, keyed by name.
+	Properties map[string]properties.Property `json:"properties,omitempty"`
+
+	// Objects are the objects of the namespace.
+	Objects []objects.CreateOpts `json:"objects,omitempty"`
+}
+
+// ToNamespaceCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToNamespaceCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create creates a namespace, along with its properties, objects and resource
+// type associations.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNamespaceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNamespaceUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a namespace. The attributes
+// which are not set are reset to their defaults. A different Namespace
+// renames the namespace.
+type UpdateOpts struct {
+	// Namespace is the name of the namespace.
+	Namespace string `json:"namespace" required:"true"`
+
+	// DisplayName is the display name of the namespace.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// Description is the description of the namespace.
+	Description string `json:"description,omitempty"`
+
+	// Visibility is the visibility of the namespace.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// Protected prevents the deletion of the namespace.
+	Protected *bool `json:"protected,omitempty"`
+}
+
+// ToNamespaceUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToNamespaceUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update updates a namespace. Its properties, objects and resource type
+// associations are left unchanged.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, namespace string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNamespaceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a namespace, along with its properties, objects and
+// resource type associations.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, namespace string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, namespace), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
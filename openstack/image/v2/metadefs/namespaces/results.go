@@ -0,0 +1,135 @@
+package namespaces
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/resourcetypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Namespace represents a metadata definition namespace, which groups
+// properties and objects applying to some types of resources.
+type Namespace struct {
+	// Namespace is the name of the namespace.
+	Namespace string `json:"namespace"`
+
+	// DisplayName is the display name of the namespace.
+	DisplayName string `json:"display_name"`
+
+	// Description is the description of the namespace.
+	Description string `json:"description"`
+
+	// Visibility is the visibility of the namespace.
+	Visibility Visibility `json:"visibility"`
+
+	// Protected is whether the namespace is protected from deletion.
+	Protected bool `json:"protected"`
+
+	// Owner is the ID of the project owning the namespace.
+	Owner string `json:"owner"`
+
+	// ResourceTypeAssociations are the resource types the namespace is
+	// associated with.
+	ResourceTypeAssociations []resourcetypes.Association `json:"resource_type_associations"`
+
+	// Properties are the properties of the namespace, keyed by name. They are
+	// only returned by Get. Use properties.ToList to get them as a slice.
+	Properties map[string]properties.Property `json:"properties"`
+
+	// Objects are the objects of the namespace. They are only returned by
+	// Get.
+	Objects []objects.Object `json:"objects"`
+
+	// Schema is the path to the JSON schema of the namespace.
+	Schema string `json:"schema"`
+
+	// Self is the path to the namespace.
+	Self string `json:"self"`
+
+	// CreatedAt is the date when the namespace was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the namespace was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Namespace.
+func (r commonResult) Extract() (*Namespace, error) {
+	var s *Namespace
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Namespace.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Namespace.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its Extract
+// method to interpret it as a Namespace.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NamespacePage represents a page of the results of a List request.
+type NamespacePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a NamespacePage contains no Namespaces.
+func (r NamespacePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	namespaces, err := ExtractNamespaces(r)
+	return len(namespaces) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r NamespacePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	if s.Next == "" {
+		return "", nil
+	}
+
+	return nextPageURL(endpointURL, s.Next)
+}
+
+// ExtractNamespaces interprets the results of a single page from a List call,
+// producing a slice of Namespaces.
+func ExtractNamespaces(r pagination.Page) ([]Namespace, error) {
+	var s struct {
+		Namespaces []Namespace `json:"namespaces"`
+	}
+	err := (r.(NamespacePage)).ExtractInto(&s)
+	return s.Namespaces, err
+}
@@ -0,0 +1,234 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/namespaces"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/resourcetypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListResult is the first page of the response of a List request.
+const ListResult = `
+{
+    "namespaces": [
+        {
+            "namespace": "OS::Compute::Watchdog",
+            "display_name": "Watchdog Behavior",
+            "visibility": "public",
+            "protected": true,
+            "owner": "admin",
+            "resource_type_associations": [
+                {
+                    "name": "OS::Glance::Image",
+                    "created_at": "2024-03-12T09:14:35Z"
+                }
+            ],
+            "schema": "/v2/schemas/metadefs/namespace",
+            "self": "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+            "created_at": "2024-03-12T09:14:35Z",
+            "updated_at": "2024-03-12T09:14:35Z"
+        }
+    ],
+    "first": "/metadefs/namespaces?limit=1&resource_types=OS%3A%3AGlance%3A%3AImage",
+    "next": "/metadefs/namespaces?limit=1&marker=OS%3A%3ACompute%3A%3AWatchdog&resource_types=OS%3A%3AGlance%3A%3AImage",
+    "schema": "/v2/schemas/metadefs/namespaces"
+}
+`
+
+// ListLastResult is the last page of the response of a List request.
+const ListLastResult = `
+{
+    "namespaces": [],
+    "first": "/metadefs/namespaces?limit=1&resource_types=OS%3A%3AGlance%3A%3AImage",
+    "schema": "/v2/schemas/metadefs/namespaces"
+}
+`
+
+// CreateRequest is the request body of a Create request.
+const CreateRequest = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog Behavior",
+    "visibility": "public",
+    "resource_type_associations": [
+        {
+            "name": "OS::Glance::Image"
+        }
+    ],
+    "properties": {
+        "hw_watchdog_action": {
+            "title": "Watchdog Action",
+            "type": "string",
+            "enum": ["disabled", "reset"]
+        }
+    }
+}
+`
+
+// GetResult is the response of a Create or Get request.
+const GetResult = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog Behavior",
+    "visibility": "public",
+    "protected": true,
+    "owner": "admin",
+    "resource_type_associations": [
+        {
+            "name": "OS::Glance::Image",
+            "created_at": "2024-03-12T09:14:35Z"
+        }
+    ],
+    "properties": {
+        "hw_watchdog_action": {
+            "title": "Watchdog Action",
+            "type": "string",
+            "enum": ["disabled", "reset"]
+        }
+    },
+    "schema": "/v2/schemas/metadefs/namespace",
+    "self": "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+    "created_at": "2024-03-12T09:14:35Z",
+    "updated_at": "2024-03-12T09:14:35Z"
+}
+`
+
+// UpdateRequest is the request body of an Update request.
+const UpdateRequest = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog",
+    "visibility": "private",
+    "protected": false
+}
+`
+
+// UpdateResult is the response of an Update request.
+const UpdateResult = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog",
+    "visibility": "private",
+    "protected": false,
+    "owner": "admin",
+    "schema": "/v2/schemas/metadefs/namespace",
+    "self": "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+    "created_at": "2024-03-12T09:14:35Z",
+    "updated_at": "2024-03-12T10:02:11Z"
+}
+`
+
+// Watchdog is the namespace of ListResult.
+var Watchdog = namespaces.Namespace{
+	Namespace:   "OS::Compute::Watchdog",
+	DisplayName: "Watchdog Behavior",
+	Visibility:  namespaces.VisibilityPublic,
+	Protected:   true,
+	Owner:       "admin",
+	ResourceTypeAssociations: []resourcetypes.Association{
+		{
+			Name:      "OS::Glance::Image",
+			CreatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+		},
+	},
+	Schema:    "/v2/schemas/metadefs/namespace",
+	Self:      "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+	CreatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+	UpdatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+}
+
+// WatchdogDetail is the namespace of GetResult.
+var WatchdogDetail = func() namespaces.Namespace {
+	n := Watchdog
+	n.Properties = map[string]properties.Property{
+		"hw_watchdog_action": {
+			Title: "Watchdog Action",
+			Type:  "string",
+			Enum:  []any{"disabled", "reset"},
+		},
+	}
+	return n
+}()
+
+// HandleListSuccessfully sets up the test server to respond to a List request
+// with two pages.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch r.URL.Query().Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"limit":          "1",
+				"resource_types": "OS::Glance::Image",
+			})
+			fmt.Fprint(w, ListResult)
+		case "OS::Compute::Watchdog":
+			fmt.Fprint(w, ListLastResult)
+		default:
+			t.Fatalf("unexpected marker %q", r.URL.Query().Get("marker"))
+		}
+	})
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"resource_type": "OS::Glance::Image"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResult)
+	})
+}
+
+// HandleCreateSuccessfully sets up the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResult)
+	})
+}
+
+// HandleUpdateSuccessfully sets up the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResult)
+	})
+}
+
+// HandleDeleteSuccessfully sets up the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
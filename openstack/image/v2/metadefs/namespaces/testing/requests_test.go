@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/namespaces"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/resourcetypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	listOpts := namespaces.ListOpts{
+		Limit:         1,
+		ResourceTypes: []string{"OS::Glance::Image"},
+	}
+
+	pages := 0
+	err := namespaces.List(client.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := namespaces.ExtractNamespaces(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []namespaces.Namespace{Watchdog}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestListQuery(t *testing.T) {
+	listOpts := namespaces.ListOpts{
+		SortKey:       "created_at",
+		ResourceTypes: []string{"OS::Glance::Image", "OS::Nova::Flavor"},
+	}
+	query, err := listOpts.ToNamespaceListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?resource_types=OS%3A%3AGlance%3A%3AImage%2COS%3A%3ANova%3A%3AFlavor&sort_key=created_at", query)
+
+	query, err = namespaces.ListOpts{}.ToNamespaceListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", query)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	getOpts := namespaces.GetOpts{
+		ResourceType: "OS::Glance::Image",
+	}
+	actual, err := namespaces.Get(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Watchdog", getOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WatchdogDetail, actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := namespaces.CreateOpts{
+		Namespace:   "OS::Compute::Watchdog",
+		DisplayName: "Watchdog Behavior",
+		Visibility:  namespaces.VisibilityPublic,
+		ResourceTypeAssociations: []resourcetypes.AssociateOpts{
+			{Name: "OS::Glance::Image"},
+		},
+		Properties: map[string]properties.Property{
+			"hw_watchdog_action": {
+				Title: "Watchdog Action",
+				Type:  "string",
+				Enum:  []any{"disabled", "reset"},
+			},
+		},
+	}
+	actual, err := namespaces.Create(context.TODO(), client.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WatchdogDetail, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	protected := false
+	updateOpts := namespaces.UpdateOpts{
+		Namespace:   "OS::Compute::Watchdog",
+		DisplayName: "Watchdog",
+		Visibility:  namespaces.VisibilityPrivate,
+		Protected:   &protected,
+	}
+	actual, err := namespaces.Update(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Watchdog", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Watchdog", actual.DisplayName)
+	th.AssertEquals(t, namespaces.VisibilityPrivate, actual.Visibility)
+	th.AssertEquals(t, false, actual.Protected)
+	th.AssertEquals(t, time.Date(2024, time.March, 12, 10, 2, 11, 0, time.UTC), actual.UpdatedAt)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := namespaces.Delete(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Watchdog").ExtractErr()
+	th.AssertNoErr(t, err)
+}
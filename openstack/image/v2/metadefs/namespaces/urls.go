@@ -0,0 +1,62 @@
+package namespaces
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/utils"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("metadefs", "namespaces")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, namespace string) string {
+	return c.ServiceURL("metadefs", "namespaces", namespace)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, namespace string) string {
+	return resourceURL(c, namespace)
+}
+
+func updateURL(c *gophercloud.ServiceClient, namespace string) string {
+	return resourceURL(c, namespace)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, namespace string) string {
+	return resourceURL(c, namespace)
+}
+
+// builds next page full url based on current url
+func nextPageURL(endpointURL, requestedNext string) (string, error) {
+	base, err := utils.BaseEndpoint(endpointURL)
+	if err != nil {
+		return "", err
+	}
+
+	requestedNextURL, err := url.Parse(requestedNext)
+	if err != nil {
+		return "", err
+	}
+
+	base = gophercloud.NormalizeURL(base)
+	nextPath := base + strings.TrimPrefix(requestedNextURL.Path, "/")
+
+	nextURL, err := url.Parse(nextPath)
+	if err != nil {
+		return "", err
+	}
+
+	nextURL.RawQuery = requestedNextURL.RawQuery
+
+	return nextURL.String(), nil
+}
@@ -0,0 +1,60 @@
+/*
+Package objects enables management of the objects of the metadata definition
+namespaces of the Image service. An object groups properties.
+
+Example to List the Objects of a Namespace
+
+	allPages, err := objects.List(imagesClient, "OS::Compute::CPUPinning").AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allObjects, err := objects.ExtractObjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, object := range allObjects {
+		fmt.Printf("%+v\n", object)
+	}
+
+Example to Create an Object
+
+	createOpts := objects.CreateOpts{
+		Name:        "CPU Pinning",
+		Description: "Pins the vCPUs of the instances to host CPUs.",
+		Required:    []string{"hw_cpu_policy"},
+		Properties: map[string]properties.Property{
+			"hw_cpu_policy": {
+				Title: "CPU Pinning policy",
+				Type:  "string",
+				Enum:  []any{"shared", "dedicated"},
+			},
+		},
+	}
+
+	object, err := objects.Create(context.TODO(), imagesClient, "OS::Compute::CPUPinning", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Object
+
+	updateOpts := objects.UpdateOpts{
+		Name:        "CPU Pinning",
+		Description: "Pins the vCPUs of the instances to dedicated host CPUs.",
+	}
+
+	object, err := objects.Update(context.TODO(), imagesClient, "OS::Compute::CPUPinning", "CPU Pinning", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Object
+
+	err := objects.Delete(context.TODO(), imagesClient, "OS::Compute::CPUPinning", "CPU Pinning").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package objects
@@ -0,0 +1,108 @@
+package objects
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// List returns the objects of a namespace.
+func List(client *gophercloud.ServiceClient, namespace string) pagination.Pager {
+	return pagination.NewPager(client, listURL(client, namespace), func(r pagination.PageResult) pagination.Page {
+		return ObjectPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToObjectCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create an object.
+type CreateOpts struct {
+	// Name is the name of the object.
+	Name string `json:"name" required:"true"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// Required are the names of the properties which must be set.
+	Required []string `json:"required,omitempty"`
+
+	// Properties are the properties of the object, keyed by name. The Name
+	// of the properties is ignored.
+	Properties map[string]properties.Property `json:"properties,omitempty"`
+}
+
+// ToObjectCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToObjectCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create adds an object to a namespace.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, namespace string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToObjectCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves an object of a namespace.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, namespace, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToObjectUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update an object. The object is
+// replaced, so all its attributes must be set. A different Name renames the
+// object.
+type UpdateOpts CreateOpts
+
+// ToObjectUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToObjectUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update replaces an object of a namespace.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToObjectUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, namespace, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes an object of a namespace.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteAll deletes all the objects of a namespace.
+func DeleteAll(ctx context.Context, client *gophercloud.ServiceClient, namespace string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteAllURL(client, namespace), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,97 @@
+package objects
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Object represents a metadata definition object, a group of properties.
+type Object struct {
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description"`
+
+	// Required are the names of the properties which must be set.
+	Required []string `json:"required"`
+
+	// Properties are the properties of the object, keyed by name. Use
+	// properties.ToList to get them as a slice.
+	Properties map[string]properties.Property `json:"properties"`
+
+	// Schema is the path to the JSON schema of the object.
+	Schema string `json:"schema"`
+
+	// Self is the path to the object.
+	Self string `json:"self"`
+
+	// CreatedAt is the date when the object was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the object was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as an Object.
+func (r commonResult) Extract() (*Object, error) {
+	var s *Object
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as an Object.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as an Object.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its Extract
+// method to interpret it as an Object.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete or DeleteAll operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ObjectPage is a single page of Object results.
+type ObjectPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an ObjectPage contains any results.
+func (r ObjectPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	objects, err := ExtractObjects(r)
+	return len(objects) == 0, err
+}
+
+// ExtractObjects returns a slice of Objects contained in a single page of
+// results.
+func ExtractObjects(r pagination.Page) ([]Object, error) {
+	var s struct {
+		Objects []Object `json:"objects"`
+	}
+	err := (r.(ObjectPage)).ExtractInto(&s)
+	return s.Objects, err
+}
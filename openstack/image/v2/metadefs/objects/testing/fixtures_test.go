@@ -0,0 +1,175 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListResult is the response of a List request.
+const ListResult = `
+{
+    "objects": [
+        {
+            "name": "CPUPinning",
+            "description": "Pins the vCPUs of the instances to host CPUs.",
+            "required": ["hw_cpu_policy"],
+            "properties": {
+                "hw_cpu_policy": {
+                    "title": "CPUPinning policy",
+                    "type": "string",
+                    "enum": ["shared", "dedicated"]
+                }
+            },
+            "schema": "/v2/schemas/metadefs/object",
+            "self": "/v2/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning",
+            "created_at": "2024-03-12T09:14:35Z",
+            "updated_at": "2024-03-12T09:14:35Z"
+        }
+    ],
+    "schema": "/v2/schemas/metadefs/objects"
+}
+`
+
+// CreateRequest is the request body of a Create request.
+const CreateRequest = `
+{
+    "name": "CPUPinning",
+    "description": "Pins the vCPUs of the instances to host CPUs.",
+    "required": ["hw_cpu_policy"],
+    "properties": {
+        "hw_cpu_policy": {
+            "title": "CPUPinning policy",
+            "type": "string",
+            "enum": ["shared", "dedicated"]
+        }
+    }
+}
+`
+
+// GetResult is the response of a Create or Get request.
+const GetResult = `
+{
+    "name": "CPUPinning",
+    "description": "Pins the vCPUs of the instances to host CPUs.",
+    "required": ["hw_cpu_policy"],
+    "properties": {
+        "hw_cpu_policy": {
+            "title": "CPUPinning policy",
+            "type": "string",
+            "enum": ["shared", "dedicated"]
+        }
+    },
+    "schema": "/v2/schemas/metadefs/object",
+    "self": "/v2/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning",
+    "created_at": "2024-03-12T09:14:35Z",
+    "updated_at": "2024-03-12T09:14:35Z"
+}
+`
+
+// UpdateRequest is the request body of an Update request.
+const UpdateRequest = `
+{
+    "name": "CPUPinning",
+    "description": "Pins the vCPUs of the instances to dedicated host CPUs."
+}
+`
+
+// UpdateResult is the response of an Update request.
+const UpdateResult = `
+{
+    "name": "CPUPinning",
+    "description": "Pins the vCPUs of the instances to dedicated host CPUs.",
+    "schema": "/v2/schemas/metadefs/object",
+    "self": "/v2/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning",
+    "created_at": "2024-03-12T09:14:35Z",
+    "updated_at": "2024-03-12T10:02:11Z"
+}
+`
+
+// CPUPinning is the object of GetResult.
+var CPUPinning = objects.Object{
+	Name:        "CPUPinning",
+	Description: "Pins the vCPUs of the instances to host CPUs.",
+	Required:    []string{"hw_cpu_policy"},
+	Properties: map[string]properties.Property{
+		"hw_cpu_policy": {
+			Title: "CPUPinning policy",
+			Type:  "string",
+			Enum:  []any{"shared", "dedicated"},
+		},
+	},
+	Schema:    "/v2/schemas/metadefs/object",
+	Self:      "/v2/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning",
+	CreatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+	UpdatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::CPUPinning/objects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResult)
+	})
+}
+
+// HandleCreateSuccessfully sets up the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::CPUPinning/objects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResult)
+	})
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResult)
+	})
+}
+
+// HandleUpdateSuccessfully sets up the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResult)
+	})
+}
+
+// HandleDeleteSuccessfully sets up the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::CPUPinning/objects/CPUPinning", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
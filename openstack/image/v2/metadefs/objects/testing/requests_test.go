@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	allPages, err := objects.List(client.ServiceClient(fakeServer), "OS::Compute::CPUPinning").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := objects.ExtractObjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []objects.Object{CPUPinning}, actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := objects.CreateOpts{
+		Name:        "CPUPinning",
+		Description: "Pins the vCPUs of the instances to host CPUs.",
+		Required:    []string{"hw_cpu_policy"},
+		Properties: map[string]properties.Property{
+			"hw_cpu_policy": {
+				Title: "CPUPinning policy",
+				Type:  "string",
+				Enum:  []any{"shared", "dedicated"},
+			},
+		},
+	}
+	actual, err := objects.Create(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::CPUPinning", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CPUPinning, actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := objects.Get(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::CPUPinning", "CPUPinning").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CPUPinning, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	updateOpts := objects.UpdateOpts{
+		Name:        "CPUPinning",
+		Description: "Pins the vCPUs of the instances to dedicated host CPUs.",
+	}
+	actual, err := objects.Update(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::CPUPinning", "CPUPinning", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Pins the vCPUs of the instances to dedicated host CPUs.", actual.Description)
+	th.AssertEquals(t, time.Date(2024, time.March, 12, 10, 2, 11, 0, time.UTC), actual.UpdatedAt)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := objects.Delete(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::CPUPinning", "CPUPinning").ExtractErr()
+	th.AssertNoErr(t, err)
+}
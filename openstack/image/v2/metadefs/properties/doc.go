@@ -0,0 +1,57 @@
+/*
+Package properties enables management of the properties of the metadata
+definition namespaces of the Image service.
+
+Example to List the Properties of a Namespace
+
+	allPages, err := properties.List(imagesClient, "OS::Compute::Watchdog").AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProperties, err := properties.ExtractProperties(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, property := range allProperties {
+		fmt.Printf("%+v\n", property)
+	}
+
+Example to Create a Property
+
+	createOpts := properties.CreateOpts{
+		Name:  "hw_watchdog_action",
+		Title: "Watchdog Action",
+		Type:  "string",
+		Enum:  []any{"disabled", "reset", "poweroff", "pause", "none"},
+	}
+
+	property, err := properties.Create(context.TODO(), imagesClient, "OS::Compute::Watchdog", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Property
+
+	updateOpts := properties.UpdateOpts{
+		Name:    "hw_watchdog_action",
+		Title:   "Watchdog Action",
+		Type:    "string",
+		Enum:    []any{"disabled", "reset", "poweroff", "pause", "none"},
+		Default: "disabled",
+	}
+
+	property, err := properties.Update(context.TODO(), imagesClient, "OS::Compute::Watchdog", "hw_watchdog_action", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Property
+
+	err := properties.Delete(context.TODO(), imagesClient, "OS::Compute::Watchdog", "hw_watchdog_action").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package properties
@@ -0,0 +1,122 @@
+package properties
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// List returns the properties of a namespace.
+func List(client *gophercloud.ServiceClient, namespace string) pagination.Pager {
+	return pagination.NewPager(client, listURL(client, namespace), func(r pagination.PageResult) pagination.Page {
+		return PropertyPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPropertyCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a property. The attributes
+// follow the JSON schema of the property.
+type CreateOpts struct {
+	// Name is the name of the property.
+	Name string `json:"name,omitempty" required:"true"`
+
+	// Title is the display name of the property.
+	Title string `json:"title" required:"true"`
+
+	Description string `json:"description,omitempty"`
+
+	// Type is the type of the property: string, integer, number, boolean or
+	// array.
+	Type string `json:"type" required:"true"`
+
+	Enum            []any    `json:"enum,omitempty"`
+	Default         any      `json:"default,omitempty"`
+	Minimum         *float64 `json:"minimum,omitempty"`
+	Maximum         *float64 `json:"maximum,omitempty"`
+	MinLength       *int     `json:"minLength,omitempty"`
+	MaxLength       *int     `json:"maxLength,omitempty"`
+	Pattern         string   `json:"pattern,omitempty"`
+	Items           *Items   `json:"items,omitempty"`
+	UniqueItems     bool     `json:"uniqueItems,omitempty"`
+	MinItems        *int     `json:"minItems,omitempty"`
+	MaxItems        *int     `json:"maxItems,omitempty"`
+	AdditionalItems *bool    `json:"additionalItems,omitempty"`
+	ReadOnly        bool     `json:"readonly,omitempty"`
+	Operators       []string `json:"operators,omitempty"`
+}
+
+// ToPropertyCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToPropertyCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create adds a property to a namespace.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, namespace string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPropertyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a property of a namespace.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, namespace, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPropertyUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a property. The property is
+// replaced, so all its attributes must be set. A different Name renames the
+// property.
+type UpdateOpts CreateOpts
+
+// ToPropertyUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToPropertyUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update replaces a property of a namespace.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPropertyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, namespace, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a property of a namespace.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteAll deletes all the properties of a namespace.
+func DeleteAll(ctx context.Context, client *gophercloud.ServiceClient, namespace string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteAllURL(client, namespace), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
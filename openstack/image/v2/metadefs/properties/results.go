@@ -0,0 +1,146 @@
+package properties
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Property represents a metadata definition property, described by a JSON
+// schema.
+type Property struct {
+	// Name is the name of the property.
+	Name string `json:"name"`
+
+	// Title is the display name of the property.
+	Title string `json:"title"`
+
+	// Description is the description of the property.
+	Description string `json:"description"`
+
+	// Type is the type of the property: string, integer, number, boolean or
+	// array.
+	Type string `json:"type"`
+
+	// Enum are the allowed values of the property.
+	Enum []any `json:"enum"`
+
+	// Default is the default value of the property.
+	Default any `json:"default"`
+
+	// Minimum and Maximum bound the value of integer and number properties.
+	Minimum *float64 `json:"minimum"`
+	Maximum *float64 `json:"maximum"`
+
+	// MinLength, MaxLength and Pattern constrain the value of string
+	// properties.
+	MinLength *int   `json:"minLength"`
+	MaxLength *int   `json:"maxLength"`
+	Pattern   string `json:"pattern"`
+
+	// Items, UniqueItems, MinItems, MaxItems and AdditionalItems constrain
+	// the items of array properties.
+	Items           *Items `json:"items"`
+	UniqueItems     bool   `json:"uniqueItems"`
+	MinItems        *int   `json:"minItems"`
+	MaxItems        *int   `json:"maxItems"`
+	AdditionalItems *bool  `json:"additionalItems"`
+
+	// ReadOnly is whether the property is read-only.
+	ReadOnly bool `json:"readonly"`
+
+	// Operators are the operators which can be used with the value, like
+	// "<or>" and "<all-in>".
+	Operators []string `json:"operators"`
+}
+
+// MarshalJSON omits the attributes which are not set, so that a Property can
+// be sent in the properties of an object or a namespace.
+func (r Property) MarshalJSON() ([]byte, error) {
+	return json.Marshal(CreateOpts(r))
+}
+
+// Items describes the items of an array property.
+type Items struct {
+	Type string `json:"type,omitempty"`
+	Enum []any  `json:"enum,omitempty"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Property.
+func (r commonResult) Extract() (*Property, error) {
+	var s *Property
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Property.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Property.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its Extract
+// method to interpret it as a Property.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete or DeleteAll operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PropertyPage is a single page of Property results.
+type PropertyPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a PropertyPage contains any results.
+func (r PropertyPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	properties, err := ExtractProperties(r)
+	return len(properties) == 0, err
+}
+
+// ExtractProperties returns a slice of Properties contained in a single page
+// of results, sorted by name.
+func ExtractProperties(r pagination.Page) ([]Property, error) {
+	var s struct {
+		Properties map[string]Property `json:"properties"`
+	}
+	err := (r.(PropertyPage)).ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return ToList(s.Properties), nil
+}
+
+// ToList converts the properties of a namespace or of an object, keyed by
+// name, into a slice sorted by name.
+func ToList(properties map[string]Property) []Property {
+	list := make([]Property, 0, len(properties))
+	for name, property := range properties {
+		property.Name = name
+		list = append(list, property)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
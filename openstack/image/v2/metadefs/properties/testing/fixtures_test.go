@@ -0,0 +1,171 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListResult is the response of a List request.
+const ListResult = `
+{
+    "properties": {
+        "hw_watchdog_action": {
+            "title": "Watchdog Action",
+            "description": "The action to take when the watchdog fires.",
+            "type": "string",
+            "enum": ["disabled", "reset", "poweroff", "pause", "none"],
+            "default": "disabled"
+        },
+        "hw_cpu_cores": {
+            "title": "vCPU Cores",
+            "type": "integer",
+            "minimum": 1
+        }
+    }
+}
+`
+
+// CreateRequest is the request body of a Create request.
+const CreateRequest = `
+{
+    "name": "hw_watchdog_action",
+    "title": "Watchdog Action",
+    "description": "The action to take when the watchdog fires.",
+    "type": "string",
+    "enum": ["disabled", "reset", "poweroff", "pause", "none"],
+    "default": "disabled"
+}
+`
+
+// GetResult is the response of a Create, Get or Update request.
+const GetResult = `
+{
+    "name": "hw_watchdog_action",
+    "title": "Watchdog Action",
+    "description": "The action to take when the watchdog fires.",
+    "type": "string",
+    "enum": ["disabled", "reset", "poweroff", "pause", "none"],
+    "default": "disabled"
+}
+`
+
+// UpdateRequest is the request body of an Update request.
+const UpdateRequest = `
+{
+    "name": "hw_cpu_cores",
+    "title": "vCPU Cores",
+    "type": "integer",
+    "minimum": 1,
+    "maximum": 64
+}
+`
+
+// UpdateResult is the response of an Update request.
+const UpdateResult = `
+{
+    "name": "hw_cpu_cores",
+    "title": "vCPU Cores",
+    "type": "integer",
+    "minimum": 1,
+    "maximum": 64
+}
+`
+
+var one = 1.0
+
+// WatchdogAction is the hw_watchdog_action property.
+var WatchdogAction = properties.Property{
+	Name:        "hw_watchdog_action",
+	Title:       "Watchdog Action",
+	Description: "The action to take when the watchdog fires.",
+	Type:        "string",
+	Enum:        []any{"disabled", "reset", "poweroff", "pause", "none"},
+	Default:     "disabled",
+}
+
+// CPUCores is the hw_cpu_cores property.
+var CPUCores = properties.Property{
+	Name:    "hw_cpu_cores",
+	Title:   "vCPU Cores",
+	Type:    "integer",
+	Minimum: &one,
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Hypervisor/properties", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResult)
+	})
+}
+
+// HandleCreateSuccessfully sets up the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Hypervisor/properties", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResult)
+	})
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Hypervisor/properties/hw_watchdog_action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResult)
+	})
+}
+
+// HandleUpdateSuccessfully sets up the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Hypervisor/properties/hw_cpu_cores", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResult)
+	})
+}
+
+// HandleDeleteSuccessfully sets up the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Hypervisor/properties/hw_watchdog_action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleDeleteAllSuccessfully sets up the test server to respond to a
+// DeleteAll request.
+func HandleDeleteAllSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Hypervisor/properties", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/properties"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	allPages, err := properties.List(client.ServiceClient(fakeServer), "OS::Compute::Hypervisor").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := properties.ExtractProperties(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []properties.Property{CPUCores, WatchdogAction}, actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := properties.CreateOpts{
+		Name:        "hw_watchdog_action",
+		Title:       "Watchdog Action",
+		Description: "The action to take when the watchdog fires.",
+		Type:        "string",
+		Enum:        []any{"disabled", "reset", "poweroff", "pause", "none"},
+		Default:     "disabled",
+	}
+	actual, err := properties.Create(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Hypervisor", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WatchdogAction, actual)
+}
+
+func TestCreateRequiredFields(t *testing.T) {
+	_, err := properties.CreateOpts{Name: "hw_watchdog_action"}.ToPropertyCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for a property without title and type")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := properties.Get(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Hypervisor", "hw_watchdog_action").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WatchdogAction, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	one, sixtyFour := 1.0, 64.0
+	updateOpts := properties.UpdateOpts{
+		Name:    "hw_cpu_cores",
+		Title:   "vCPU Cores",
+		Type:    "integer",
+		Minimum: &one,
+		Maximum: &sixtyFour,
+	}
+	actual, err := properties.Update(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Hypervisor", "hw_cpu_cores", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := CPUCores
+	expected.Maximum = &sixtyFour
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := properties.Delete(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Hypervisor", "hw_watchdog_action").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteAll(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteAllSuccessfully(t, fakeServer)
+
+	err := properties.DeleteAll(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Hypervisor").ExtractErr()
+	th.AssertNoErr(t, err)
+}
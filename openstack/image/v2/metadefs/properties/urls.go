@@ -0,0 +1,35 @@
+package properties
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func rootURL(c *gophercloud.ServiceClient, namespace string) string {
+	return c.ServiceURL("metadefs", "namespaces", namespace, "properties")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return c.ServiceURL("metadefs", "namespaces", namespace, "properties", name)
+}
+
+func listURL(c *gophercloud.ServiceClient, namespace string) string {
+	return rootURL(c, namespace)
+}
+
+func createURL(c *gophercloud.ServiceClient, namespace string) string {
+	return rootURL(c, namespace)
+}
+
+func deleteAllURL(c *gophercloud.ServiceClient, namespace string) string {
+	return rootURL(c, namespace)
+}
+
+func getURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return resourceURL(c, namespace, name)
+}
+
+func updateURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return resourceURL(c, namespace, name)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return resourceURL(c, namespace, name)
+}
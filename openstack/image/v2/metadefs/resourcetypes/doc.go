@@ -0,0 +1,53 @@
+/*
+Package resourcetypes enables management of the associations between the
+metadata definition namespaces of the Image service and the types of resources
+they apply to.
+
+Example to List the Resource Types
+
+	allPages, err := resourcetypes.List(imagesClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allResourceTypes, err := resourcetypes.ExtractResourceTypes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resourceType := range allResourceTypes {
+		fmt.Printf("%+v\n", resourceType)
+	}
+
+Example to List the Resource Types Associated with a Namespace
+
+	allPages, err := resourcetypes.ListAssociations(imagesClient, "OS::Compute::Watchdog").AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAssociations, err := resourcetypes.ExtractAssociations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Resource Type with a Namespace
+
+	associateOpts := resourcetypes.AssociateOpts{
+		Name:   "OS::Glance::Image",
+		Prefix: "hw_",
+	}
+
+	association, err := resourcetypes.Associate(context.TODO(), imagesClient, "OS::Compute::Watchdog", associateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disassociate a Resource Type from a Namespace
+
+	err := resourcetypes.Disassociate(context.TODO(), imagesClient, "OS::Compute::Watchdog", "OS::Glance::Image").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package resourcetypes
@@ -0,0 +1,71 @@
+package resourcetypes
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// List returns the resource types which are associated with at least one
+// namespace.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return ResourceTypePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListAssociations returns the resource types associated with a namespace.
+func ListAssociations(client *gophercloud.ServiceClient, namespace string) pagination.Pager {
+	return pagination.NewPager(client, associationsURL(client, namespace), func(r pagination.PageResult) pagination.Page {
+		return AssociationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// AssociateOptsBuilder allows extensions to add additional parameters to the
+// Associate request.
+type AssociateOptsBuilder interface {
+	ToResourceTypeAssociateMap() (map[string]any, error)
+}
+
+// AssociateOpts represents options used to associate a resource type with a
+// namespace.
+type AssociateOpts struct {
+	// Name is the name of the resource type, like OS::Glance::Image or
+	// OS::Nova::Flavor.
+	Name string `json:"name" required:"true"`
+
+	// Prefix is prepended to the names of the properties of the namespace
+	// when they are applied to the resource type, like "hw_" for images.
+	Prefix string `json:"prefix,omitempty"`
+
+	// PropertiesTarget is the part of the resource type the properties apply
+	// to, like "image" or "volume_image_metadata" for OS::Cinder::Volume.
+	PropertiesTarget string `json:"properties_target,omitempty"`
+}
+
+// ToResourceTypeAssociateMap formats an AssociateOpts into a request body.
+func (opts AssociateOpts) ToResourceTypeAssociateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Associate associates a resource type with a namespace.
+func Associate(ctx context.Context, client *gophercloud.ServiceClient, namespace string, opts AssociateOptsBuilder) (r AssociateResult) {
+	b, err := opts.ToResourceTypeAssociateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, associationsURL(client, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Disassociate removes the association of a resource type with a namespace.
+func Disassociate(ctx context.Context, client *gophercloud.ServiceClient, namespace, name string) (r DisassociateResult) {
+	resp, err := client.Delete(ctx, associationURL(client, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
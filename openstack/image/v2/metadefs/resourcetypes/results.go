@@ -0,0 +1,111 @@
+package resourcetypes
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ResourceType represents a type of resource the metadata definitions can
+// apply to.
+type ResourceType struct {
+	// Name is the name of the resource type, like OS::Glance::Image.
+	Name string `json:"name"`
+
+	// CreatedAt is the date when the resource type was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the resource type was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Association represents the association of a resource type with a
+// namespace.
+type Association struct {
+	// Name is the name of the resource type.
+	Name string `json:"name"`
+
+	// Prefix is prepended to the names of the properties of the namespace
+	// when they are applied to the resource type.
+	Prefix string `json:"prefix"`
+
+	// PropertiesTarget is the part of the resource type the properties apply
+	// to.
+	PropertiesTarget string `json:"properties_target"`
+
+	// CreatedAt is the date when the association was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the association was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ResourceTypePage is a single page of ResourceType results.
+type ResourceTypePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a ResourceTypePage contains any results.
+func (r ResourceTypePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	resourceTypes, err := ExtractResourceTypes(r)
+	return len(resourceTypes) == 0, err
+}
+
+// ExtractResourceTypes returns a slice of ResourceTypes contained in a single
+// page of results.
+func ExtractResourceTypes(r pagination.Page) ([]ResourceType, error) {
+	var s struct {
+		ResourceTypes []ResourceType `json:"resource_types"`
+	}
+	err := (r.(ResourceTypePage)).ExtractInto(&s)
+	return s.ResourceTypes, err
+}
+
+// AssociationPage is a single page of Association results.
+type AssociationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an AssociationPage contains any results.
+func (r AssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	associations, err := ExtractAssociations(r)
+	return len(associations) == 0, err
+}
+
+// ExtractAssociations returns a slice of Associations contained in a single
+// page of results.
+func ExtractAssociations(r pagination.Page) ([]Association, error) {
+	var s struct {
+		Associations []Association `json:"resource_type_associations"`
+	}
+	err := (r.(AssociationPage)).ExtractInto(&s)
+	return s.Associations, err
+}
+
+// AssociateResult represents the result of an Associate operation. Call its
+// Extract method to interpret it as an Association.
+type AssociateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AssociateResult as an Association.
+func (r AssociateResult) Extract() (*Association, error) {
+	var s *Association
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// DisassociateResult represents the result of a Disassociate operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DisassociateResult struct {
+	gophercloud.ErrResult
+}
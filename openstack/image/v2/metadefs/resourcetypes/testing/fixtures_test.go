@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/resourcetypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListResult is the response of a List request.
+const ListResult = `
+{
+    "resource_types": [
+        {
+            "name": "OS::Glance::Image",
+            "created_at": "2024-03-12T09:14:35Z",
+            "updated_at": "2024-03-12T09:14:35Z"
+        },
+        {
+            "name": "OS::Nova::Flavor",
+            "created_at": "2024-03-12T09:14:35Z"
+        }
+    ]
+}
+`
+
+// ListAssociationsResult is the response of a ListAssociations request.
+const ListAssociationsResult = `
+{
+    "resource_type_associations": [
+        {
+            "name": "OS::Cinder::Volume",
+            "prefix": "hw_",
+            "properties_target": "image",
+            "created_at": "2024-03-12T09:14:35Z"
+        }
+    ]
+}
+`
+
+// AssociateRequest is the request body of an Associate request.
+const AssociateRequest = `
+{
+    "name": "OS::Glance::Image",
+    "prefix": "hw_"
+}
+`
+
+// AssociateResult is the response of an Associate request.
+const AssociateResult = `
+{
+    "name": "OS::Glance::Image",
+    "prefix": "hw_",
+    "created_at": "2024-03-12T10:02:11Z"
+}
+`
+
+// ImageResourceType is the OS::Glance::Image resource type of ListResult.
+var ImageResourceType = resourcetypes.ResourceType{
+	Name:      "OS::Glance::Image",
+	CreatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+	UpdatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+}
+
+// FlavorResourceType is the OS::Nova::Flavor resource type of ListResult.
+var FlavorResourceType = resourcetypes.ResourceType{
+	Name:      "OS::Nova::Flavor",
+	CreatedAt: time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+}
+
+// VolumeAssociation is the association of ListAssociationsResult.
+var VolumeAssociation = resourcetypes.Association{
+	Name:             "OS::Cinder::Volume",
+	Prefix:           "hw_",
+	PropertiesTarget: "image",
+	CreatedAt:        time.Date(2024, time.March, 12, 9, 14, 35, 0, time.UTC),
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResult)
+	})
+}
+
+// HandleListAssociationsSuccessfully sets up the test server to respond to a
+// ListAssociations request.
+func HandleListAssociationsSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListAssociationsResult)
+	})
+}
+
+// HandleAssociateSuccessfully sets up the test server to respond to an
+// Associate request.
+func HandleAssociateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, AssociateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, AssociateResult)
+	})
+}
+
+// HandleDisassociateSuccessfully sets up the test server to respond to a
+// Disassociate request.
+func HandleDisassociateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/resource_types/OS::Glance::Image", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
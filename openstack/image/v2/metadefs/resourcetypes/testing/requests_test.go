@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/metadefs/resourcetypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	allPages, err := resourcetypes.List(client.ServiceClient(fakeServer)).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := resourcetypes.ExtractResourceTypes(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []resourcetypes.ResourceType{ImageResourceType, FlavorResourceType}, actual)
+}
+
+func TestListAssociations(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListAssociationsSuccessfully(t, fakeServer)
+
+	allPages, err := resourcetypes.ListAssociations(client.ServiceClient(fakeServer), "OS::Compute::Watchdog").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := resourcetypes.ExtractAssociations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []resourcetypes.Association{VolumeAssociation}, actual)
+}
+
+func TestAssociate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleAssociateSuccessfully(t, fakeServer)
+
+	associateOpts := resourcetypes.AssociateOpts{
+		Name:   "OS::Glance::Image",
+		Prefix: "hw_",
+	}
+	actual, err := resourcetypes.Associate(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Watchdog", associateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &resourcetypes.Association{
+		Name:      "OS::Glance::Image",
+		Prefix:    "hw_",
+		CreatedAt: time.Date(2024, time.March, 12, 10, 2, 11, 0, time.UTC),
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestDisassociate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDisassociateSuccessfully(t, fakeServer)
+
+	err := resourcetypes.Disassociate(context.TODO(), client.ServiceClient(fakeServer), "OS::Compute::Watchdog", "OS::Glance::Image").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,15 @@
+package resourcetypes
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("metadefs", "resource_types")
+}
+
+func associationsURL(c *gophercloud.ServiceClient, namespace string) string {
+	return c.ServiceURL("metadefs", "namespaces", namespace, "resource_types")
+}
+
+func associationURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return c.ServiceURL("metadefs", "namespaces", namespace, "resource_types", name)
+}
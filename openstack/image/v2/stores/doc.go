@@ -0,0 +1,47 @@
+/*
+Package stores enables retrieval of the stores configured in an Image service
+with multiple stores enabled, and deletion of image data from a single store.
+
+Example to List Stores
+
+	allPages, err := stores.List(imagesClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allStores, err := stores.ExtractStores(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, store := range allStores {
+		fmt.Printf("%+v\n", store)
+	}
+
+Example to List Stores with their Details
+
+	allPages, err := stores.ListDetail(imagesClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allStores, err := stores.ExtractStores(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, store := range allStores {
+		fmt.Printf("%s: %s (weight %d)\n", store.ID, store.Type, store.Weight)
+	}
+
+Example to Delete the Data of an Image from a Store
+
+	storeID := "ceph"
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	err := stores.DeleteImage(context.TODO(), imagesClient, storeID, imageID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package stores
@@ -0,0 +1,31 @@
+package stores
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// List returns the stores available to the user.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return StorePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListDetail returns the stores with their type, weight and properties.
+// It requires admin privileges.
+func ListDetail(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listDetailURL(client), func(r pagination.PageResult) pagination.Page {
+		return StorePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// DeleteImage deletes the data of an image from a single store. The image
+// must be active and its data must be present in another store.
+func DeleteImage(ctx context.Context, client *gophercloud.ServiceClient, storeID, imageID string) (r DeleteImageResult) {
+	resp, err := client.Delete(ctx, deleteImageURL(client, storeID, imageID), &gophercloud.RequestOpts{OkCodes: []int{204}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,65 @@
+package stores
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Store represents a store of the Image service.
+type Store struct {
+	// ID is the identifier of the store.
+	ID string `json:"id"`
+
+	// Description is the description of the store.
+	Description string `json:"description"`
+
+	// Default is whether the store is the default one, where the data of new
+	// images is imported when no store is requested.
+	Default bool `json:"default"`
+
+	// ReadOnly is whether the store is read-only.
+	ReadOnly bool `json:"read-only"`
+
+	// Type is the backend type of the store, like rbd or swift. It is only
+	// returned by ListDetail.
+	Type string `json:"type"`
+
+	// Weight is the weight of the store, used to sort the locations of the
+	// images. It is only returned by ListDetail.
+	Weight int `json:"weight"`
+
+	// Properties are the backend specific properties of the store. They are
+	// only returned by ListDetail.
+	Properties map[string]any `json:"properties"`
+}
+
+// StorePage is a single page of Store results.
+type StorePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a StorePage contains any results.
+func (r StorePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	stores, err := ExtractStores(r)
+	return len(stores) == 0, err
+}
+
+// ExtractStores returns a slice of Stores contained in a single page of
+// results.
+func ExtractStores(r pagination.Page) ([]Store, error) {
+	var s struct {
+		Stores []Store `json:"stores"`
+	}
+	err := (r.(StorePage)).ExtractInto(&s)
+	return s.Stores, err
+}
+
+// DeleteImageResult represents the result of a DeleteImage operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteImageResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/stores"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// ListResult is the response of a List request.
+const ListResult = `
+{
+    "stores": [
+        {
+            "id": "ceph",
+            "description": "Fast access to rbd store",
+            "default": true
+        },
+        {
+            "id": "web",
+            "description": "Read-only store",
+            "read-only": true
+        }
+    ]
+}
+`
+
+// ListDetailResult is the response of a ListDetail request.
+const ListDetailResult = `
+{
+    "stores": [
+        {
+            "id": "ceph",
+            "description": "Fast access to rbd store",
+            "default": true,
+            "type": "rbd",
+            "weight": 100,
+            "properties": {
+                "pool": "images",
+                "chunk_size": 8388608,
+                "thin_provisioning": false
+            }
+        }
+    ]
+}
+`
+
+// CephStore is the ceph store of ListResult.
+var CephStore = stores.Store{
+	ID:          "ceph",
+	Description: "Fast access to rbd store",
+	Default:     true,
+}
+
+// WebStore is the web store of ListResult.
+var WebStore = stores.Store{
+	ID:          "web",
+	Description: "Read-only store",
+	ReadOnly:    true,
+}
+
+// CephStoreDetail is the ceph store of ListDetailResult.
+var CephStoreDetail = stores.Store{
+	ID:          "ceph",
+	Description: "Fast access to rbd store",
+	Default:     true,
+	Type:        "rbd",
+	Weight:      100,
+	Properties: map[string]any{
+		"pool":              "images",
+		"chunk_size":        float64(8388608),
+		"thin_provisioning": false,
+	},
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/info/stores", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResult)
+	})
+}
+
+// HandleListDetailSuccessfully sets up the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/info/stores/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListDetailResult)
+	})
+}
+
+// HandleDeleteImageSuccessfully sets up the test server to respond to a
+// DeleteImage request.
+func HandleDeleteImageSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/stores/ceph/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
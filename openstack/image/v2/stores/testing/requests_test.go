@@ -0,0 +1,45 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/stores"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	allPages, err := stores.List(client.ServiceClient(fakeServer)).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := stores.ExtractStores(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []stores.Store{CephStore, WebStore}, actual)
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	allPages, err := stores.ListDetail(client.ServiceClient(fakeServer)).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := stores.ExtractStores(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []stores.Store{CephStoreDetail}, actual)
+}
+
+func TestDeleteImage(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteImageSuccessfully(t, fakeServer)
+
+	err := stores.DeleteImage(context.TODO(), client.ServiceClient(fakeServer), "ceph", "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,15 @@
+package stores
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("info", "stores")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("info", "stores", "detail")
+}
+
+func deleteImageURL(c *gophercloud.ServiceClient, storeID, imageID string) string {
+	return c.ServiceURL("stores", storeID, imageID)
+}
@@ -30,5 +30,16 @@ Example to Failover an amphora
 	if err != nil {
 		panic(err)
 	}
+
+Example to Failover all the Amphorae of a Load Balancer
+
+	lbID := "6bd55cd3-802e-447e-a518-1e74e23bb106"
+
+	failedOver, err := amphorae.RollingFailover(context.TODO(), octaviaClient, lbID)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("failed over amphorae: %v\n", failedOver)
 */
 package amphorae
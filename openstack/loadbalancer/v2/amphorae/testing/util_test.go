@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/testhelper"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const rollingFailoverLoadBalancerID = "882f2a9d-9d53-4bd0-b0e9-08e9d0de11f9"
+
+// HandleRollingFailover sets up the test server to respond to the requests
+// of a rolling failover. The requests are recorded in the returned slice, and
+// the failover of failingID fails with a conflict.
+func HandleRollingFailover(t *testing.T, fakeServer th.FakeServer, failingID string) *[]string {
+	var requests []string
+
+	fakeServer.Mux.HandleFunc("/v2.0/octavia/amphorae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"loadbalancer_id": rollingFailoverLoadBalancerID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, AmphoraeListBody)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/octavia/amphorae/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v2.0/octavia/amphorae/"), "/failover")
+		requests = append(requests, "failover "+id)
+		if id == failingID {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/"+rollingFailoverLoadBalancerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		requests = append(requests, "wait")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "%s", "provisioning_status": "ACTIVE"}}`, rollingFailoverLoadBalancerID)
+	})
+
+	return &requests
+}
+
+func TestRollingFailover(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	requests := HandleRollingFailover(t, fakeServer, "")
+
+	failedOver, err := amphorae.RollingFailover(context.TODO(), fake.ServiceClient(fakeServer), rollingFailoverLoadBalancerID)
+	th.AssertNoErr(t, err)
+
+	// The BACKUP amphora is failed over before the MASTER one.
+	th.CheckDeepEquals(t, []string{SecondAmphora.ID, FirstAmphora.ID}, failedOver)
+	th.CheckDeepEquals(t, []string{
+		"wait",
+		"failover " + SecondAmphora.ID,
+		"wait",
+		"failover " + FirstAmphora.ID,
+		"wait",
+	}, *requests)
+}
+
+func TestRollingFailoverError(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	requests := HandleRollingFailover(t, fakeServer, FirstAmphora.ID)
+
+	failedOver, err := amphorae.RollingFailover(context.TODO(), fake.ServiceClient(fakeServer), rollingFailoverLoadBalancerID)
+	if !gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+
+	th.CheckDeepEquals(t, []string{SecondAmphora.ID}, failedOver)
+	th.AssertEquals(t, 4, len(*requests))
+}
@@ -0,0 +1,65 @@
+package amphorae
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+)
+
+// failoverOrder is the order in which the amphorae are failed over by their
+// role: the BACKUP amphora of an active/standby load balancer is replaced
+// before the MASTER one, so that the VIP only moves once.
+var failoverOrder = map[string]int{
+	"BACKUP":     0,
+	"STANDALONE": 1,
+	"MASTER":     2,
+}
+
+// RollingFailover fails over the amphorae of a load balancer one at a time.
+// It waits for the load balancer to be ACTIVE before failing over the first
+// amphora, and after every failover before failing over the next one, so that
+// the load balancer always keeps an amphora serving traffic in active/standby
+// topologies. The BACKUP amphora is failed over before the MASTER one.
+//
+// It returns the IDs of the amphorae which were failed over, in order. It
+// stops at the first error, which is returned along with the IDs of the
+// amphorae failed over so far. Failing over amphorae requires administrative
+// privileges.
+func RollingFailover(ctx context.Context, c *gophercloud.ServiceClient, lbID string) ([]string, error) {
+	allPages, err := List(c, ListOpts{LoadbalancerID: lbID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allAmphorae, err := ExtractAmphorae(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	allAmphorae = slices.DeleteFunc(allAmphorae, func(a Amphora) bool {
+		return a.Status == "DELETED" || a.Status == "PENDING_DELETE"
+	})
+	slices.SortStableFunc(allAmphorae, func(a, b Amphora) int {
+		return failoverOrder[a.Role] - failoverOrder[b.Role]
+	})
+
+	if err := loadbalancers.WaitForActive(ctx, c, lbID); err != nil {
+		return nil, err
+	}
+
+	var failedOver []string
+	for _, amphora := range allAmphorae {
+		if err := Failover(ctx, c, amphora.ID).ExtractErr(); err != nil {
+			return failedOver, fmt.Errorf("failing over amphora %s: %w", amphora.ID, err)
+		}
+		failedOver = append(failedOver, amphora.ID)
+
+		if err := loadbalancers.WaitForActive(ctx, c, lbID); err != nil {
+			return failedOver, fmt.Errorf("waiting for the failover of amphora %s: %w", amphora.ID, err)
+		}
+	}
+
+	return failedOver, nil
+}
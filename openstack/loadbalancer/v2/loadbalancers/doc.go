@@ -136,5 +136,84 @@ Example to Failover a Load Balancers
 	if err != nil {
 		panic(err)
 	}
+
+Example to Reconcile the Listeners and Pools of a Load Balancer
+
+	graph := loadbalancers.Graph{
+		Listeners: []loadbalancers.GraphListener{
+			{
+				Protocol:     listeners.ProtocolHTTP,
+				ProtocolPort: 80,
+				DefaultPool:  "web",
+			},
+		},
+		Pools: []loadbalancers.GraphPool{
+			{
+				Name:     "web",
+				Protocol: pools.ProtocolHTTP,
+				LBMethod: pools.LBMethodRoundRobin,
+				Members: []pools.BatchUpdateMemberOpts{
+					{Address: "192.0.2.10", ProtocolPort: 80},
+					{Address: "192.0.2.11", ProtocolPort: 80},
+				},
+				Monitor: &monitors.CreateOpts{
+					Type:       monitors.TypeHTTP,
+					Delay:      5,
+					Timeout:    3,
+					MaxRetries: 3,
+				},
+			},
+		},
+	}
+
+	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	report, err := loadbalancers.Reconcile(context.TODO(), networkClient, lbID, graph, loadbalancers.ReconcileOpts{})
+	if err != nil {
+		for _, change := range report.Changes {
+			fmt.Printf("%s %s %s: %s %v\n", change.Action, change.Kind, change.Key, change.Status, change.Err)
+		}
+		panic(err)
+	}
+
+Example to Create a Load Balancer with a Graph
+
+	createOpts := loadbalancers.CreateOpts{
+		Name:        "db_lb",
+		VipSubnetID: "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+	}
+
+	lb, err := loadbalancers.CreateGraph(context.TODO(), networkClient, createOpts, graph)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Find the Degraded Listeners and Members of a Load Balancer
+
+	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	status, err := loadbalancers.GetStatuses(context.TODO(), networkClient, lbID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	degraded := status.Degraded()
+	for _, listener := range degraded.Listeners {
+		fmt.Printf("listener %s is %s\n", listener.ID, listener.OperatingStatus)
+	}
+	for _, member := range degraded.Members {
+		fmt.Printf("member %s of pool %s is %s\n", member.Member.ID, member.Member.PoolID, member.Member.OperatingStatus)
+	}
+
+Example to Sample the Statistics of a Load Balancer
+
+	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	err := loadbalancers.SampleStats(context.TODO(), networkClient, lbID, 10*time.Second,
+		func(sample loadbalancers.StatsSample, rates loadbalancers.StatsRates) (bool, error) {
+			fmt.Printf("%s: %.0f B/s in, %.0f B/s out, %.1f conn/s\n",
+				sample.Time, rates.BytesInPerSecond, rates.BytesOutPerSecond, rates.ConnectionsPerSecond)
+			return true, nil
+		})
+	if err != nil {
+		panic(err)
+	}
 */
 package loadbalancers
@@ -0,0 +1,18 @@
+package loadbalancers
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrProvisioningFailed is the error returned by WaitForActive when a load
+// balancer reaches the ERROR provisioning status.
+type ErrProvisioningFailed struct {
+	gophercloud.BaseError
+	LoadBalancerID string
+}
+
+func (e ErrProvisioningFailed) Error() string {
+	return fmt.Sprintf("load balancer %s is in ERROR provisioning status", e.LoadBalancerID)
+}
@@ -0,0 +1,847 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+)
+
+// Graph represents the desired listeners and pools of a load balancer, along
+// with the members and the health monitor of every pool.
+//
+// Unless stated otherwise, the pointer, slice and map fields left nil are not
+// managed: Reconcile neither sets nor compares them.
+type Graph struct {
+	// Listeners are the listeners of the load balancer, identified by their
+	// protocol and port.
+	Listeners []GraphListener
+
+	// Pools are the pools of the load balancer, identified by their name.
+	Pools []GraphPool
+}
+
+// GraphListener represents a desired listener of a Graph.
+type GraphListener struct {
+	// Protocol and ProtocolPort identify the listener. They are required.
+	Protocol     listeners.Protocol
+	ProtocolPort int
+
+	// Name of the listener.
+	Name string
+
+	// Description of the listener.
+	Description string
+
+	// DefaultPool is the name of the default pool of the listener, which
+	// must be one of the Graph.Pools. An empty DefaultPool removes the
+	// default pool of the listener.
+	DefaultPool string
+
+	// ConnLimit is the maximum number of connections allowed for the listener.
+	ConnLimit *int
+
+	// AdminStateUp is the administrative state of the listener.
+	AdminStateUp *bool
+
+	// DefaultTlsContainerRef is the reference of the TLS container of a
+	// TERMINATED_HTTPS listener.
+	DefaultTlsContainerRef string
+
+	// AllowedCIDRs is the list of CIDRs allowed to connect to the listener.
+	AllowedCIDRs []string
+
+	// InsertHeaders is a dictionary of optional headers to insert into the
+	// request before it is sent to the backend member.
+	InsertHeaders map[string]string
+
+	// Tags is a set of resource tags.
+	Tags []string
+}
+
+// GraphPool represents a desired pool of a Graph.
+type GraphPool struct {
+	// Name identifies the pool. It is required.
+	Name string
+
+	// Protocol of the pool. It is required and can't be changed once the
+	// pool is created.
+	Protocol pools.Protocol
+
+	// LBMethod is the load balancing algorithm of the pool. It is required.
+	LBMethod pools.LBMethod
+
+	// Description of the pool.
+	Description string
+
+	// Persistence is the session persistence of the pool.
+	Persistence *pools.SessionPersistence
+
+	// AdminStateUp is the administrative state of the pool.
+	AdminStateUp *bool
+
+	// Tags is a set of resource tags.
+	Tags []string
+
+	// Members are the members of the pool, identified by their address and
+	// port. The members of the pool which are not listed are deleted.
+	Members []pools.BatchUpdateMemberOpts
+
+	// Monitor is the health monitor of the pool. Its PoolID is ignored. The
+	// health monitor of the pool is deleted when Monitor is nil.
+	Monitor *monitors.CreateOpts
+}
+
+// ToCreateOpts returns a copy of opts populated with the listeners and
+// pools of the graph, which creates the whole graph along with the load
+// balancer in a single request. Every pool is nested into the listener using
+// it as default pool, so a pool can't be the default pool of several
+// listeners.
+func (g Graph) ToCreateOpts(opts CreateOpts) (CreateOpts, error) {
+	if err := g.validate(); err != nil {
+		return opts, err
+	}
+
+	nested := make(map[string]bool)
+	for i, l := range g.Listeners {
+		if l.DefaultPool == "" {
+			continue
+		}
+		if nested[l.DefaultPool] {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = fmt.Sprintf("loadbalancers.Graph.Listeners[%d].DefaultPool", i)
+			err.Value = l.DefaultPool
+			err.Info = "a pool can't be the default pool of several listeners of a fully populated load balancer"
+			return opts, err
+		}
+		nested[l.DefaultPool] = true
+	}
+
+	opts.Listeners = slices.Clone(opts.Listeners)
+	opts.Pools = slices.Clone(opts.Pools)
+	for _, p := range g.Pools {
+		if !nested[p.Name] {
+			opts.Pools = append(opts.Pools, p.toCreateOpts(""))
+		}
+	}
+	for _, l := range g.Listeners {
+		listener := l.toCreateOpts("", "")
+		for _, p := range g.Pools {
+			if p.Name == l.DefaultPool {
+				pool := p.toCreateOpts("")
+				listener.DefaultPool = &pool
+			}
+		}
+		opts.Listeners = append(opts.Listeners, listener)
+	}
+
+	return opts, nil
+}
+
+// CreateGraph creates a fully populated load balancer with the listeners and
+// pools of the graph, and waits for it to become ACTIVE.
+func CreateGraph(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOpts, graph Graph) (*LoadBalancer, error) {
+	createOpts, err := graph.ToCreateOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := Create(ctx, c, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WaitForActive(ctx, c, lb.ID); err != nil {
+		return lb, err
+	}
+
+	return Get(ctx, c, lb.ID).Extract()
+}
+
+// Kinds of the objects changed by Reconcile.
+const (
+	GraphKindListener = "listener"
+	GraphKindPool     = "pool"
+	GraphKindMembers  = "members"
+	GraphKindMonitor  = "healthmonitor"
+)
+
+// Actions of the changes applied by Reconcile.
+const (
+	GraphActionCreate = "create"
+	GraphActionUpdate = "update"
+	GraphActionDelete = "delete"
+)
+
+// Statuses of the changes applied by Reconcile.
+const (
+	GraphChangePending = "pending"
+	GraphChangeDone    = "done"
+	GraphChangeFailed  = "failed"
+)
+
+// GraphChange represents a change of a listener, a pool, the members of a
+// pool or a health monitor.
+type GraphChange struct {
+	// Kind is the kind of the changed object, one of the GraphKind*
+	// constants.
+	Kind string
+
+	// Key identifies the object in the Graph: the protocol and port of a
+	// listener, such as "HTTP:80", or the name of a pool. The members and the
+	// health monitor of a pool are identified by the name of the pool.
+	Key string
+
+	// ID is the ID of the object, once it is known. The ID of the members is
+	// the ID of their pool.
+	ID string
+
+	// Action is one of the GraphAction* constants.
+	Action string
+
+	// Status is one of the GraphChange* constants. The changes following a
+	// failed change are left pending.
+	Status string
+
+	// Err is the error of a failed change.
+	Err error
+}
+
+// ReconcileOpts represents the options of Reconcile.
+type ReconcileOpts struct {
+	// DryRun only computes the changes, without applying them.
+	DryRun bool
+}
+
+// ReconcileReport represents the changes applied by Reconcile.
+type ReconcileReport struct {
+	// Changes are the changes in the order they are applied.
+	Changes []GraphChange
+}
+
+// Reconcile compares the listeners and pools of a load balancer with the
+// graph, and applies the changes making them match in the following order:
+// pools with their members and health monitors are created or updated,
+// listeners not in the graph are deleted, listeners are created or updated,
+// and finally pools not in the graph are deleted. The members of a pool are
+// replaced in a single batch update request.
+//
+// L7 policies are not part of the graph. A pool not in the graph is only
+// deleted when no L7 policy of a remaining listener redirects to it,
+// otherwise Reconcile returns an error before applying any change.
+//
+// Octavia makes a load balancer immutable while a change is provisioned, so
+// Reconcile waits for the load balancer to become ACTIVE before and after
+// every change. It stops at the first failed change, and returns the report
+// along with the error.
+func Reconcile(ctx context.Context, c *gophercloud.ServiceClient, id string, graph Graph, opts ReconcileOpts) (*ReconcileReport, error) {
+	if err := graph.validate(); err != nil {
+		return nil, err
+	}
+
+	live, err := getLiveGraph(ctx, c, id, graph)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := planGraph(c, id, graph, live)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPoolDeletes(ctx, c, steps); err != nil {
+		return nil, err
+	}
+
+	report := &ReconcileReport{Changes: make([]GraphChange, len(steps))}
+	for i, s := range steps {
+		report.Changes[i] = s.change
+	}
+	if opts.DryRun || len(steps) == 0 {
+		return report, nil
+	}
+
+	if err := WaitForActive(ctx, c, id); err != nil {
+		return report, err
+	}
+
+	for i, s := range steps {
+		change := &report.Changes[i]
+		objectID, err := s.apply(ctx)
+		if err == nil {
+			err = WaitForActive(ctx, c, id)
+		}
+		if objectID != "" {
+			change.ID = objectID
+		}
+		if err != nil {
+			change.Status = GraphChangeFailed
+			change.Err = err
+			return report, fmt.Errorf("%s %s %s: %w", change.Action, change.Kind, change.Key, err)
+		}
+		change.Status = GraphChangeDone
+	}
+
+	return report, nil
+}
+
+// liveGraph is the current state of the listeners and pools of a load
+// balancer.
+type liveGraph struct {
+	listeners []listeners.Listener
+	pools     []pools.Pool
+	members   map[string][]pools.Member
+	monitors  map[string]monitors.Monitor
+}
+
+// graphStep is a change and the function applying it, which returns the ID
+// of the object once it is known.
+type graphStep struct {
+	change GraphChange
+	apply  func(context.Context) (string, error)
+}
+
+func getLiveGraph(ctx context.Context, c *gophercloud.ServiceClient, id string, graph Graph) (*liveGraph, error) {
+	live := &liveGraph{
+		members:  make(map[string][]pools.Member),
+		monitors: make(map[string]monitors.Monitor),
+	}
+
+	allPages, err := listeners.List(c, listeners.ListOpts{LoadbalancerID: id}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	live.listeners, err = listeners.ExtractListeners(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err = pools.List(c, pools.ListOpts{LoadbalancerID: id}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	live.pools, err = pools.ExtractPools(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := make(map[string]bool, len(graph.Pools))
+	for _, p := range graph.Pools {
+		desired[p.Name] = true
+	}
+	for _, p := range live.pools {
+		if !desired[p.Name] {
+			continue
+		}
+
+		allPages, err := pools.ListMembers(c, p.ID, nil).AllPages(ctx)
+		if err != nil {
+			return nil, err
+		}
+		live.members[p.ID], err = pools.ExtractMembers(allPages)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.MonitorID != "" {
+			monitor, err := monitors.Get(ctx, c, p.MonitorID).Extract()
+			if err != nil {
+				return nil, err
+			}
+			live.monitors[p.ID] = *monitor
+		}
+	}
+
+	return live, nil
+}
+
+func planGraph(c *gophercloud.ServiceClient, lbID string, graph Graph, live *liveGraph) ([]graphStep, error) {
+	var steps []graphStep
+	add := func(kind, key, id, action string, apply func(context.Context) (string, error)) {
+		steps = append(steps, graphStep{
+			change: GraphChange{Kind: kind, Key: key, ID: id, Action: action, Status: GraphChangePending},
+			apply:  apply,
+		})
+	}
+
+	// poolIDs maps the pool names to their IDs, and is completed as the
+	// pools are created.
+	poolIDs := make(map[string]string)
+	livePools := make(map[string]pools.Pool)
+	for _, p := range live.pools {
+		if _, ok := livePools[p.Name]; !ok {
+			livePools[p.Name] = p
+			poolIDs[p.Name] = p.ID
+		}
+	}
+
+	for i, desired := range graph.Pools {
+		name := desired.Name
+		current, ok := livePools[name]
+
+		if !ok {
+			add(GraphKindPool, name, "", GraphActionCreate, func(ctx context.Context) (string, error) {
+				pool, err := pools.Create(ctx, c, desired.toCreateOpts(lbID)).Extract()
+				if err != nil {
+					return "", err
+				}
+				poolIDs[name] = pool.ID
+				return pool.ID, nil
+			})
+		} else {
+			if !strings.EqualFold(current.Protocol, string(desired.Protocol)) {
+				err := gophercloud.ErrInvalidInput{}
+				err.Argument = fmt.Sprintf("loadbalancers.Graph.Pools[%d].Protocol", i)
+				err.Value = desired.Protocol
+				err.Info = fmt.Sprintf("the protocol of pool %s is %s and can't be changed", name, current.Protocol)
+				return nil, err
+			}
+			if updateOpts, changed := diffPool(desired, current); changed {
+				add(GraphKindPool, name, current.ID, GraphActionUpdate, func(ctx context.Context) (string, error) {
+					return "", pools.Update(ctx, c, current.ID, updateOpts).Err
+				})
+			}
+		}
+
+		if (!ok && len(desired.Members) > 0) || (ok && membersChanged(desired.Members, live.members[current.ID])) {
+			add(GraphKindMembers, name, current.ID, GraphActionUpdate, func(ctx context.Context) (string, error) {
+				poolID := poolIDs[name]
+				return poolID, pools.BatchUpdateMembers(ctx, c, poolID, desired.Members).ExtractErr()
+			})
+		}
+
+		monitor, hasMonitor := live.monitors[current.ID]
+		createMonitor := func(ctx context.Context) (string, error) {
+			createOpts := *desired.Monitor
+			createOpts.PoolID = poolIDs[name]
+			created, err := monitors.Create(ctx, c, createOpts).Extract()
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		}
+		deleteMonitor := func(ctx context.Context) (string, error) {
+			return "", ignoreNotFound(monitors.Delete(ctx, c, monitor.ID).ExtractErr())
+		}
+		switch {
+		case desired.Monitor == nil && hasMonitor:
+			add(GraphKindMonitor, name, monitor.ID, GraphActionDelete, deleteMonitor)
+		case desired.Monitor == nil:
+		case !hasMonitor:
+			add(GraphKindMonitor, name, "", GraphActionCreate, createMonitor)
+		case !strings.EqualFold(monitor.Type, desired.Monitor.Type):
+			// The type of a health monitor can't be updated.
+			add(GraphKindMonitor, name, monitor.ID, GraphActionDelete, deleteMonitor)
+			add(GraphKindMonitor, name, "", GraphActionCreate, createMonitor)
+		default:
+			if updateOpts, changed := diffMonitor(*desired.Monitor, monitor); changed {
+				add(GraphKindMonitor, name, monitor.ID, GraphActionUpdate, func(ctx context.Context) (string, error) {
+					return "", monitors.Update(ctx, c, monitor.ID, updateOpts).Err
+				})
+			}
+		}
+	}
+
+	liveListeners := make(map[string]listeners.Listener)
+	for _, l := range live.listeners {
+		key := listenerKey(l.Protocol, l.ProtocolPort)
+		if _, ok := liveListeners[key]; !ok && slices.ContainsFunc(graph.Listeners, func(d GraphListener) bool {
+			return listenerKey(string(d.Protocol), d.ProtocolPort) == key
+		}) {
+			liveListeners[key] = l
+			continue
+		}
+		add(GraphKindListener, key, l.ID, GraphActionDelete, func(ctx context.Context) (string, error) {
+			return "", ignoreNotFound(listeners.Delete(ctx, c, l.ID).ExtractErr())
+		})
+	}
+
+	for _, desired := range graph.Listeners {
+		key := listenerKey(string(desired.Protocol), desired.ProtocolPort)
+		current, ok := liveListeners[key]
+		if !ok {
+			add(GraphKindListener, key, "", GraphActionCreate, func(ctx context.Context) (string, error) {
+				listener, err := listeners.Create(ctx, c, desired.toCreateOpts(lbID, poolIDs[desired.DefaultPool])).Extract()
+				if err != nil {
+					return "", err
+				}
+				return listener.ID, nil
+			})
+			continue
+		}
+
+		// The ID of a pool to be created is only known once it is created.
+		_, poolExists := livePools[desired.DefaultPool]
+		if updateOpts, changed := diffListener(desired, current, poolIDs[desired.DefaultPool], poolExists || desired.DefaultPool == ""); changed {
+			add(GraphKindListener, key, current.ID, GraphActionUpdate, func(ctx context.Context) (string, error) {
+				if updateOpts.DefaultPoolID != nil {
+					poolID := poolIDs[desired.DefaultPool]
+					updateOpts.DefaultPoolID = &poolID
+				}
+				return "", listeners.Update(ctx, c, current.ID, updateOpts).Err
+			})
+		}
+	}
+
+	for _, p := range live.pools {
+		if slices.ContainsFunc(graph.Pools, func(d GraphPool) bool { return d.Name == p.Name }) && livePools[p.Name].ID == p.ID {
+			continue
+		}
+		add(GraphKindPool, p.Name, p.ID, GraphActionDelete, func(ctx context.Context) (string, error) {
+			return "", ignoreNotFound(pools.Delete(ctx, c, p.ID).ExtractErr())
+		})
+	}
+
+	return steps, nil
+}
+
+// checkPoolDeletes rejects the deletion of a pool which an L7 policy of a
+// remaining listener redirects to. The L7 policies of a deleted listener are
+// deleted along with it.
+func checkPoolDeletes(ctx context.Context, c *gophercloud.ServiceClient, steps []graphStep) error {
+	deletedListeners := make(map[string]bool)
+	for _, s := range steps {
+		if s.change.Kind == GraphKindListener && s.change.Action == GraphActionDelete {
+			deletedListeners[s.change.ID] = true
+		}
+	}
+
+	for _, s := range steps {
+		if s.change.Kind != GraphKindPool || s.change.Action != GraphActionDelete {
+			continue
+		}
+
+		allPages, err := l7policies.List(c, l7policies.ListOpts{RedirectPoolID: s.change.ID}).AllPages(ctx)
+		if err != nil {
+			return err
+		}
+		allPolicies, err := l7policies.ExtractL7Policies(allPages)
+		if err != nil {
+			return err
+		}
+		for _, policy := range allPolicies {
+			if deletedListeners[policy.ListenerID] {
+				continue
+			}
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "loadbalancers.Graph.Pools"
+			err.Value = s.change.Key
+			err.Info = fmt.Sprintf("pool %s is not in the graph, but L7 policy %s of listener %s redirects to it", s.change.ID, policy.ID, policy.ListenerID)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func diffPool(desired GraphPool, current pools.Pool) (pools.UpdateOpts, bool) {
+	var opts pools.UpdateOpts
+	changed := false
+
+	if desired.Description != current.Description {
+		opts.Description = &desired.Description
+		changed = true
+	}
+	if !strings.EqualFold(string(desired.LBMethod), current.LBMethod) {
+		opts.LBMethod = desired.LBMethod
+		changed = true
+	}
+	if desired.AdminStateUp != nil && *desired.AdminStateUp != current.AdminStateUp {
+		opts.AdminStateUp = desired.AdminStateUp
+		changed = true
+	}
+	if desired.Persistence != nil && *desired.Persistence != current.Persistence {
+		opts.Persistence = desired.Persistence
+		changed = true
+	}
+	if desired.Tags != nil && !equalSets(desired.Tags, current.Tags) {
+		opts.Tags = &desired.Tags
+		changed = true
+	}
+
+	return opts, changed
+}
+
+func diffMonitor(desired monitors.CreateOpts, current monitors.Monitor) (monitors.UpdateOpts, bool) {
+	// Octavia defaults the fields of a health monitor left empty, so they are
+	// only compared when they are set.
+	opts := monitors.UpdateOpts{
+		Delay:          desired.Delay,
+		Timeout:        desired.Timeout,
+		MaxRetries:     desired.MaxRetries,
+		MaxRetriesDown: desired.MaxRetriesDown,
+		URLPath:        desired.URLPath,
+		HTTPMethod:     desired.HTTPMethod,
+		ExpectedCodes:  desired.ExpectedCodes,
+		Name:           &desired.Name,
+		AdminStateUp:   desired.AdminStateUp,
+		Tags:           desired.Tags,
+	}
+	if desired.HTTPVersion != "" {
+		opts.HTTPVersion = &desired.HTTPVersion
+	}
+	if desired.DomainName != "" {
+		opts.DomainName = &desired.DomainName
+	}
+
+	changed := desired.Delay != current.Delay ||
+		desired.Timeout != current.Timeout ||
+		desired.MaxRetries != current.MaxRetries ||
+		(desired.MaxRetriesDown != 0 && desired.MaxRetriesDown != current.MaxRetriesDown) ||
+		(desired.URLPath != "" && desired.URLPath != current.URLPath) ||
+		(desired.HTTPMethod != "" && !strings.EqualFold(desired.HTTPMethod, current.HTTPMethod)) ||
+		(desired.HTTPVersion != "" && desired.HTTPVersion != current.HTTPVersion) ||
+		(desired.ExpectedCodes != "" && desired.ExpectedCodes != current.ExpectedCodes) ||
+		(desired.DomainName != "" && desired.DomainName != current.DomainName) ||
+		desired.Name != current.Name ||
+		(desired.AdminStateUp != nil && *desired.AdminStateUp != current.AdminStateUp) ||
+		(desired.Tags != nil && !equalSets(desired.Tags, current.Tags))
+
+	return opts, changed
+}
+
+// diffListener compares a desired listener with the current one. The ID of
+// the desired default pool is only compared when poolIDKnown is true,
+// otherwise the default pool is always updated.
+func diffListener(desired GraphListener, current listeners.Listener, poolID string, poolIDKnown bool) (listeners.UpdateOpts, bool) {
+	var opts listeners.UpdateOpts
+	changed := false
+
+	if desired.Name != current.Name {
+		opts.Name = &desired.Name
+		changed = true
+	}
+	if desired.Description != current.Description {
+		opts.Description = &desired.Description
+		changed = true
+	}
+	if !poolIDKnown || poolID != current.DefaultPoolID {
+		opts.DefaultPoolID = &poolID
+		changed = true
+	}
+	if desired.ConnLimit != nil && *desired.ConnLimit != current.ConnLimit {
+		opts.ConnLimit = desired.ConnLimit
+		changed = true
+	}
+	if desired.AdminStateUp != nil && *desired.AdminStateUp != current.AdminStateUp {
+		opts.AdminStateUp = desired.AdminStateUp
+		changed = true
+	}
+	if desired.DefaultTlsContainerRef != current.DefaultTlsContainerRef {
+		opts.DefaultTlsContainerRef = &desired.DefaultTlsContainerRef
+		changed = true
+	}
+	if desired.AllowedCIDRs != nil && !equalSets(desired.AllowedCIDRs, current.AllowedCIDRs) {
+		opts.AllowedCIDRs = &desired.AllowedCIDRs
+		changed = true
+	}
+	if desired.InsertHeaders != nil && !maps.Equal(desired.InsertHeaders, current.InsertHeaders) {
+		opts.InsertHeaders = &desired.InsertHeaders
+		changed = true
+	}
+	if desired.Tags != nil && !equalSets(desired.Tags, current.Tags) {
+		opts.Tags = &desired.Tags
+		changed = true
+	}
+
+	return opts, changed
+}
+
+// membersChanged reports whether the members of a pool differ from the
+// desired ones.
+func membersChanged(desired []pools.BatchUpdateMemberOpts, current []pools.Member) bool {
+	if len(desired) != len(current) {
+		return true
+	}
+
+	byKey := make(map[string]pools.Member, len(current))
+	for _, m := range current {
+		byKey[memberKey(m.Address, m.ProtocolPort)] = m
+	}
+
+	for _, d := range desired {
+		m, ok := byKey[memberKey(d.Address, d.ProtocolPort)]
+		if !ok ||
+			(d.Name != nil && *d.Name != m.Name) ||
+			(d.Weight != nil && *d.Weight != m.Weight) ||
+			(d.SubnetID != nil && *d.SubnetID != m.SubnetID) ||
+			(d.AdminStateUp != nil && *d.AdminStateUp != m.AdminStateUp) ||
+			(d.Backup != nil && *d.Backup != m.Backup) ||
+			(d.MonitorAddress != nil && *d.MonitorAddress != m.MonitorAddress) ||
+			(d.MonitorPort != nil && *d.MonitorPort != m.MonitorPort) ||
+			(d.Tags != nil && !equalSets(d.Tags, m.Tags)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g Graph) validate() error {
+	missing := func(argument string) error {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "loadbalancers.Graph." + argument
+		return err
+	}
+	duplicate := func(argument string, value any) error {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "loadbalancers.Graph." + argument
+		err.Value = value
+		err.Info = "duplicate key"
+		return err
+	}
+
+	poolNames := make(map[string]bool, len(g.Pools))
+	for i, p := range g.Pools {
+		switch {
+		case p.Name == "":
+			return missing(fmt.Sprintf("Pools[%d].Name", i))
+		case p.Protocol == "":
+			return missing(fmt.Sprintf("Pools[%d].Protocol", i))
+		case p.LBMethod == "":
+			return missing(fmt.Sprintf("Pools[%d].LBMethod", i))
+		case poolNames[p.Name]:
+			return duplicate(fmt.Sprintf("Pools[%d].Name", i), p.Name)
+		}
+		poolNames[p.Name] = true
+
+		members := make(map[string]bool, len(p.Members))
+		for j, m := range p.Members {
+			switch {
+			case m.Address == "":
+				return missing(fmt.Sprintf("Pools[%d].Members[%d].Address", i, j))
+			case m.ProtocolPort == 0:
+				return missing(fmt.Sprintf("Pools[%d].Members[%d].ProtocolPort", i, j))
+			}
+			key := memberKey(m.Address, m.ProtocolPort)
+			if members[key] {
+				return duplicate(fmt.Sprintf("Pools[%d].Members[%d]", i, j), key)
+			}
+			members[key] = true
+		}
+	}
+
+	listenerKeys := make(map[string]bool, len(g.Listeners))
+	for i, l := range g.Listeners {
+		switch {
+		case l.Protocol == "":
+			return missing(fmt.Sprintf("Listeners[%d].Protocol", i))
+		case l.ProtocolPort == 0:
+			return missing(fmt.Sprintf("Listeners[%d].ProtocolPort", i))
+		}
+		key := listenerKey(string(l.Protocol), l.ProtocolPort)
+		if listenerKeys[key] {
+			return duplicate(fmt.Sprintf("Listeners[%d]", i), key)
+		}
+		listenerKeys[key] = true
+
+		if l.DefaultPool != "" && !poolNames[l.DefaultPool] {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = fmt.Sprintf("loadbalancers.Graph.Listeners[%d].DefaultPool", i)
+			err.Value = l.DefaultPool
+			err.Info = "the default pool is not one of the pools of the graph"
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p GraphPool) toCreateOpts(lbID string) pools.CreateOpts {
+	opts := pools.CreateOpts{
+		LoadbalancerID: lbID,
+		Name:           p.Name,
+		Description:    p.Description,
+		Protocol:       p.Protocol,
+		LBMethod:       p.LBMethod,
+		Persistence:    p.Persistence,
+		AdminStateUp:   p.AdminStateUp,
+		Tags:           p.Tags,
+	}
+
+	// Members and health monitors are only created along with a pool when
+	// creating a fully populated load balancer.
+	if lbID == "" {
+		for _, m := range p.Members {
+			member := pools.CreateMemberOpts{
+				Address:      m.Address,
+				ProtocolPort: m.ProtocolPort,
+				ProjectID:    m.ProjectID,
+				Weight:       m.Weight,
+				AdminStateUp: m.AdminStateUp,
+				Backup:       m.Backup,
+				MonitorPort:  m.MonitorPort,
+				Tags:         m.Tags,
+			}
+			if m.Name != nil {
+				member.Name = *m.Name
+			}
+			if m.SubnetID != nil {
+				member.SubnetID = *m.SubnetID
+			}
+			if m.MonitorAddress != nil {
+				member.MonitorAddress = *m.MonitorAddress
+			}
+			opts.Members = append(opts.Members, member)
+		}
+		if p.Monitor != nil {
+			monitor := *p.Monitor
+			monitor.PoolID = ""
+			opts.Monitor = monitor
+		}
+	}
+
+	return opts
+}
+
+func (l GraphListener) toCreateOpts(lbID, defaultPoolID string) listeners.CreateOpts {
+	return listeners.CreateOpts{
+		LoadbalancerID:         lbID,
+		Protocol:               l.Protocol,
+		ProtocolPort:           l.ProtocolPort,
+		Name:                   l.Name,
+		Description:            l.Description,
+		DefaultPoolID:          defaultPoolID,
+		ConnLimit:              l.ConnLimit,
+		AdminStateUp:           l.AdminStateUp,
+		DefaultTlsContainerRef: l.DefaultTlsContainerRef,
+		AllowedCIDRs:           l.AllowedCIDRs,
+		InsertHeaders:          l.InsertHeaders,
+		Tags:                   l.Tags,
+	}
+}
+
+func listenerKey(protocol string, port int) string {
+	return fmt.Sprintf("%s:%d", protocol, port)
+}
+
+func memberKey(address string, port int) string {
+	if addr, err := netip.ParseAddr(address); err == nil {
+		address = addr.String()
+	}
+	return net.JoinHostPort(address, strconv.Itoa(port))
+}
+
+func equalSets(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+func ignoreNotFound(err error) error {
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,120 @@
+package loadbalancers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// StatsSample is the statistics of a load balancer at a given time.
+type StatsSample struct {
+	// Time is the time the statistics were retrieved.
+	Time time.Time
+
+	// Stats are the statistics of the load balancer.
+	Stats Stats
+}
+
+// StatsRates represents the rates of the statistics of a load balancer
+// between two samples.
+type StatsRates struct {
+	// Interval is the time elapsed between the two samples.
+	Interval time.Duration
+
+	// ActiveConnections is the number of active connections at the time of
+	// the last sample.
+	ActiveConnections int
+
+	// BytesInPerSecond is the number of bytes received per second.
+	BytesInPerSecond float64
+
+	// BytesOutPerSecond is the number of bytes sent per second.
+	BytesOutPerSecond float64
+
+	// ConnectionsPerSecond is the number of connections handled per second.
+	ConnectionsPerSecond float64
+
+	// RequestErrorsPerSecond is the number of requests which were unable to
+	// be fulfilled per second.
+	RequestErrorsPerSecond float64
+}
+
+// RatesSince computes the rates of the statistics since a previous sample.
+// The counters of a load balancer are reset when its amphorae are replaced,
+// in which case a counter lower than in the previous sample is considered to
+// have started from zero.
+func (s StatsSample) RatesSince(previous StatsSample) StatsRates {
+	rates := StatsRates{
+		Interval:          s.Time.Sub(previous.Time),
+		ActiveConnections: s.Stats.ActiveConnections,
+	}
+
+	seconds := rates.Interval.Seconds()
+	if seconds <= 0 {
+		return rates
+	}
+	rate := func(current, previous int) float64 {
+		if current < previous {
+			previous = 0
+		}
+		return float64(current-previous) / seconds
+	}
+
+	rates.BytesInPerSecond = rate(s.Stats.BytesIn, previous.Stats.BytesIn)
+	rates.BytesOutPerSecond = rate(s.Stats.BytesOut, previous.Stats.BytesOut)
+	rates.ConnectionsPerSecond = rate(s.Stats.TotalConnections, previous.Stats.TotalConnections)
+	rates.RequestErrorsPerSecond = rate(s.Stats.RequestErrors, previous.Stats.RequestErrors)
+
+	return rates
+}
+
+// SampleStats retrieves the statistics of a load balancer every interval,
+// and calls fn with every sample and the rates since the previous sample.
+// Sampling stops when fn returns false or an error, or when the context is
+// done, in which case the error of the context is returned. The interval
+// must be positive.
+func SampleStats(ctx context.Context, c *gophercloud.ServiceClient, id string, interval time.Duration, fn func(StatsSample, StatsRates) (bool, error)) error {
+	if interval <= 0 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "loadbalancers.SampleStats.interval"
+		err.Value = interval
+		err.Info = "the interval must be positive"
+		return err
+	}
+
+	sample := func() (StatsSample, error) {
+		stats, err := GetStats(ctx, c, id).Extract()
+		if err != nil {
+			return StatsSample{}, err
+		}
+		return StatsSample{Time: time.Now(), Stats: *stats}, nil
+	}
+
+	previous, err := sample()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		current, err := sample()
+		if err != nil {
+			return err
+		}
+
+		ok, err := fn(current, current.RatesSince(previous))
+		if err != nil || !ok {
+			return err
+		}
+		previous = current
+	}
+}
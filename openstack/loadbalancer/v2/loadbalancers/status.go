@@ -0,0 +1,135 @@
+package loadbalancers
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+)
+
+// StatusWalker holds the functions called by StatusTree.Walk for the objects
+// of a status tree. The functions left nil are not called.
+type StatusWalker struct {
+	// LoadBalancer is called for the load balancer at the root of the tree.
+	LoadBalancer func(lb LoadBalancer) error
+
+	// Listener is called for every listener of the load balancer.
+	Listener func(listener listeners.Listener) error
+
+	// Pool is called for every pool of a listener.
+	Pool func(listener listeners.Listener, pool pools.Pool) error
+
+	// Member is called for every member of a pool of a listener.
+	Member func(listener listeners.Listener, pool pools.Pool, member pools.Member) error
+}
+
+// Walk calls the functions of the walker for the load balancer, the
+// listeners, the pools and the members of the status tree, depth first. It
+// stops at the first error returned by a function, and returns it.
+func (t StatusTree) Walk(w StatusWalker) error {
+	if t.Loadbalancer == nil {
+		return nil
+	}
+
+	if w.LoadBalancer != nil {
+		if err := w.LoadBalancer(*t.Loadbalancer); err != nil {
+			return err
+		}
+	}
+
+	for _, listener := range t.Loadbalancer.Listeners {
+		if w.Listener != nil {
+			if err := w.Listener(listener); err != nil {
+				return err
+			}
+		}
+
+		for _, pool := range listener.Pools {
+			if w.Pool != nil {
+				if err := w.Pool(listener, pool); err != nil {
+					return err
+				}
+			}
+
+			if w.Member == nil {
+				continue
+			}
+			for _, member := range pool.Members {
+				if err := w.Member(listener, pool, member); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// DegradedMember is a member of a pool which is not healthy.
+type DegradedMember struct {
+	// ListenerID is the ID of the listener of the pool.
+	ListenerID string
+
+	// Member is the member. Its PoolID is the ID of its pool.
+	Member pools.Member
+}
+
+// DegradedReport lists the objects of a status tree which are not healthy.
+type DegradedReport struct {
+	// Listeners are the listeners which are not healthy.
+	Listeners []listeners.Listener
+
+	// Pools are the pools which are not healthy.
+	Pools []pools.Pool
+
+	// Members are the members which are not healthy.
+	Members []DegradedMember
+}
+
+// IsHealthy reports whether every object of the status tree is healthy.
+func (r DegradedReport) IsHealthy() bool {
+	return len(r.Listeners) == 0 && len(r.Pools) == 0 && len(r.Members) == 0
+}
+
+// Degraded returns the listeners, pools and members of the status tree which
+// are not healthy. An object is healthy when its provisioning status is not
+// ERROR and its operating status is ONLINE, or NO_MONITOR for a member of a
+// pool without health monitor. Objects administratively down are OFFLINE,
+// and are therefore reported.
+func (t StatusTree) Degraded() DegradedReport {
+	var report DegradedReport
+
+	_ = t.Walk(StatusWalker{
+		Listener: func(listener listeners.Listener) error {
+			if !isHealthy(listener.ProvisioningStatus, listener.OperatingStatus) {
+				report.Listeners = append(report.Listeners, listener)
+			}
+			return nil
+		},
+		Pool: func(_ listeners.Listener, pool pools.Pool) error {
+			if !isHealthy(pool.ProvisioningStatus, pool.OperatingStatus) {
+				report.Pools = append(report.Pools, pool)
+			}
+			return nil
+		},
+		Member: func(listener listeners.Listener, pool pools.Pool, member pools.Member) error {
+			operatingStatus := member.OperatingStatus
+			if operatingStatus == "NO_MONITOR" {
+				operatingStatus = "ONLINE"
+			}
+			if isHealthy(member.ProvisioningStatus, operatingStatus) {
+				return nil
+			}
+			member.PoolID = pool.ID
+			report.Members = append(report.Members, DegradedMember{
+				ListenerID: listener.ID,
+				Member:     member,
+			})
+			return nil
+		},
+	})
+
+	return report
+}
+
+func isHealthy(provisioningStatus, operatingStatus string) bool {
+	return provisioningStatus != "ERROR" && operatingStatus == "ONLINE"
+}
@@ -0,0 +1,490 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/testhelper"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const (
+	graphLoadBalancerID = "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"
+	graphWebPoolID      = "9b4c3ea1-6a49-4e3c-9a43-1d0f6f3e2b6a"
+	graphOldPoolID      = "0f2c6d1e-3a8b-4c5d-8e9f-7a1b2c3d4e5f"
+	graphAPIPoolID      = "3e0b9c8d-7f6a-4b5c-8d4e-2f1a0b9c8d7e"
+	graphWebMonitorID   = "5d7e4f3a-2b1c-4d0e-9f8a-6b5c4d3e2f1a"
+	graphAPIMonitorID   = "6a5b4c3d-2e1f-4a0b-9c8d-7e6f5a4b3c2d"
+	graphHTTPListenerID = "8d21d5ef-1c6c-4d4b-8ad4-4a4d0bbd0d1e"
+	graphSSHListenerID  = "4c6a2e3f-2bb7-4b1f-9f0a-5a6d3c3d2e7b"
+	graphAPIListenerID  = "7f8e9d0c-1b2a-4c3d-8e4f-5a6b7c8d9e0f"
+)
+
+var graphListenersBody = fmt.Sprintf(`
+{
+	"listeners": [
+		{
+			"id": "%s",
+			"name": "http",
+			"protocol": "HTTP",
+			"protocol_port": 80,
+			"default_pool_id": "%s",
+			"connection_limit": -1,
+			"admin_state_up": true
+		},
+		{
+			"id": "%s",
+			"name": "ssh",
+			"protocol": "TCP",
+			"protocol_port": 22,
+			"default_pool_id": "%s",
+			"connection_limit": -1,
+			"admin_state_up": true
+		}
+	]
+}
+`, graphHTTPListenerID, graphWebPoolID, graphSSHListenerID, graphOldPoolID)
+
+var graphPoolsBody = fmt.Sprintf(`
+{
+	"pools": [
+		{
+			"id": "%s",
+			"name": "web",
+			"protocol": "HTTP",
+			"lb_algorithm": "ROUND_ROBIN",
+			"healthmonitor_id": "%s",
+			"admin_state_up": true
+		},
+		{
+			"id": "%s",
+			"name": "old",
+			"protocol": "TCP",
+			"lb_algorithm": "ROUND_ROBIN",
+			"admin_state_up": true
+		}
+	]
+}
+`, graphWebPoolID, graphWebMonitorID, graphOldPoolID)
+
+const graphWebMembersBody = `
+{
+	"members": [
+		{
+			"id": "b85c807e-4d7c-4cbd-b725-5e8afddf80d2",
+			"address": "192.0.2.10",
+			"protocol_port": 80,
+			"weight": 1,
+			"admin_state_up": true
+		},
+		{
+			"id": "d0b0ab9d-1ec8-41b0-8a1d-2a6c4f6f0b6c",
+			"address": "192.0.2.11",
+			"protocol_port": 80,
+			"weight": 1,
+			"admin_state_up": true
+		}
+	]
+}
+`
+
+var graphWebMonitorBody = fmt.Sprintf(`
+{
+	"healthmonitor": {
+		"id": "%s",
+		"name": "web",
+		"type": "HTTP",
+		"delay": 5,
+		"timeout": 3,
+		"max_retries": 3,
+		"max_retries_down": 3,
+		"url_path": "/",
+		"http_method": "GET",
+		"expected_codes": "200",
+		"admin_state_up": true
+	}
+}
+`, graphWebMonitorID)
+
+// graphRequestBodies are the expected bodies of the mutating requests of
+// TestReconcileGraph.
+var graphRequestBodies = map[string]string{
+	"PUT /v2.0/lbaas/pools/" + graphWebPoolID: `{"pool": {"lb_algorithm": "LEAST_CONNECTIONS"}}`,
+	"PUT /v2.0/lbaas/pools/" + graphWebPoolID + "/members": `{
+		"members": [
+			{"address": "192.0.2.10", "protocol_port": 80},
+			{"address": "192.0.2.12", "protocol_port": 80, "weight": 5}
+		]
+	}`,
+	"PUT /v2.0/lbaas/healthmonitors/" + graphWebMonitorID: `{
+		"healthmonitor": {"name": "web", "delay": 10, "timeout": 3, "max_retries": 3}
+	}`,
+	"POST /v2.0/lbaas/pools": fmt.Sprintf(`{
+		"pool": {"loadbalancer_id": "%s", "name": "api", "protocol": "HTTP", "lb_algorithm": "ROUND_ROBIN"}
+	}`, graphLoadBalancerID),
+	"PUT /v2.0/lbaas/pools/" + graphAPIPoolID + "/members": `{
+		"members": [{"address": "192.0.2.20", "protocol_port": 8080}]
+	}`,
+	"POST /v2.0/lbaas/healthmonitors": fmt.Sprintf(`{
+		"healthmonitor": {"pool_id": "%s", "type": "TCP", "delay": 5, "timeout": 3, "max_retries": 3}
+	}`, graphAPIPoolID),
+	"POST /v2.0/lbaas/listeners": fmt.Sprintf(`{
+		"listener": {"loadbalancer_id": "%s", "name": "api", "protocol": "HTTP", "protocol_port": 8080, "default_pool_id": "%s"}
+	}`, graphLoadBalancerID, graphAPIPoolID),
+}
+
+// graphRequestResponses are the responses of the mutating requests of
+// TestReconcileGraph.
+var graphRequestResponses = map[string]struct {
+	code int
+	body string
+}{
+	"PUT /v2.0/lbaas/pools/" + graphWebPoolID:              {http.StatusOK, `{"pool": {}}`},
+	"PUT /v2.0/lbaas/pools/" + graphWebPoolID + "/members": {http.StatusAccepted, ""},
+	"PUT /v2.0/lbaas/healthmonitors/" + graphWebMonitorID:  {http.StatusOK, `{"healthmonitor": {}}`},
+	"POST /v2.0/lbaas/pools":                               {http.StatusCreated, fmt.Sprintf(`{"pool": {"id": "%s"}}`, graphAPIPoolID)},
+	"PUT /v2.0/lbaas/pools/" + graphAPIPoolID + "/members": {http.StatusAccepted, ""},
+	"POST /v2.0/lbaas/healthmonitors":                      {http.StatusCreated, fmt.Sprintf(`{"healthmonitor": {"id": "%s"}}`, graphAPIMonitorID)},
+	"DELETE /v2.0/lbaas/listeners/" + graphSSHListenerID:   {http.StatusNoContent, ""},
+	"POST /v2.0/lbaas/listeners":                           {http.StatusCreated, fmt.Sprintf(`{"listener": {"id": "%s"}}`, graphAPIListenerID)},
+	"DELETE /v2.0/lbaas/pools/" + graphOldPoolID:           {http.StatusNoContent, ""},
+}
+
+// HandleGraph sets up the test server to respond to the requests of a graph
+// reconciliation. The mutating requests are recorded in the returned slice,
+// and the one matching failing fails with a conflict. The listeners of
+// redirectingListenerIDs have an L7 policy redirecting to the old pool.
+func HandleGraph(t *testing.T, fakeServer th.FakeServer, failing string, redirectingListenerIDs ...string) (*[]string, *int) {
+	var (
+		mu        sync.Mutex
+		requests  []string
+		waitCount int
+	)
+
+	respond := func(w http.ResponseWriter, body string) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	}
+
+	fakeServer.Mux.HandleFunc("/v2.0/lbaas/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		if r.Method == "GET" {
+			switch r.URL.Path {
+			case "/v2.0/lbaas/loadbalancers/" + graphLoadBalancerID:
+				mu.Lock()
+				waitCount++
+				mu.Unlock()
+				respond(w, fmt.Sprintf(`{"loadbalancer": {"id": "%s", "provisioning_status": "ACTIVE"}}`, graphLoadBalancerID))
+			case "/v2.0/lbaas/listeners":
+				th.TestFormValues(t, r, map[string]string{"loadbalancer_id": graphLoadBalancerID})
+				respond(w, graphListenersBody)
+			case "/v2.0/lbaas/pools":
+				th.TestFormValues(t, r, map[string]string{"loadbalancer_id": graphLoadBalancerID})
+				respond(w, graphPoolsBody)
+			case "/v2.0/lbaas/pools/" + graphWebPoolID + "/members":
+				respond(w, graphWebMembersBody)
+			case "/v2.0/lbaas/healthmonitors/" + graphWebMonitorID:
+				respond(w, graphWebMonitorBody)
+			case "/v2.0/lbaas/l7policies":
+				th.TestFormValues(t, r, map[string]string{"redirect_pool_id": graphOldPoolID})
+				policies := make([]string, 0, len(redirectingListenerIDs))
+				for i, listenerID := range redirectingListenerIDs {
+					policies = append(policies, fmt.Sprintf(`{"id": "policy-%d", "listener_id": "%s", "action": "REDIRECT_TO_POOL", "redirect_pool_id": "%s"}`,
+						i, listenerID, graphOldPoolID))
+				}
+				respond(w, `{"l7policies": [`+strings.Join(policies, ",")+`]}`)
+			default:
+				t.Errorf("unexpected request GET %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+			return
+		}
+
+		request := r.Method + " " + r.URL.Path
+		mu.Lock()
+		requests = append(requests, request)
+		mu.Unlock()
+
+		if body, ok := graphRequestBodies[request]; ok {
+			th.TestJSONRequest(t, r, body)
+		}
+		if request == failing {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		response, ok := graphRequestResponses[request]
+		if !ok {
+			t.Errorf("unexpected request %s", request)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if response.body != "" {
+			w.Header().Add("Content-Type", "application/json")
+		}
+		w.WriteHeader(response.code)
+		fmt.Fprint(w, response.body)
+	})
+
+	return &requests, &waitCount
+}
+
+func desiredGraph() loadbalancers.Graph {
+	weight := 5
+	return loadbalancers.Graph{
+		Listeners: []loadbalancers.GraphListener{
+			{
+				Protocol:     listeners.ProtocolHTTP,
+				ProtocolPort: 80,
+				Name:         "http",
+				DefaultPool:  "web",
+			},
+			{
+				Protocol:     listeners.ProtocolHTTP,
+				ProtocolPort: 8080,
+				Name:         "api",
+				DefaultPool:  "api",
+			},
+		},
+		Pools: []loadbalancers.GraphPool{
+			{
+				Name:     "web",
+				Protocol: pools.ProtocolHTTP,
+				LBMethod: pools.LBMethodLeastConnections,
+				Members: []pools.BatchUpdateMemberOpts{
+					{Address: "192.0.2.10", ProtocolPort: 80},
+					{Address: "192.0.2.12", ProtocolPort: 80, Weight: &weight},
+				},
+				Monitor: &monitors.CreateOpts{
+					Name:       "web",
+					Type:       monitors.TypeHTTP,
+					Delay:      10,
+					Timeout:    3,
+					MaxRetries: 3,
+				},
+			},
+			{
+				Name:     "api",
+				Protocol: pools.ProtocolHTTP,
+				LBMethod: pools.LBMethodRoundRobin,
+				Members: []pools.BatchUpdateMemberOpts{
+					{Address: "192.0.2.20", ProtocolPort: 8080},
+				},
+				Monitor: &monitors.CreateOpts{
+					Type:       monitors.TypeTCP,
+					Delay:      5,
+					Timeout:    3,
+					MaxRetries: 3,
+				},
+			},
+		},
+	}
+}
+
+func TestReconcileGraph(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	requests, waitCount := HandleGraph(t, fakeServer, "")
+
+	report, err := loadbalancers.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), graphLoadBalancerID, desiredGraph(), loadbalancers.ReconcileOpts{})
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{
+		"PUT /v2.0/lbaas/pools/" + graphWebPoolID,
+		"PUT /v2.0/lbaas/pools/" + graphWebPoolID + "/members",
+		"PUT /v2.0/lbaas/healthmonitors/" + graphWebMonitorID,
+		"POST /v2.0/lbaas/pools",
+		"PUT /v2.0/lbaas/pools/" + graphAPIPoolID + "/members",
+		"POST /v2.0/lbaas/healthmonitors",
+		"DELETE /v2.0/lbaas/listeners/" + graphSSHListenerID,
+		"POST /v2.0/lbaas/listeners",
+		"DELETE /v2.0/lbaas/pools/" + graphOldPoolID,
+	}, *requests)
+
+	// The load balancer is waited for before the first change, and after
+	// every change.
+	th.AssertEquals(t, len(*requests)+1, *waitCount)
+
+	th.CheckDeepEquals(t, []loadbalancers.GraphChange{
+		{Kind: loadbalancers.GraphKindPool, Key: "web", ID: graphWebPoolID, Action: loadbalancers.GraphActionUpdate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindMembers, Key: "web", ID: graphWebPoolID, Action: loadbalancers.GraphActionUpdate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindMonitor, Key: "web", ID: graphWebMonitorID, Action: loadbalancers.GraphActionUpdate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindPool, Key: "api", ID: graphAPIPoolID, Action: loadbalancers.GraphActionCreate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindMembers, Key: "api", ID: graphAPIPoolID, Action: loadbalancers.GraphActionUpdate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindMonitor, Key: "api", ID: graphAPIMonitorID, Action: loadbalancers.GraphActionCreate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindListener, Key: "TCP:22", ID: graphSSHListenerID, Action: loadbalancers.GraphActionDelete, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindListener, Key: "HTTP:8080", ID: graphAPIListenerID, Action: loadbalancers.GraphActionCreate, Status: loadbalancers.GraphChangeDone},
+		{Kind: loadbalancers.GraphKindPool, Key: "old", ID: graphOldPoolID, Action: loadbalancers.GraphActionDelete, Status: loadbalancers.GraphChangeDone},
+	}, report.Changes)
+}
+
+func TestReconcileGraphDeletedListenerRedirect(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	// The L7 policy is deleted along with its listener, before the pool.
+	requests, _ := HandleGraph(t, fakeServer, "", graphSSHListenerID)
+
+	_, err := loadbalancers.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), graphLoadBalancerID, desiredGraph(), loadbalancers.ReconcileOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "DELETE /v2.0/lbaas/pools/"+graphOldPoolID, (*requests)[len(*requests)-1])
+}
+
+func TestReconcileGraphRedirectPool(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	requests, _ := HandleGraph(t, fakeServer, "", graphHTTPListenerID)
+
+	// Nothing is applied when a pool to delete is still in use.
+	_, err := loadbalancers.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), graphLoadBalancerID, desiredGraph(), loadbalancers.ReconcileOpts{})
+	var invalidInput gophercloud.ErrInvalidInput
+	if !errors.As(err, &invalidInput) {
+		t.Fatalf("expected an invalid input error, got %v", err)
+	}
+	th.AssertEquals(t, "old", invalidInput.Value)
+	th.AssertEquals(t, 0, len(*requests))
+}
+
+func TestReconcileGraphDryRun(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	requests, _ := HandleGraph(t, fakeServer, "")
+
+	report, err := loadbalancers.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), graphLoadBalancerID, desiredGraph(), loadbalancers.ReconcileOpts{DryRun: true})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 0, len(*requests))
+	th.AssertEquals(t, 9, len(report.Changes))
+	for _, change := range report.Changes {
+		th.AssertEquals(t, loadbalancers.GraphChangePending, change.Status)
+	}
+}
+
+func TestReconcileGraphFailure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	requests, _ := HandleGraph(t, fakeServer, "POST /v2.0/lbaas/pools")
+
+	report, err := loadbalancers.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), graphLoadBalancerID, desiredGraph(), loadbalancers.ReconcileOpts{})
+	if !gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "create pool api") {
+		t.Errorf("expected the error to name the failed change, got %v", err)
+	}
+
+	th.AssertEquals(t, 4, len(*requests))
+	for i, change := range report.Changes {
+		switch {
+		case i < 3:
+			th.AssertEquals(t, loadbalancers.GraphChangeDone, change.Status)
+		case i == 3:
+			th.AssertEquals(t, loadbalancers.GraphChangeFailed, change.Status)
+			th.AssertTrue(t, change.Err != nil)
+		default:
+			th.AssertEquals(t, loadbalancers.GraphChangePending, change.Status)
+		}
+	}
+}
+
+func TestReconcileGraphInvalid(t *testing.T) {
+	graph := desiredGraph()
+	graph.Listeners[1].DefaultPool = "missing"
+
+	_, err := loadbalancers.Reconcile(context.TODO(), nil, graphLoadBalancerID, graph, loadbalancers.ReconcileOpts{})
+	var invalidInput gophercloud.ErrInvalidInput
+	if !errors.As(err, &invalidInput) {
+		t.Fatalf("expected an invalid input error, got %v", err)
+	}
+	th.AssertEquals(t, "loadbalancers.Graph.Listeners[1].DefaultPool", invalidInput.Argument)
+}
+
+func TestGraphToCreateOpts(t *testing.T) {
+	graph := desiredGraph()
+	graph.Listeners = graph.Listeners[:1]
+
+	opts, err := graph.ToCreateOpts(loadbalancers.CreateOpts{
+		Name:        "db_lb",
+		VipSubnetID: "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+	})
+	th.AssertNoErr(t, err)
+
+	b, err := opts.ToLoadBalancerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, `{
+		"loadbalancer": {
+			"name": "db_lb",
+			"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+			"listeners": [
+				{
+					"name": "http",
+					"protocol": "HTTP",
+					"protocol_port": 80,
+					"default_pool": {
+						"name": "web",
+						"protocol": "HTTP",
+						"lb_algorithm": "LEAST_CONNECTIONS",
+						"members": [
+							{"address": "192.0.2.10", "protocol_port": 80},
+							{"address": "192.0.2.12", "protocol_port": 80, "weight": 5}
+						],
+						"healthmonitor": {"name": "web", "type": "HTTP", "delay": 10, "timeout": 3, "max_retries": 3}
+					}
+				}
+			],
+			"pools": [
+				{
+					"name": "api",
+					"protocol": "HTTP",
+					"lb_algorithm": "ROUND_ROBIN",
+					"members": [{"address": "192.0.2.20", "protocol_port": 8080}],
+					"healthmonitor": {"type": "TCP", "delay": 5, "timeout": 3, "max_retries": 3}
+				}
+			]
+		}
+	}`, b)
+
+	graph = desiredGraph()
+	graph.Listeners[1].DefaultPool = "web"
+	_, err = graph.ToCreateOpts(loadbalancers.CreateOpts{})
+	var invalidInput gophercloud.ErrInvalidInput
+	if !errors.As(err, &invalidInput) {
+		t.Fatalf("expected an invalid input error, got %v", err)
+	}
+}
+
+func TestWaitForActiveError(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/"+graphLoadBalancerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "%s", "provisioning_status": "ERROR"}}`, graphLoadBalancerID)
+	})
+
+	err := loadbalancers.WaitForActive(context.TODO(), fake.ServiceClient(fakeServer), graphLoadBalancerID)
+	var provisioningFailed loadbalancers.ErrProvisioningFailed
+	if !errors.As(err, &provisioningFailed) {
+		t.Fatalf("expected an ErrProvisioningFailed, got %v", err)
+	}
+	th.AssertEquals(t, graphLoadBalancerID, provisioningFailed.LoadBalancerID)
+}
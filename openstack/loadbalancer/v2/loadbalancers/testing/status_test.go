@@ -0,0 +1,219 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/testhelper"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var degradedStatusTree = loadbalancers.StatusTree{
+	Loadbalancer: &loadbalancers.LoadBalancer{
+		ID:                 "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		ProvisioningStatus: "ACTIVE",
+		OperatingStatus:    "DEGRADED",
+		Listeners: []listeners.Listener{
+			{
+				ID:                 "db902c0c-d5ff-4753-b465-668ad9656918",
+				ProvisioningStatus: "ACTIVE",
+				OperatingStatus:    "DEGRADED",
+				Pools: []pools.Pool{{
+					ID:                 "fad389a3-9a4a-4762-a365-8c7038508b5d",
+					ProvisioningStatus: "ACTIVE",
+					OperatingStatus:    "DEGRADED",
+					Members: []pools.Member{
+						{ID: "2a280670-c202-4b0b-a562-34077415aabf", ProvisioningStatus: "ACTIVE", OperatingStatus: "ONLINE"},
+						{ID: "7d19ad6c-d549-453e-a5cd-05382c6be96a", ProvisioningStatus: "ACTIVE", OperatingStatus: "ERROR"},
+						{ID: "b85c807e-4d7c-4cbd-b725-5e8afddf80d2", ProvisioningStatus: "ACTIVE", OperatingStatus: "NO_MONITOR"},
+					},
+				}},
+			},
+			{
+				ID:                 "8d21d5ef-1c6c-4d4b-8ad4-4a4d0bbd0d1e",
+				ProvisioningStatus: "ACTIVE",
+				OperatingStatus:    "ONLINE",
+				Pools: []pools.Pool{{
+					ID:                 "9b4c3ea1-6a49-4e3c-9a43-1d0f6f3e2b6a",
+					ProvisioningStatus: "ACTIVE",
+					OperatingStatus:    "ONLINE",
+					Members: []pools.Member{
+						{ID: "d0b0ab9d-1ec8-41b0-8a1d-2a6c4f6f0b6c", ProvisioningStatus: "ERROR", OperatingStatus: "NO_MONITOR"},
+					},
+				}},
+			},
+		},
+	},
+}
+
+func TestStatusTreeWalk(t *testing.T) {
+	var visited []string
+	err := degradedStatusTree.Walk(loadbalancers.StatusWalker{
+		LoadBalancer: func(lb loadbalancers.LoadBalancer) error {
+			visited = append(visited, "loadbalancer "+lb.ID)
+			return nil
+		},
+		Listener: func(listener listeners.Listener) error {
+			visited = append(visited, "listener "+listener.ID)
+			return nil
+		},
+		Member: func(listener listeners.Listener, pool pools.Pool, member pools.Member) error {
+			visited = append(visited, fmt.Sprintf("member %s/%s/%s", listener.ID, pool.ID, member.ID))
+			return nil
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{
+		"loadbalancer 36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		"listener db902c0c-d5ff-4753-b465-668ad9656918",
+		"member db902c0c-d5ff-4753-b465-668ad9656918/fad389a3-9a4a-4762-a365-8c7038508b5d/2a280670-c202-4b0b-a562-34077415aabf",
+		"member db902c0c-d5ff-4753-b465-668ad9656918/fad389a3-9a4a-4762-a365-8c7038508b5d/7d19ad6c-d549-453e-a5cd-05382c6be96a",
+		"member db902c0c-d5ff-4753-b465-668ad9656918/fad389a3-9a4a-4762-a365-8c7038508b5d/b85c807e-4d7c-4cbd-b725-5e8afddf80d2",
+		"listener 8d21d5ef-1c6c-4d4b-8ad4-4a4d0bbd0d1e",
+		"member 8d21d5ef-1c6c-4d4b-8ad4-4a4d0bbd0d1e/9b4c3ea1-6a49-4e3c-9a43-1d0f6f3e2b6a/d0b0ab9d-1ec8-41b0-8a1d-2a6c4f6f0b6c",
+	}, visited)
+
+	errStop := errors.New("stop")
+	var visitedPools int
+	err = degradedStatusTree.Walk(loadbalancers.StatusWalker{
+		Pool: func(listeners.Listener, pools.Pool) error {
+			visitedPools++
+			return errStop
+		},
+	})
+	if !errors.Is(err, errStop) {
+		t.Fatalf("expected the error of the walker, got %v", err)
+	}
+	th.AssertEquals(t, 1, visitedPools)
+}
+
+func TestStatusTreeDegraded(t *testing.T) {
+	report := degradedStatusTree.Degraded()
+	th.AssertEquals(t, false, report.IsHealthy())
+
+	th.AssertEquals(t, 1, len(report.Listeners))
+	th.AssertEquals(t, "db902c0c-d5ff-4753-b465-668ad9656918", report.Listeners[0].ID)
+
+	th.AssertEquals(t, 1, len(report.Pools))
+	th.AssertEquals(t, "fad389a3-9a4a-4762-a365-8c7038508b5d", report.Pools[0].ID)
+
+	th.AssertEquals(t, 2, len(report.Members))
+	th.AssertEquals(t, "db902c0c-d5ff-4753-b465-668ad9656918", report.Members[0].ListenerID)
+	th.AssertEquals(t, "7d19ad6c-d549-453e-a5cd-05382c6be96a", report.Members[0].Member.ID)
+	th.AssertEquals(t, "fad389a3-9a4a-4762-a365-8c7038508b5d", report.Members[0].Member.PoolID)
+	th.AssertEquals(t, "8d21d5ef-1c6c-4d4b-8ad4-4a4d0bbd0d1e", report.Members[1].ListenerID)
+	th.AssertEquals(t, "d0b0ab9d-1ec8-41b0-8a1d-2a6c4f6f0b6c", report.Members[1].Member.ID)
+
+	th.AssertEquals(t, true, loadbalancers.StatusTree{}.Degraded().IsHealthy())
+}
+
+func TestStatsRatesSince(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	previous := loadbalancers.StatsSample{
+		Time:  start,
+		Stats: loadbalancers.Stats{BytesIn: 1000, BytesOut: 4000, TotalConnections: 10, RequestErrors: 2},
+	}
+	current := loadbalancers.StatsSample{
+		Time:  start.Add(10 * time.Second),
+		Stats: loadbalancers.Stats{ActiveConnections: 3, BytesIn: 3000, BytesOut: 24000, TotalConnections: 60, RequestErrors: 2},
+	}
+
+	th.CheckDeepEquals(t, loadbalancers.StatsRates{
+		Interval:               10 * time.Second,
+		ActiveConnections:      3,
+		BytesInPerSecond:       200,
+		BytesOutPerSecond:      2000,
+		ConnectionsPerSecond:   5,
+		RequestErrorsPerSecond: 0,
+	}, current.RatesSince(previous))
+
+	// The counters were reset between the samples.
+	reset := loadbalancers.StatsSample{
+		Time:  start.Add(20 * time.Second),
+		Stats: loadbalancers.Stats{BytesIn: 500, BytesOut: 1000, TotalConnections: 20, RequestErrors: 1},
+	}
+	th.CheckDeepEquals(t, loadbalancers.StatsRates{
+		Interval:               10 * time.Second,
+		BytesInPerSecond:       50,
+		BytesOutPerSecond:      100,
+		ConnectionsPerSecond:   2,
+		RequestErrorsPerSecond: 0.1,
+	}, reset.RatesSince(current))
+}
+
+func TestSampleStats(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var requests int
+	fakeServer.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab/stats", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		requests++
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"stats": {"active_connections": 1, "bytes_in": %d, "bytes_out": %d, "request_errors": 0, "total_connections": %d}}`,
+			requests*1000, requests*2000, requests*10)
+	})
+
+	var samples []loadbalancers.StatsSample
+	err := loadbalancers.SampleStats(context.TODO(), fake.ServiceClient(fakeServer), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", 10*time.Millisecond,
+		func(sample loadbalancers.StatsSample, rates loadbalancers.StatsRates) (bool, error) {
+			samples = append(samples, sample)
+			if rates.Interval <= 0 || rates.BytesInPerSecond <= 0 || rates.BytesOutPerSecond != 2*rates.BytesInPerSecond {
+				t.Errorf("unexpected rates %+v", rates)
+			}
+			return len(samples) < 2, nil
+		})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 3, requests)
+	th.AssertEquals(t, 2, len(samples))
+	th.AssertEquals(t, 2000, samples[0].Stats.BytesIn)
+	th.AssertEquals(t, 3000, samples[1].Stats.BytesIn)
+}
+
+func TestSampleStatsContextDone(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleLoadbalancerGetStatsTree(t, fakeServer)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	err := loadbalancers.SampleStats(ctx, fake.ServiceClient(fakeServer), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", time.Hour,
+		func(loadbalancers.StatsSample, loadbalancers.StatsRates) (bool, error) {
+			t.Errorf("unexpected sample")
+			return true, nil
+		})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the context deadline to be exceeded, got %v", err)
+	}
+}
+
+func TestSampleStatsInvalidInterval(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	err := loadbalancers.SampleStats(context.TODO(), fake.ServiceClient(fakeServer), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", 0,
+		func(loadbalancers.StatsSample, loadbalancers.StatsRates) (bool, error) {
+			t.Errorf("unexpected sample")
+			return true, nil
+		})
+	var invalid gophercloud.ErrInvalidInput
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected an ErrInvalidInput, got %v", err)
+	}
+	th.AssertEquals(t, "loadbalancers.SampleStats.interval", invalid.Argument)
+}
@@ -0,0 +1,29 @@
+package loadbalancers
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// WaitForActive will continually poll the load balancer until its
+// provisioning status is ACTIVE, or returns an ErrProvisioningFailed when it
+// is ERROR. Octavia rejects any change to a load balancer or to its children
+// while its provisioning status is PENDING_*.
+func WaitForActive(ctx context.Context, c *gophercloud.ServiceClient, id string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch current.ProvisioningStatus {
+		case "ACTIVE":
+			return true, nil
+		case "ERROR":
+			return false, ErrProvisioningFailed{LoadBalancerID: id}
+		}
+
+		return false, nil
+	})
+}
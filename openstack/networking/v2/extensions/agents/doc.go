@@ -156,6 +156,40 @@ Example to schedule router to L3 agent
             log.Panic(err)
         }
 
+Example to List the L3 agents hosting a router
+
+	routerID := "e6fa0457-efc2-491d-ac12-17ab60417efd"
+	allPages, err := agents.ListL3AgentsHostingRouter(neutron, routerID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAgents, err := agents.ExtractAgents(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, agent := range allAgents {
+		fmt.Printf("%s: %s\n", agent.ID, agent.HAState)
+	}
+
+Example to evacuate an L3 agent
+
+	agentID := "0e1095ae-6f36-40f3-8322-8e1c9a5e68ca"
+	opts := agents.EvacuateOpts{
+		DisableAgent: true,
+		DryRun:       true,
+	}
+
+	plan, err := agents.Evacuate(context.TODO(), neutron, agentID, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, move := range plan.Moves {
+		fmt.Printf("%s %s: %s -> %s\n", move.Kind, move.ResourceID, move.FromAgentID, move.ToAgentID)
+	}
+
 
 */
 
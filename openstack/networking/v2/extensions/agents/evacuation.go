@@ -0,0 +1,323 @@
+package agents
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+const (
+	// AgentTypeL3 is the type of the agents hosting routers.
+	AgentTypeL3 = "L3 agent"
+
+	// AgentTypeDHCP is the type of the agents hosting DHCP networks.
+	AgentTypeDHCP = "DHCP agent"
+)
+
+const (
+	// HAStateActive is the HA state of the agent forwarding the traffic of
+	// an HA router.
+	HAStateActive = "active"
+
+	// HAStateStandby is the HA state of the agents ready to take over an HA
+	// router.
+	HAStateStandby = "standby"
+)
+
+// MoveKind is the kind of resource rescheduled by a Move.
+type MoveKind string
+
+const (
+	// MoveRouter is the rescheduling of a router between L3 agents.
+	MoveRouter MoveKind = "router"
+
+	// MoveDHCPNetwork is the rescheduling of a network between DHCP agents.
+	MoveDHCPNetwork MoveKind = "network"
+)
+
+// EvacuateOpts represents the options of an agent evacuation.
+type EvacuateOpts struct {
+	// TargetAgentIDs restricts the agents the resources can be moved to.
+	// All the alive and administratively up agents of the same type are
+	// candidates when it is empty.
+	TargetAgentIDs []string
+
+	// DisableAgent sets the evacuated agent administratively down before
+	// moving its resources, so the scheduler doesn't place new ones on it.
+	DisableAgent bool
+
+	// DryRun only computes the evacuation plan without changing anything.
+	DryRun bool
+}
+
+// Move is the rescheduling of a resource off the evacuated agent.
+type Move struct {
+	// Kind is the kind of the rescheduled resource.
+	Kind MoveKind
+
+	// ResourceID is the ID of the router or network.
+	ResourceID string
+
+	// HAState is the HA state of the router on the evacuated agent. It is
+	// empty for legacy routers and networks.
+	HAState string
+
+	// FromAgentID is the ID of the evacuated agent.
+	FromAgentID string
+
+	// ToAgentID is the ID of the agent the resource is scheduled to. It is
+	// empty when the resource is already hosted by all the candidate
+	// agents, in which case it is only removed from the evacuated agent.
+	ToAgentID string
+
+	// Done reports whether the move has been carried out.
+	Done bool
+}
+
+// EvacuationPlan represents the moves needed to evacuate an agent, in the
+// order they are carried out.
+type EvacuationPlan struct {
+	// Agent is the evacuated agent.
+	Agent Agent
+
+	// Moves are the moves of the resources hosted by the agent.
+	Moves []Move
+}
+
+// PlanEvacuation computes the moves needed to evacuate the routers of an L3
+// agent or the networks of a DHCP agent, without changing anything.
+//
+// Each resource is assigned to the candidate agent not hosting it yet which
+// hosts the fewest routers or networks, counting the ones it already hosts
+// and the ones assigned to it by the plan. The moves are ordered so that the evacuation disrupts the traffic
+// as little as possible: DHCP networks first, then the HA routers the agent
+// is standby for, then the HA routers it is active for, which fail over, and
+// finally the legacy routers, which are unavailable until rescheduled.
+func PlanEvacuation(ctx context.Context, c *gophercloud.ServiceClient, agentID string, opts EvacuateOpts) (*EvacuationPlan, error) {
+	agent, err := Get(ctx, c, agentID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var kind MoveKind
+	switch agent.AgentType {
+	case AgentTypeL3:
+		kind = MoveRouter
+	case AgentTypeDHCP:
+		kind = MoveDHCPNetwork
+	default:
+		return nil, fmt.Errorf("agent %s of type %q can't be evacuated", agentID, agent.AgentType)
+	}
+
+	resourceIDs, err := hostedResources(ctx, c, kind, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := evacuationCandidates(ctx, c, agent, opts.TargetAgentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	load := make(map[string]int, len(candidates))
+	for _, candidate := range candidates {
+		hosted, err := hostedResources(ctx, c, kind, candidate.ID)
+		if err != nil {
+			return nil, err
+		}
+		load[candidate.ID] = len(hosted)
+	}
+
+	plan := &EvacuationPlan{Agent: *agent}
+	for _, resourceID := range resourceIDs {
+		hosts, err := hostingAgents(ctx, c, kind, resourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		move := Move{
+			Kind:        kind,
+			ResourceID:  resourceID,
+			FromAgentID: agentID,
+		}
+
+		hostedElsewhere := false
+		for _, host := range hosts {
+			if host.ID == agentID {
+				move.HAState = host.HAState
+			} else if slices.ContainsFunc(candidates, func(a Agent) bool { return a.ID == host.ID }) {
+				hostedElsewhere = true
+			}
+		}
+
+		for _, candidate := range candidates {
+			if slices.ContainsFunc(hosts, func(a Agent) bool { return a.ID == candidate.ID }) {
+				continue
+			}
+			if move.ToAgentID == "" || load[candidate.ID] < load[move.ToAgentID] {
+				move.ToAgentID = candidate.ID
+			}
+		}
+
+		if move.ToAgentID != "" {
+			load[move.ToAgentID]++
+		} else if !hostedElsewhere {
+			return nil, fmt.Errorf("no agent available to host %s %s", kind, resourceID)
+		}
+
+		plan.Moves = append(plan.Moves, move)
+	}
+
+	sort.SliceStable(plan.Moves, func(i, j int) bool {
+		return moveRank(plan.Moves[i]) < moveRank(plan.Moves[j])
+	})
+
+	return plan, nil
+}
+
+// Evacuate moves the routers of an L3 agent or the networks of a DHCP agent
+// onto other healthy agents of the same type, following the plan computed by
+// PlanEvacuation. HA routers and networks are scheduled to their new agent
+// before being removed from the evacuated one. Legacy routers, which can
+// only be hosted by one agent, are removed first.
+//
+// The returned plan reports which moves have been carried out, including
+// when an error interrupts the evacuation. Nothing is changed when
+// opts.DryRun is set.
+func Evacuate(ctx context.Context, c *gophercloud.ServiceClient, agentID string, opts EvacuateOpts) (*EvacuationPlan, error) {
+	plan, err := PlanEvacuation(ctx, c, agentID, opts)
+	if err != nil || opts.DryRun {
+		return plan, err
+	}
+
+	if opts.DisableAgent {
+		adminStateUp := false
+		err := Update(ctx, c, agentID, UpdateOpts{AdminStateUp: &adminStateUp}).Err
+		if err != nil {
+			return plan, err
+		}
+	}
+
+	for i := range plan.Moves {
+		move := &plan.Moves[i]
+		if err := carryOut(ctx, c, *move); err != nil {
+			return plan, fmt.Errorf("moving %s %s from agent %s to agent %s: %w", move.Kind, move.ResourceID, move.FromAgentID, move.ToAgentID, err)
+		}
+		move.Done = true
+	}
+
+	return plan, nil
+}
+
+// evacuationCandidates returns the alive and administratively up agents of
+// the same type as the evacuated agent.
+func evacuationCandidates(ctx context.Context, c *gophercloud.ServiceClient, agent *Agent, targetAgentIDs []string) ([]Agent, error) {
+	alive := true
+	allPages, err := List(c, ListOpts{AgentType: agent.AgentType, Alive: &alive}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allAgents, err := ExtractAgents(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []Agent
+	for _, a := range allAgents {
+		if a.ID == agent.ID || !a.Alive || !a.AdminStateUp {
+			continue
+		}
+		if len(targetAgentIDs) > 0 && !slices.Contains(targetAgentIDs, a.ID) {
+			continue
+		}
+		candidates = append(candidates, a)
+	}
+	return candidates, nil
+}
+
+// hostedResources returns the IDs of the routers of an L3 agent or of the
+// networks of a DHCP agent.
+func hostedResources(ctx context.Context, c *gophercloud.ServiceClient, kind MoveKind, agentID string) ([]string, error) {
+	var resourceIDs []string
+	if kind == MoveRouter {
+		routers, err := ListL3Routers(ctx, c, agentID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			resourceIDs = append(resourceIDs, router.ID)
+		}
+		return resourceIDs, nil
+	}
+
+	networks, err := ListDHCPNetworks(ctx, c, agentID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networks {
+		resourceIDs = append(resourceIDs, network.ID)
+	}
+	return resourceIDs, nil
+}
+
+// hostingAgents returns the agents hosting a router or a network.
+func hostingAgents(ctx context.Context, c *gophercloud.ServiceClient, kind MoveKind, resourceID string) ([]Agent, error) {
+	pager := ListDHCPAgentsHostingNetwork(c, resourceID)
+	if kind == MoveRouter {
+		pager = ListL3AgentsHostingRouter(c, resourceID)
+	}
+	allPages, err := pager.AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ExtractAgents(allPages)
+}
+
+// moveRank orders the moves of an evacuation from the least to the most
+// disruptive.
+func moveRank(move Move) int {
+	switch {
+	case move.Kind == MoveDHCPNetwork:
+		return 0
+	case move.HAState == HAStateActive:
+		return 2
+	case move.HAState == "":
+		return 3
+	default:
+		return 1
+	}
+}
+
+// carryOut reschedules a resource as described by a move.
+func carryOut(ctx context.Context, c *gophercloud.ServiceClient, move Move) error {
+	schedule := func() error {
+		if move.ToAgentID == "" {
+			return nil
+		}
+		if move.Kind == MoveRouter {
+			return ScheduleL3Router(ctx, c, move.ToAgentID, ScheduleL3RouterOpts{RouterID: move.ResourceID}).ExtractErr()
+		}
+		return ScheduleDHCPNetwork(ctx, c, move.ToAgentID, ScheduleDHCPNetworkOpts{NetworkID: move.ResourceID}).ExtractErr()
+	}
+	remove := func() error {
+		if move.Kind == MoveRouter {
+			return RemoveL3Router(ctx, c, move.FromAgentID, move.ResourceID).ExtractErr()
+		}
+		return RemoveDHCPNetwork(ctx, c, move.FromAgentID, move.ResourceID).ExtractErr()
+	}
+
+	if move.Kind == MoveRouter && move.HAState == "" {
+		if err := remove(); err != nil {
+			return err
+		}
+		return schedule()
+	}
+
+	if err := schedule(); err != nil {
+		return err
+	}
+	return remove()
+}
@@ -253,3 +253,23 @@ func RemoveL3Router(ctx context.Context, c *gophercloud.ServiceClient, id string
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// ListL3AgentsHostingRouter lists the L3 agents hosting a specific router.
+// The HAState of each agent is set for HA routers.
+// GET /v2.0/routers/{router-id}/l3-agents
+func ListL3AgentsHostingRouter(c *gophercloud.ServiceClient, routerID string) pagination.Pager {
+	url := listL3AgentsHostingRouterURL(c, routerID)
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AgentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListDHCPAgentsHostingNetwork lists the DHCP agents hosting a specific
+// network.
+// GET /v2.0/networks/{network-id}/dhcp-agents
+func ListDHCPAgentsHostingNetwork(c *gophercloud.ServiceClient, networkID string) pagination.Pager {
+	url := listDHCPAgentsHostingNetworkURL(c, networkID)
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AgentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -115,6 +115,10 @@ type Agent struct {
 
 	// Topic contains name of AMQP topic.
 	Topic string `json:"topic"`
+
+	// HAState is the HA state (active/standby) of a router on the agent. It
+	// is only set by ListL3AgentsHostingRouter for HA routers.
+	HAState string `json:"ha_state"`
 }
 
 // UnmarshalJSON helps to convert the timestamps into the time.Time type.
@@ -0,0 +1,266 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/agents"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+// fakeAgent is an agent of a fake deployment.
+type fakeAgent struct {
+	ID           string
+	Alive        bool
+	AdminStateUp bool
+}
+
+// fakeHost is the hosting of a resource by an agent of a fake deployment.
+type fakeHost struct {
+	AgentID string
+	HAState string
+}
+
+// fakeDeployment simulates the agents of a deployment and records the
+// rescheduling calls made by an evacuation.
+type fakeDeployment struct {
+	AgentType string
+	SourceID  string
+	Agents    []fakeAgent
+	Resources []string
+	Hosts     map[string][]fakeHost
+	Calls     []string
+}
+
+func (d *fakeDeployment) agentJSON(a fakeAgent, haState string) map[string]any {
+	agent := map[string]any{
+		"id":             a.ID,
+		"agent_type":     d.AgentType,
+		"alive":          a.Alive,
+		"admin_state_up": a.AdminStateUp,
+	}
+	if haState != "" {
+		agent["ha_state"] = haState
+	}
+	return agent
+}
+
+func (d *fakeDeployment) agent(id string) fakeAgent {
+	for _, a := range d.Agents {
+		if a.ID == id {
+			return a
+		}
+	}
+	return fakeAgent{ID: id}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v any) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Error(err)
+	}
+}
+
+func HandleDeployment(t *testing.T, fakeServer th.FakeServer, d *fakeDeployment) {
+	resourcePath, resourceKey, hostsPath := "l3-routers", "routers", "/v2.0/routers/%s/l3-agents"
+	idKey := "router_id"
+	if d.AgentType == agents.AgentTypeDHCP {
+		resourcePath, resourceKey, hostsPath = "dhcp-networks", "networks", "/v2.0/networks/%s/dhcp-agents"
+		idKey = "network_id"
+	}
+
+	fakeServer.Mux.HandleFunc("/v2.0/agents", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"agent_type": d.AgentType, "alive": "true"})
+
+		var all []map[string]any
+		for _, a := range d.Agents {
+			if a.Alive {
+				all = append(all, d.agentJSON(a, ""))
+			}
+		}
+		writeJSON(t, w, http.StatusOK, map[string]any{"agents": all})
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/agents/"+d.SourceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			writeJSON(t, w, http.StatusOK, map[string]any{"agent": d.agentJSON(d.agent(d.SourceID), "")})
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"agent": {"admin_state_up": false}}`)
+			d.Calls = append(d.Calls, "disable "+d.SourceID)
+			writeJSON(t, w, http.StatusOK, map[string]any{"agent": d.agentJSON(d.agent(d.SourceID), "")})
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	for _, a := range d.Agents {
+		agentID := a.ID
+		fakeServer.Mux.HandleFunc("/v2.0/agents/"+agentID+"/"+resourcePath, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			switch r.Method {
+			case "GET":
+				var resources []map[string]any
+				for _, id := range d.Resources {
+					if slices.ContainsFunc(d.Hosts[id], func(h fakeHost) bool { return h.AgentID == agentID }) {
+						resources = append(resources, map[string]any{"id": id})
+					}
+				}
+				writeJSON(t, w, http.StatusOK, map[string]any{resourceKey: resources})
+			case "POST":
+				var body map[string]string
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Error(err)
+				}
+				d.Calls = append(d.Calls, "schedule "+body[idKey]+" "+agentID)
+				w.WriteHeader(http.StatusCreated)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		})
+
+		fakeServer.Mux.HandleFunc("/v2.0/agents/"+agentID+"/"+resourcePath+"/", func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			resourceID := strings.TrimPrefix(r.URL.Path, "/v2.0/agents/"+agentID+"/"+resourcePath+"/")
+			d.Calls = append(d.Calls, "remove "+resourceID+" "+agentID)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	for resourceID, hosts := range d.Hosts {
+		fakeServer.Mux.HandleFunc(strings.Replace(hostsPath, "%s", resourceID, 1), func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			var all []map[string]any
+			for _, host := range hosts {
+				all = append(all, d.agentJSON(d.agent(host.AgentID), host.HAState))
+			}
+			writeJSON(t, w, http.StatusOK, map[string]any{"agents": all})
+		})
+	}
+}
+
+// newL3Deployment returns a deployment where the evacuated agent hosts a
+// legacy router, the active and the standby instances of two HA routers, and
+// an HA router already hosted by every other agent. agent-a hosts one more
+// router than agent-b.
+func newL3Deployment() *fakeDeployment {
+	return &fakeDeployment{
+		AgentType: agents.AgentTypeL3,
+		SourceID:  "agent-s",
+		Agents: []fakeAgent{
+			{ID: "agent-s", Alive: true, AdminStateUp: true},
+			{ID: "agent-a", Alive: true, AdminStateUp: true},
+			{ID: "agent-b", Alive: true, AdminStateUp: true},
+			{ID: "agent-c", Alive: true, AdminStateUp: false},
+			{ID: "agent-d", Alive: false, AdminStateUp: true},
+		},
+		Resources: []string{"router-legacy", "router-active", "router-standby", "router-full", "router-busy"},
+		Hosts: map[string][]fakeHost{
+			"router-legacy":  {{AgentID: "agent-s"}},
+			"router-active":  {{AgentID: "agent-s", HAState: "active"}, {AgentID: "agent-a", HAState: "standby"}},
+			"router-standby": {{AgentID: "agent-s", HAState: "standby"}, {AgentID: "agent-b", HAState: "active"}},
+			"router-full":    {{AgentID: "agent-s", HAState: "standby"}, {AgentID: "agent-a", HAState: "active"}, {AgentID: "agent-b", HAState: "standby"}},
+			"router-busy":    {{AgentID: "agent-a"}},
+		},
+	}
+}
+
+func TestPlanEvacuationL3(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	d := newL3Deployment()
+	HandleDeployment(t, fakeServer, d)
+
+	plan, err := agents.PlanEvacuation(context.TODO(), fake.ServiceClient(fakeServer), "agent-s", agents.EvacuateOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "agent-s", plan.Agent.ID)
+
+	expected := []agents.Move{
+		{Kind: agents.MoveRouter, ResourceID: "router-standby", HAState: "standby", FromAgentID: "agent-s", ToAgentID: "agent-a"},
+		{Kind: agents.MoveRouter, ResourceID: "router-full", HAState: "standby", FromAgentID: "agent-s"},
+		{Kind: agents.MoveRouter, ResourceID: "router-active", HAState: "active", FromAgentID: "agent-s", ToAgentID: "agent-b"},
+		{Kind: agents.MoveRouter, ResourceID: "router-legacy", FromAgentID: "agent-s", ToAgentID: "agent-b"},
+	}
+	th.CheckDeepEquals(t, expected, plan.Moves)
+	th.CheckDeepEquals(t, []string(nil), d.Calls)
+}
+
+func TestEvacuateL3(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	d := newL3Deployment()
+	HandleDeployment(t, fakeServer, d)
+
+	plan, err := agents.Evacuate(context.TODO(), fake.ServiceClient(fakeServer), "agent-s", agents.EvacuateOpts{DisableAgent: true})
+	th.AssertNoErr(t, err)
+
+	expected := []string{
+		"disable agent-s",
+		"schedule router-standby agent-a",
+		"remove router-standby agent-s",
+		"remove router-full agent-s",
+		"schedule router-active agent-b",
+		"remove router-active agent-s",
+		"remove router-legacy agent-s",
+		"schedule router-legacy agent-b",
+	}
+	th.CheckDeepEquals(t, expected, d.Calls)
+	for _, move := range plan.Moves {
+		th.AssertTrue(t, move.Done)
+	}
+}
+
+func TestEvacuateDHCPDryRun(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	d := &fakeDeployment{
+		AgentType: agents.AgentTypeDHCP,
+		SourceID:  "dhcp-s",
+		Agents: []fakeAgent{
+			{ID: "dhcp-s", Alive: true, AdminStateUp: true},
+			{ID: "dhcp-a", Alive: true, AdminStateUp: true},
+			{ID: "dhcp-b", Alive: true, AdminStateUp: true},
+		},
+		Resources: []string{"network-1", "network-2"},
+		Hosts: map[string][]fakeHost{
+			"network-1": {{AgentID: "dhcp-s"}},
+			"network-2": {{AgentID: "dhcp-s"}, {AgentID: "dhcp-a"}},
+		},
+	}
+	HandleDeployment(t, fakeServer, d)
+
+	plan, err := agents.Evacuate(context.TODO(), fake.ServiceClient(fakeServer), "dhcp-s", agents.EvacuateOpts{DisableAgent: true, DryRun: true})
+	th.AssertNoErr(t, err)
+
+	expected := []agents.Move{
+		{Kind: agents.MoveDHCPNetwork, ResourceID: "network-1", FromAgentID: "dhcp-s", ToAgentID: "dhcp-b"},
+		{Kind: agents.MoveDHCPNetwork, ResourceID: "network-2", FromAgentID: "dhcp-s", ToAgentID: "dhcp-b"},
+	}
+	th.CheckDeepEquals(t, expected, plan.Moves)
+	th.CheckDeepEquals(t, []string(nil), d.Calls)
+}
+
+func TestEvacuateTargetAgents(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	d := newL3Deployment()
+	HandleDeployment(t, fakeServer, d)
+
+	_, err := agents.PlanEvacuation(context.TODO(), fake.ServiceClient(fakeServer), "agent-s", agents.EvacuateOpts{TargetAgentIDs: []string{"agent-c"}})
+	if err == nil || !strings.Contains(err.Error(), "router-legacy") {
+		t.Fatalf("expected no agent to be available for router-legacy, got %v", err)
+	}
+	th.CheckDeepEquals(t, []string(nil), d.Calls)
+}
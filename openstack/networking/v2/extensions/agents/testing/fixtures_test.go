@@ -431,3 +431,115 @@ const ScheduleL3RouterRequest = `
     "router_id": "43e66290-79a4-415d-9eb9-7ff7919839e1"
 }
 `
+
+// ListL3AgentsHostingRouterResult represents raw response for the
+// ListL3AgentsHostingRouter request.
+const ListL3AgentsHostingRouterResult = `
+{
+  "agents": [
+    {
+      "binary": "neutron-l3-agent",
+      "description": null,
+      "availability_zone": "nova",
+      "heartbeat_timestamp": "2019-10-14 11:51:01",
+      "admin_state_up": true,
+      "alive": true,
+      "topic": "l3_agent",
+      "host": "network1",
+      "agent_type": "L3 agent",
+      "created_at": "2019-10-08 09:41:25",
+      "started_at": "2019-10-08 09:41:25",
+      "id": "43583cf5-472e-4dc8-af5b-6aed4c94ee3a",
+      "ha_state": "active",
+      "configurations": {}
+    },
+    {
+      "binary": "neutron-l3-agent",
+      "description": null,
+      "availability_zone": "nova",
+      "heartbeat_timestamp": "2019-10-14 11:51:03",
+      "admin_state_up": true,
+      "alive": true,
+      "topic": "l3_agent",
+      "host": "network2",
+      "agent_type": "L3 agent",
+      "created_at": "2019-10-08 09:41:28",
+      "started_at": "2019-10-08 09:41:28",
+      "id": "a8b49ef5-47e1-4a57-9fb0-9f3bc5f85b7a",
+      "ha_state": "standby",
+      "configurations": {}
+    }
+  ]
+}
+`
+
+// ListDHCPAgentsHostingNetworkResult represents raw response for the
+// ListDHCPAgentsHostingNetwork request.
+const ListDHCPAgentsHostingNetworkResult = `
+{
+  "agents": [
+    {
+      "binary": "neutron-dhcp-agent",
+      "description": null,
+      "availability_zone": "nova",
+      "heartbeat_timestamp": "2019-10-14 11:51:02",
+      "admin_state_up": true,
+      "alive": true,
+      "topic": "dhcp_agent",
+      "host": "network1",
+      "agent_type": "DHCP agent",
+      "created_at": "2019-10-08 09:41:26",
+      "started_at": "2019-10-08 09:41:26",
+      "id": "2bf84eaf-d869-49cc-8401-cbbca5177e59",
+      "configurations": {}
+    }
+  ]
+}
+`
+
+var L3AgentActive = agents.Agent{
+	ID:                 "43583cf5-472e-4dc8-af5b-6aed4c94ee3a",
+	AdminStateUp:       true,
+	AgentType:          "L3 agent",
+	Alive:              true,
+	AvailabilityZone:   "nova",
+	Binary:             "neutron-l3-agent",
+	Configurations:     map[string]any{},
+	CreatedAt:          time.Date(2019, 10, 8, 9, 41, 25, 0, time.UTC),
+	StartedAt:          time.Date(2019, 10, 8, 9, 41, 25, 0, time.UTC),
+	HeartbeatTimestamp: time.Date(2019, 10, 14, 11, 51, 1, 0, time.UTC),
+	Host:               "network1",
+	Topic:              "l3_agent",
+	HAState:            "active",
+}
+
+var L3AgentStandby = agents.Agent{
+	ID:                 "a8b49ef5-47e1-4a57-9fb0-9f3bc5f85b7a",
+	AdminStateUp:       true,
+	AgentType:          "L3 agent",
+	Alive:              true,
+	AvailabilityZone:   "nova",
+	Binary:             "neutron-l3-agent",
+	Configurations:     map[string]any{},
+	CreatedAt:          time.Date(2019, 10, 8, 9, 41, 28, 0, time.UTC),
+	StartedAt:          time.Date(2019, 10, 8, 9, 41, 28, 0, time.UTC),
+	HeartbeatTimestamp: time.Date(2019, 10, 14, 11, 51, 3, 0, time.UTC),
+	Host:               "network2",
+	Topic:              "l3_agent",
+	HAState:            "standby",
+}
+
+var DHCPAgent = agents.Agent{
+	ID:                 "2bf84eaf-d869-49cc-8401-cbbca5177e59",
+	AdminStateUp:       true,
+	AgentType:          "DHCP agent",
+	Alive:              true,
+	AvailabilityZone:   "nova",
+	Binary:             "neutron-dhcp-agent",
+	Configurations:     map[string]any{},
+	CreatedAt:          time.Date(2019, 10, 8, 9, 41, 26, 0, time.UTC),
+	StartedAt:          time.Date(2019, 10, 8, 9, 41, 26, 0, time.UTC),
+	HeartbeatTimestamp: time.Date(2019, 10, 14, 11, 51, 2, 0, time.UTC),
+	Host:               "network1",
+	Topic:              "dhcp_agent",
+}
@@ -427,3 +427,45 @@ func TestRemoveL3Router(t *testing.T) {
 	err := agents.RemoveL3Router(context.TODO(), fake.ServiceClient(fakeServer), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", "43e66290-79a4-415d-9eb9-7ff7919839e1").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestListL3AgentsHostingRouter(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	routerID := "43e66290-79a4-415d-9eb9-7ff7919839e1"
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+routerID+"/l3-agents", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListL3AgentsHostingRouterResult)
+	})
+
+	allPages, err := agents.ListL3AgentsHostingRouter(fake.ServiceClient(fakeServer), routerID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := agents.ExtractAgents(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []agents.Agent{L3AgentActive, L3AgentStandby}, actual)
+}
+
+func TestListDHCPAgentsHostingNetwork(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	networkID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
+	fakeServer.Mux.HandleFunc("/v2.0/networks/"+networkID+"/dhcp-agents", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListDHCPAgentsHostingNetworkResult)
+	})
+
+	allPages, err := agents.ListDHCPAgentsHostingNetwork(fake.ServiceClient(fakeServer), networkID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := agents.ExtractAgents(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []agents.Agent{DHCPAgent}, actual)
+}
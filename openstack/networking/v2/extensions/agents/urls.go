@@ -8,6 +8,10 @@ const l3RoutersResourcePath = "l3-routers"
 const bgpSpeakersResourcePath = "bgp-drinstances"
 const bgpDRAgentSpeakersResourcePath = "bgp-speakers"
 const bgpDRAgentAgentResourcePath = "bgp-dragents"
+const routersResourcePath = "routers"
+const l3AgentsResourcePath = "l3-agents"
+const networksResourcePath = "networks"
+const dhcpAgentsResourcePath = "dhcp-agents"
 
 func resourceURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL(resourcePath, id)
@@ -84,3 +88,13 @@ func removeBGPSpeakersURL(c *gophercloud.ServiceClient, agentID string, speakerI
 func listDRAgentHostingBGPSpeakersURL(c *gophercloud.ServiceClient, speakerID string) string {
 	return c.ServiceURL(bgpDRAgentSpeakersResourcePath, speakerID, bgpDRAgentAgentResourcePath)
 }
+
+// return /v2.0/routers/{router-id}/l3-agents
+func listL3AgentsHostingRouterURL(c *gophercloud.ServiceClient, routerID string) string {
+	return c.ServiceURL(routersResourcePath, routerID, l3AgentsResourcePath)
+}
+
+// return /v2.0/networks/{network-id}/dhcp-agents
+func listDHCPAgentsHostingNetworkURL(c *gophercloud.ServiceClient, networkID string) string {
+	return c.ServiceURL(networksResourcePath, networkID, dhcpAgentsResourcePath)
+}
@@ -0,0 +1,30 @@
+/*
+Package autoallocatedtopology provides access to the auto-allocated topology
+of the OpenStack Networking Service, also known as get-me-a-network. It
+allocates a network, a subnet per IP version and a router connected to the
+default external network for a project on demand.
+
+Example to Validate the Requirements of a Project
+
+	err := autoallocatedtopology.Validate(context.TODO(), networkClient, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Network of a Project
+
+	topology, err := autoallocatedtopology.Get(context.TODO(), networkClient, projectID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("network: %s\n", topology.ID)
+
+Example to Release the Topology of a Project
+
+	err := autoallocatedtopology.Delete(context.TODO(), networkClient, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package autoallocatedtopology
@@ -0,0 +1,34 @@
+package autoallocatedtopology
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Get returns the auto-allocated topology of a project, allocating the
+// network, subnets and router on the first call. Administrative users can
+// get the topology of any project.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Validate checks whether a topology can be allocated for a project without
+// allocating it. The Networking service answers with a 409 Conflict
+// describing the missing requirement, such as a default external network or
+// a default subnet pool, when it can't.
+func Validate(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r ValidateResult) {
+	url := resourceURL(c, projectID) + "?fields=dry-run"
+	resp, err := c.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete releases the auto-allocated topology of a project.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
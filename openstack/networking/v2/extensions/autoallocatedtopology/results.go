@@ -0,0 +1,69 @@
+package autoallocatedtopology
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// dryRunPass is the ID returned by the Networking service for a successful
+// validation.
+const dryRunPass = "dry-run=pass"
+
+// Topology represents the network allocated to a project by the
+// get-me-a-network feature, along with its subnets and router.
+type Topology struct {
+	// ID is the ID of the allocated network.
+	ID string `json:"id"`
+
+	// TenantID is the project owner of the topology.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the topology.
+	ProjectID string `json:"project_id"`
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Topology.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Topology.
+func (r GetResult) Extract() (*Topology, error) {
+	var s struct {
+		Topology *Topology `json:"auto_allocated_topology"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Topology, err
+}
+
+// ValidateResult represents the result of a validate operation. Call its
+// ExtractErr method to determine if a topology can be allocated.
+type ValidateResult struct {
+	gophercloud.Result
+}
+
+// ExtractErr returns nil when a topology can be allocated for the project,
+// and the reason it can't otherwise.
+func (r ValidateResult) ExtractErr() error {
+	var s struct {
+		Topology struct {
+			ID string `json:"id"`
+		} `json:"auto_allocated_topology"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return err
+	}
+	if s.Topology.ID != dryRunPass {
+		return fmt.Errorf("unexpected auto-allocated topology validation result %q", s.Topology.ID)
+	}
+	return nil
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// autoallocatedtopology unit tests
+package testing
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const ProjectID = "0c3c7a4d3a9b4c6e8f5e1d2c3b4a5f6e"
+
+const GetResponse = `
+{
+    "auto_allocated_topology": {
+        "id": "b7a5a5e1-0e69-4b0c-9f4e-2f4d5a6b7c8d",
+        "tenant_id": "0c3c7a4d3a9b4c6e8f5e1d2c3b4a5f6e",
+        "project_id": "0c3c7a4d3a9b4c6e8f5e1d2c3b4a5f6e"
+    }
+}
+`
+
+const ValidateResponse = `
+{
+    "auto_allocated_topology": {
+        "id": "dry-run=pass",
+        "tenant_id": "0c3c7a4d3a9b4c6e8f5e1d2c3b4a5f6e",
+        "project_id": "0c3c7a4d3a9b4c6e8f5e1d2c3b4a5f6e"
+    }
+}
+`
+
+const ValidateConflictResponse = `
+{
+    "NeutronError": {
+        "type": "DeploymentError",
+        "message": "Deployment error: No default router:external network.",
+        "detail": ""
+    }
+}
+`
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+func HandleValidate(t *testing.T, fakeServer th.FakeServer, status int, response string) {
+	fakeServer.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"fields": "dry-run"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/autoallocatedtopology"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := autoallocatedtopology.Get(context.TODO(), fake.ServiceClient(fakeServer), ProjectID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, autoallocatedtopology.Topology{
+		ID:        "b7a5a5e1-0e69-4b0c-9f4e-2f4d5a6b7c8d",
+		TenantID:  ProjectID,
+		ProjectID: ProjectID,
+	}, *actual)
+}
+
+func TestValidate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleValidate(t, fakeServer, http.StatusOK, ValidateResponse)
+
+	err := autoallocatedtopology.Validate(context.TODO(), fake.ServiceClient(fakeServer), ProjectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestValidateConflict(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleValidate(t, fakeServer, http.StatusConflict, ValidateConflictResponse)
+
+	err := autoallocatedtopology.Validate(context.TODO(), fake.ServiceClient(fakeServer), ProjectID).ExtractErr()
+	th.AssertTrue(t, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+}
+
+func TestValidateUnexpectedResult(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleValidate(t, fakeServer, http.StatusOK, `{"auto_allocated_topology": {}}`)
+
+	err := autoallocatedtopology.Validate(context.TODO(), fake.ServiceClient(fakeServer), ProjectID).ExtractErr()
+	if err == nil {
+		t.Fatal("expected an error for an unexpected dry-run result")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := autoallocatedtopology.Delete(context.TODO(), fake.ServiceClient(fakeServer), ProjectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
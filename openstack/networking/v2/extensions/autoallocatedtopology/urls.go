@@ -0,0 +1,9 @@
+package autoallocatedtopology
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const resourcePath = "auto-allocated-topology"
+
+func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID)
+}
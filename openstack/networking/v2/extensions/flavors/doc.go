@@ -0,0 +1,8 @@
+// Package flavors contains functionality to work with the service flavor and
+// service profile Neutron resources.
+//
+// A service flavor lets users select the provider of an advanced service,
+// such as a load balancer or VPN, without knowing its driver. Administrators
+// associate each flavor with the service profiles describing the drivers
+// able to implement it.
+package flavors
@@ -0,0 +1,58 @@
+/*
+Package flavors manages and retrieves the service flavors of the OpenStack
+Networking Service.
+
+Example to List the Load Balancer Flavors
+
+	listOpts := flavors.ListOpts{
+		ServiceType: "LOADBALANCERV2",
+	}
+
+	allPages, err := flavors.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flavor := range allFlavors {
+		fmt.Printf("%+v\n", flavor)
+	}
+
+Example to Create a Flavor
+
+	createOpts := flavors.CreateOpts{
+		Name:        "gold",
+		ServiceType: "LOADBALANCERV2",
+	}
+
+	flavor, err := flavors.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Service Profile with a Flavor
+
+	err := flavors.AssociateProfile(context.TODO(), networkClient, flavorID, profileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disassociate a Service Profile from a Flavor
+
+	err := flavors.DisassociateProfile(context.TODO(), networkClient, flavorID, profileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flavor
+
+	err := flavors.Delete(context.TODO(), networkClient, flavorID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flavors
@@ -0,0 +1,158 @@
+package flavors
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the flavor attributes you want to see returned.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	ServiceType string `q:"service_type"`
+	Enabled     *bool  `q:"enabled"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToFlavorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flavors. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlavorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlavorCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new flavor.
+type CreateOpts struct {
+	// Name is the human-readable name of the flavor.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the flavor.
+	Description string `json:"description,omitempty"`
+
+	// ServiceType is the type of the service the flavor applies to, such
+	// as LOADBALANCERV2, VPN or L3_ROUTER_NAT.
+	ServiceType string `json:"service_type" required:"true"`
+
+	// Enabled specifies whether the flavor can be used. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToFlavorCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToFlavorCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// flavor.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlavorCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular flavor based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlavorUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a flavor.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToFlavorUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToFlavorUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Update allows flavors to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlavorUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular flavor based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// AssociateProfile associates a service profile with a flavor.
+func AssociateProfile(ctx context.Context, c *gophercloud.ServiceClient, id, profileID string) (r AssociateProfileResult) {
+	b := map[string]any{
+		"service_profile": map[string]any{
+			"id": profileID,
+		},
+	}
+	resp, err := c.Post(ctx, profilesURL(c, id), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DisassociateProfile removes the association of a service profile with a
+// flavor.
+func DisassociateProfile(ctx context.Context, c *gophercloud.ServiceClient, id, profileID string) (r DisassociateProfileResult) {
+	resp, err := c.Delete(ctx, profileURL(c, id, profileID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,120 @@
+package flavors
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Flavor represents a service flavor, which selects the provider of an
+// advanced service.
+type Flavor struct {
+	// ID is the unique ID of the flavor.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the flavor.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the flavor.
+	Description string `json:"description"`
+
+	// ServiceType is the type of the service the flavor applies to.
+	ServiceType string `json:"service_type"`
+
+	// ServiceProfiles are the IDs of the service profiles associated with
+	// the flavor.
+	ServiceProfiles []string `json:"service_profiles"`
+
+	// Enabled specifies whether the flavor can be used.
+	Enabled bool `json:"enabled"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Flavor.
+func (r commonResult) Extract() (*Flavor, error) {
+	var s struct {
+		Flavor *Flavor `json:"flavor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavor, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Flavor.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Flavor.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Flavor.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssociateProfileResult represents the result of an associate profile
+// operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type AssociateProfileResult struct {
+	gophercloud.ErrResult
+}
+
+// DisassociateProfileResult represents the result of a disassociate profile
+// operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type DisassociateProfileResult struct {
+	gophercloud.ErrResult
+}
+
+// FlavorPage is the page returned by a pager when traversing over a
+// collection of flavors.
+type FlavorPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flavors has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r FlavorPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flavors_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlavorPage struct is empty.
+func (r FlavorPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractFlavors(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlavors accepts a Page struct, specifically a FlavorPage struct, and
+// extracts the elements into a slice of Flavor structs.
+func ExtractFlavors(r pagination.Page) ([]Flavor, error) {
+	var s struct {
+		Flavors []Flavor `json:"flavors"`
+	}
+	err := (r.(FlavorPage)).ExtractInto(&s)
+	return s.Flavors, err
+}
@@ -0,0 +1,162 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/flavors/flavors"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const (
+	FlavorID  = "4c12c9b6-7a5d-4c4e-9b8a-1f2e3d4c5b6a"
+	ProfileID = "9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d"
+)
+
+const ListResponse = `
+{
+    "flavors": [
+        {
+            "id": "4c12c9b6-7a5d-4c4e-9b8a-1f2e3d4c5b6a",
+            "name": "gold",
+            "description": "Dedicated load balancers",
+            "service_type": "LOADBALANCERV2",
+            "service_profiles": ["9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d"],
+            "enabled": true
+        }
+    ]
+}
+`
+
+const FlavorResponse = `
+{
+    "flavor": {
+        "id": "4c12c9b6-7a5d-4c4e-9b8a-1f2e3d4c5b6a",
+        "name": "gold",
+        "description": "Dedicated load balancers",
+        "service_type": "LOADBALANCERV2",
+        "service_profiles": ["9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d"],
+        "enabled": true
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "flavor": {
+        "name": "gold",
+        "description": "Dedicated load balancers",
+        "service_type": "LOADBALANCERV2"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "flavor": {
+        "enabled": true
+    }
+}
+`
+
+const AssociateProfileRequest = `
+{
+    "service_profile": {
+        "id": "9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d"
+    }
+}
+`
+
+const AssociateProfileResponse = `
+{
+    "service_profile": {
+        "id": "9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d"
+    }
+}
+`
+
+var FlavorGold = flavors.Flavor{
+	ID:              FlavorID,
+	Name:            "gold",
+	Description:     "Dedicated load balancers",
+	ServiceType:     "LOADBALANCERV2",
+	ServiceProfiles: []string{ProfileID},
+	Enabled:         true,
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"service_type": "LOADBALANCERV2"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, FlavorResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors/"+FlavorID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, FlavorResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors/"+FlavorID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, FlavorResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors/"+FlavorID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleAssociateProfileSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors/"+FlavorID+"/service_profiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, AssociateProfileRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, AssociateProfileResponse)
+	})
+}
+
+func HandleDisassociateProfileSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/flavors/"+FlavorID+"/service_profiles/"+ProfileID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
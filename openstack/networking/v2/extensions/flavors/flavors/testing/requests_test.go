@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/flavors/flavors"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	count := 0
+	err := flavors.List(fake.ServiceClient(fakeServer), flavors.ListOpts{ServiceType: "LOADBALANCERV2"}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := flavors.ExtractFlavors(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []flavors.Flavor{FlavorGold}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := flavors.CreateOpts{
+		Name:        "gold",
+		Description: "Dedicated load balancers",
+		ServiceType: "LOADBALANCERV2",
+	}
+	actual, err := flavors.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FlavorGold, *actual)
+}
+
+func TestCreateRequiredOpts(t *testing.T) {
+	_, err := flavors.CreateOpts{Name: "gold"}.ToFlavorCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when ServiceType is not set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := flavors.Get(context.TODO(), fake.ServiceClient(fakeServer), FlavorID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FlavorGold, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	enabled := true
+	actual, err := flavors.Update(context.TODO(), fake.ServiceClient(fakeServer), FlavorID, flavors.UpdateOpts{Enabled: &enabled}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FlavorGold, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := flavors.Delete(context.TODO(), fake.ServiceClient(fakeServer), FlavorID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAssociateProfile(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleAssociateProfileSuccessfully(t, fakeServer)
+
+	err := flavors.AssociateProfile(context.TODO(), fake.ServiceClient(fakeServer), FlavorID, ProfileID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDisassociateProfile(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDisassociateProfileSuccessfully(t, fakeServer)
+
+	err := flavors.DisassociateProfile(context.TODO(), fake.ServiceClient(fakeServer), FlavorID, ProfileID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
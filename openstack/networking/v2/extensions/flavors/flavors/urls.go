@@ -0,0 +1,24 @@
+package flavors
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	rootPath    = "flavors"
+	profilePath = "service_profiles"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
+
+func profilesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id, profilePath)
+}
+
+func profileURL(c *gophercloud.ServiceClient, id, profileID string) string {
+	return c.ServiceURL(rootPath, id, profilePath, profileID)
+}
@@ -0,0 +1,53 @@
+/*
+Package serviceprofiles manages and retrieves the service profiles of the
+OpenStack Networking Service. Service profiles are associated with service
+flavors through the flavors package.
+
+Example to List Service Profiles
+
+	allPages, err := serviceprofiles.List(networkClient, serviceprofiles.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProfiles, err := serviceprofiles.ExtractServiceProfiles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, profile := range allProfiles {
+		fmt.Printf("%+v\n", profile)
+	}
+
+Example to Create a Service Profile
+
+	createOpts := serviceprofiles.CreateOpts{
+		Description: "Amphora provider",
+		Metainfo:    `{"flavor_id": "a7ae5d5a-d855-4f9a-b187-af66b53f4d04"}`,
+	}
+
+	profile, err := serviceprofiles.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable a Service Profile
+
+	enabled := false
+	updateOpts := serviceprofiles.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	profile, err := serviceprofiles.Update(context.TODO(), networkClient, profileID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Profile
+
+	err := serviceprofiles.Delete(context.TODO(), networkClient, profileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package serviceprofiles
@@ -0,0 +1,140 @@
+package serviceprofiles
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToServiceProfileListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the service profile attributes you want to see returned.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Description string `q:"description"`
+	Driver      string `q:"driver"`
+	Enabled     *bool  `q:"enabled"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToServiceProfileListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceProfileListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// service profiles. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToServiceProfileListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceProfilePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToServiceProfileCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new service profile.
+// Either Driver or Metainfo must be set.
+type CreateOpts struct {
+	// Description is the human-readable description of the service profile.
+	Description string `json:"description,omitempty"`
+
+	// Driver is the Python import path of the service driver.
+	Driver string `json:"driver,omitempty" or:"Metainfo"`
+
+	// Metainfo is a JSON encoded string of the driver specific settings.
+	Metainfo string `json:"metainfo,omitempty" or:"Driver"`
+
+	// Enabled specifies whether the service profile can be used. Defaults
+	// to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToServiceProfileCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToServiceProfileCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_profile")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// service profile.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServiceProfileCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular service profile based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServiceProfileUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a service profile.
+type UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Driver      *string `json:"driver,omitempty"`
+	Metainfo    *string `json:"metainfo,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToServiceProfileUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToServiceProfileUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_profile")
+}
+
+// Update allows service profiles to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceProfileUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular service profile based on its
+// ID. A service profile associated with a flavor can't be deleted.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
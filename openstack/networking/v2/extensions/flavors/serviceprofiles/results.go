@@ -0,0 +1,103 @@
+package serviceprofiles
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ServiceProfile represents a driver able to implement the service flavors
+// it is associated with.
+type ServiceProfile struct {
+	// ID is the unique ID of the service profile.
+	ID string `json:"id"`
+
+	// Description is the human-readable description of the service profile.
+	Description string `json:"description"`
+
+	// Driver is the Python import path of the service driver.
+	Driver string `json:"driver"`
+
+	// Metainfo is a JSON encoded string of the driver specific settings.
+	Metainfo string `json:"metainfo"`
+
+	// Enabled specifies whether the service profile can be used.
+	Enabled bool `json:"enabled"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a ServiceProfile.
+func (r commonResult) Extract() (*ServiceProfile, error) {
+	var s struct {
+		ServiceProfile *ServiceProfile `json:"service_profile"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceProfile, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a ServiceProfile.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ServiceProfile.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a ServiceProfile.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ServiceProfilePage is the page returned by a pager when traversing over a
+// collection of service profiles.
+type ServiceProfilePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of service profiles has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r ServiceProfilePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"service_profiles_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ServiceProfilePage struct is empty.
+func (r ServiceProfilePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractServiceProfiles(r)
+	return len(is) == 0, err
+}
+
+// ExtractServiceProfiles accepts a Page struct, specifically a
+// ServiceProfilePage struct, and extracts the elements into a slice of
+// ServiceProfile structs.
+func ExtractServiceProfiles(r pagination.Page) ([]ServiceProfile, error) {
+	var s struct {
+		ServiceProfiles []ServiceProfile `json:"service_profiles"`
+	}
+	err := (r.(ServiceProfilePage)).ExtractInto(&s)
+	return s.ServiceProfiles, err
+}
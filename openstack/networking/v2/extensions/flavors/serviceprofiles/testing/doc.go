@@ -0,0 +1,2 @@
+// serviceprofiles unit tests
+package testing
@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/flavors/serviceprofiles"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const ProfileID = "9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d"
+
+const ListResponse = `
+{
+    "service_profiles": [
+        {
+            "id": "9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d",
+            "description": "Amphora provider",
+            "driver": "",
+            "metainfo": "{\"flavor_id\": \"a7ae5d5a-d855-4f9a-b187-af66b53f4d04\"}",
+            "enabled": true
+        }
+    ]
+}
+`
+
+const ProfileResponse = `
+{
+    "service_profile": {
+        "id": "9e7d3b1a-2c4f-4a6e-8b0d-5f1e2a3b4c5d",
+        "description": "Amphora provider",
+        "driver": "",
+        "metainfo": "{\"flavor_id\": \"a7ae5d5a-d855-4f9a-b187-af66b53f4d04\"}",
+        "enabled": true
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "service_profile": {
+        "description": "Amphora provider",
+        "metainfo": "{\"flavor_id\": \"a7ae5d5a-d855-4f9a-b187-af66b53f4d04\"}"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "service_profile": {
+        "description": "Amphora provider"
+    }
+}
+`
+
+var ProfileAmphora = serviceprofiles.ServiceProfile{
+	ID:          ProfileID,
+	Description: "Amphora provider",
+	Metainfo:    `{"flavor_id": "a7ae5d5a-d855-4f9a-b187-af66b53f4d04"}`,
+	Enabled:     true,
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/service_profiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/service_profiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, ProfileResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/service_profiles/"+ProfileID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ProfileResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/service_profiles/"+ProfileID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ProfileResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/service_profiles/"+ProfileID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/flavors/serviceprofiles"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	enabled := true
+	count := 0
+	err := serviceprofiles.List(fake.ServiceClient(fakeServer), serviceprofiles.ListOpts{Enabled: &enabled}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := serviceprofiles.ExtractServiceProfiles(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []serviceprofiles.ServiceProfile{ProfileAmphora}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := serviceprofiles.CreateOpts{
+		Description: "Amphora provider",
+		Metainfo:    `{"flavor_id": "a7ae5d5a-d855-4f9a-b187-af66b53f4d04"}`,
+	}
+	actual, err := serviceprofiles.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProfileAmphora, *actual)
+}
+
+func TestCreateRequiresDriverOrMetainfo(t *testing.T) {
+	_, err := serviceprofiles.CreateOpts{Description: "Amphora provider"}.ToServiceProfileCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when neither Driver nor Metainfo is set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := serviceprofiles.Get(context.TODO(), fake.ServiceClient(fakeServer), ProfileID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProfileAmphora, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	description := "Amphora provider"
+	actual, err := serviceprofiles.Update(context.TODO(), fake.ServiceClient(fakeServer), ProfileID, serviceprofiles.UpdateOpts{Description: &description}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProfileAmphora, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := serviceprofiles.Delete(context.TODO(), fake.ServiceClient(fakeServer), ProfileID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package serviceprofiles
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const rootPath = "service_profiles"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
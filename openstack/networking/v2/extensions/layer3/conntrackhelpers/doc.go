@@ -0,0 +1,54 @@
+/*
+Package conntrackhelpers manages and retrieves the conntrack helpers of the
+routers of the OpenStack Networking Service. A conntrack helper enables a
+netfilter helper module, such as ftp or tftp, for a protocol and port on a
+router.
+
+Example to List the Conntrack Helpers of a Router
+
+	allPages, err := conntrackhelpers.List(networkClient, routerID, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allHelpers, err := conntrackhelpers.ExtractConntrackHelpers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, helper := range allHelpers {
+		fmt.Printf("%+v\n", helper)
+	}
+
+Example to Create a Conntrack Helper
+
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+
+	helper, err := conntrackhelpers.Create(context.TODO(), networkClient, routerID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Conntrack Helper
+
+	updateOpts := conntrackhelpers.UpdateOpts{
+		Port: 2121,
+	}
+
+	helper, err := conntrackhelpers.Update(context.TODO(), networkClient, routerID, helperID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Conntrack Helper
+
+	err := conntrackhelpers.Delete(context.TODO(), networkClient, routerID, helperID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package conntrackhelpers
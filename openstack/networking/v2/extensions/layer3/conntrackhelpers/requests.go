@@ -0,0 +1,136 @@
+package conntrackhelpers
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToConntrackHelperListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the conntrack helper attributes you want to see returned.
+type ListOpts struct {
+	ID       string `q:"id"`
+	Protocol string `q:"protocol"`
+	Port     int    `q:"port"`
+	Helper   string `q:"helper"`
+	Fields   string `q:"fields"`
+	Limit    int    `q:"limit"`
+	Marker   string `q:"marker"`
+	SortKey  string `q:"sort_key"`
+	SortDir  string `q:"sort_dir"`
+}
+
+// ToConntrackHelperListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToConntrackHelperListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the conntrack helpers
+// of a router. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, routerID string, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c, routerID)
+	if opts != nil {
+		query, err := opts.ToConntrackHelperListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ConntrackHelperPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToConntrackHelperCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new conntrack helper.
+type CreateOpts struct {
+	// Protocol is the network protocol the helper applies to, such as tcp
+	// or udp.
+	Protocol string `json:"protocol" required:"true"`
+
+	// Port is the network port the helper applies to.
+	Port int `json:"port" required:"true"`
+
+	// Helper is the name of the netfilter conntrack helper module, such as
+	// ftp or tftp.
+	Helper string `json:"helper" required:"true"`
+}
+
+// ToConntrackHelperCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToConntrackHelperCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "conntrack_helper")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// conntrack helper on a router.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, routerID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToConntrackHelperCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c, routerID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular conntrack helper of a router based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, routerID, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, routerID, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToConntrackHelperUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a conntrack helper.
+type UpdateOpts struct {
+	Protocol string `json:"protocol,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Helper   string `json:"helper,omitempty"`
+}
+
+// ToConntrackHelperUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToConntrackHelperUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "conntrack_helper")
+}
+
+// Update allows conntrack helpers to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, routerID, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToConntrackHelperUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, routerID, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular conntrack helper of a router.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, routerID, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, routerID, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
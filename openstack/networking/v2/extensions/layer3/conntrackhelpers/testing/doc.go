@@ -0,0 +1,2 @@
+// conntrackhelpers unit tests
+package testing
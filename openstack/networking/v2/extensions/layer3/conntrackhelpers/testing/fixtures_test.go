@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const (
+	RouterID = "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+	HelperID = "2f8b5a1c-4e3d-4c6b-9a7f-8e1d2c3b4a5f"
+)
+
+const ListResponse = `
+{
+    "conntrack_helpers": [
+        {
+            "id": "2f8b5a1c-4e3d-4c6b-9a7f-8e1d2c3b4a5f",
+            "protocol": "tcp",
+            "port": 21,
+            "helper": "ftp"
+        }
+    ]
+}
+`
+
+const HelperResponse = `
+{
+    "conntrack_helper": {
+        "id": "2f8b5a1c-4e3d-4c6b-9a7f-8e1d2c3b4a5f",
+        "protocol": "tcp",
+        "port": 21,
+        "helper": "ftp"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "conntrack_helper": {
+        "protocol": "tcp",
+        "port": 21,
+        "helper": "ftp"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "conntrack_helper": {
+        "port": 21
+    }
+}
+`
+
+var HelperFTP = conntrackhelpers.ConntrackHelper{
+	ID:       HelperID,
+	Protocol: "tcp",
+	Port:     21,
+	Helper:   "ftp",
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"helper": "ftp"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, HelperResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers/"+HelperID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, HelperResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers/"+HelperID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, HelperResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers/"+HelperID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	count := 0
+	err := conntrackhelpers.List(fake.ServiceClient(fakeServer), RouterID, conntrackhelpers.ListOpts{Helper: "ftp"}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := conntrackhelpers.ExtractConntrackHelpers(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []conntrackhelpers.ConntrackHelper{HelperFTP}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+	actual, err := conntrackhelpers.Create(context.TODO(), fake.ServiceClient(fakeServer), RouterID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, HelperFTP, *actual)
+}
+
+func TestCreateRequiredOpts(t *testing.T) {
+	_, err := conntrackhelpers.CreateOpts{Protocol: "tcp", Port: 21}.ToConntrackHelperCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when Helper is not set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := conntrackhelpers.Get(context.TODO(), fake.ServiceClient(fakeServer), RouterID, HelperID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, HelperFTP, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	actual, err := conntrackhelpers.Update(context.TODO(), fake.ServiceClient(fakeServer), RouterID, HelperID, conntrackhelpers.UpdateOpts{Port: 21}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, HelperFTP, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := conntrackhelpers.Delete(context.TODO(), fake.ServiceClient(fakeServer), RouterID, HelperID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,16 @@
+package conntrackhelpers
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	routerPath = "routers"
+	helperPath = "conntrack_helpers"
+)
+
+func rootURL(c *gophercloud.ServiceClient, routerID string) string {
+	return c.ServiceURL(routerPath, routerID, helperPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, routerID, id string) string {
+	return c.ServiceURL(routerPath, routerID, helperPath, id)
+}
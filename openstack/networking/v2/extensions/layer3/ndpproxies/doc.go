@@ -0,0 +1,60 @@
+/*
+Package ndpproxies manages and retrieves the NDP proxies of the OpenStack
+Networking Service. An NDP proxy publishes an internal IPv6 address on the
+external network of a router, which is required to reach IPv6 workloads
+without routing a whole prefix to the router.
+
+Example to Enable NDP Proxying on a Router
+
+	enabled := true
+	updateOpts := ndpproxies.RouterUpdateOptsExt{
+		UpdateOptsBuilder: routers.UpdateOpts{},
+		EnableNDPProxy:    &enabled,
+	}
+
+	var router struct {
+		routers.Router
+		ndpproxies.RouterNDPProxyExt
+	}
+	err := routers.Update(context.TODO(), networkClient, routerID, updateOpts).ExtractIntoStructPtr(&router, "router")
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an NDP Proxy
+
+	createOpts := ndpproxies.CreateOpts{
+		Name:     "web-1",
+		RouterID: routerID,
+		PortID:   "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+	}
+
+	proxy, err := ndpproxies.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the NDP Proxies of a Router
+
+	listOpts := ndpproxies.ListOpts{
+		RouterID: routerID,
+	}
+
+	allPages, err := ndpproxies.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProxies, err := ndpproxies.ExtractNDPProxies(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an NDP Proxy
+
+	err := ndpproxies.Delete(context.TODO(), networkClient, proxyID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package ndpproxies
@@ -0,0 +1,214 @@
+package ndpproxies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNDPProxyListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the NDP proxy attributes you want to see returned.
+type ListOpts struct {
+	ID             string `q:"id"`
+	Name           string `q:"name"`
+	Description    string `q:"description"`
+	ProjectID      string `q:"project_id"`
+	RouterID       string `q:"router_id"`
+	PortID         string `q:"port_id"`
+	IPAddress      string `q:"ip_address"`
+	RevisionNumber *int   `q:"revision_number"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+}
+
+// ToNDPProxyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNDPProxyListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// NDP proxies. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToNDPProxyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NDPProxyPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNDPProxyCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new NDP proxy.
+type CreateOpts struct {
+	// Name is the human-readable name of the NDP proxy.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the NDP proxy.
+	Description string `json:"description,omitempty"`
+
+	// RouterID is the ID of the router answering the neighbor solicitations.
+	// The router must have NDP proxying enabled.
+	RouterID string `json:"router_id" required:"true"`
+
+	// PortID is the ID of the internal port whose IPv6 address is published.
+	PortID string `json:"port_id" required:"true"`
+
+	// IPAddress is the IPv6 address of PortID to publish. It is required
+	// when the port has several IPv6 addresses.
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// ToNDPProxyCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToNDPProxyCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "ndp_proxy")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// NDP proxy.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNDPProxyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular NDP proxy based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNDPProxyUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating an NDP proxy.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
+	// will set revision_number=%s. If the revision number does not match, the
+	// update will fail.
+	RevisionNumber *int `json:"-" h:"If-Match"`
+}
+
+// ToNDPProxyUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToNDPProxyUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "ndp_proxy")
+}
+
+// Update allows NDP proxies to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNDPProxyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	for k := range h {
+		if k == "If-Match" {
+			h[k] = fmt.Sprintf("revision_number=%s", h[k])
+		}
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
+	return
+}
+
+// Delete will permanently delete a particular NDP proxy based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// RouterCreateOptsExt adds the NDP proxy toggle to the base
+// routers.CreateOpts.
+type RouterCreateOptsExt struct {
+	routers.CreateOptsBuilder
+
+	// EnableNDPProxy toggles NDP proxying on the router.
+	EnableNDPProxy *bool `json:"enable_ndp_proxy,omitempty"`
+}
+
+// ToRouterCreateMap casts a CreateOpts struct to a map.
+func (opts RouterCreateOptsExt) ToRouterCreateMap() (map[string]any, error) {
+	base, err := opts.CreateOptsBuilder.ToRouterCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	router := base["router"].(map[string]any)
+
+	if opts.EnableNDPProxy != nil {
+		router["enable_ndp_proxy"] = *opts.EnableNDPProxy
+	}
+
+	return base, nil
+}
+
+// RouterUpdateOptsExt adds the NDP proxy toggle to the base
+// routers.UpdateOpts.
+type RouterUpdateOptsExt struct {
+	routers.UpdateOptsBuilder
+
+	// EnableNDPProxy toggles NDP proxying on the router.
+	EnableNDPProxy *bool `json:"enable_ndp_proxy,omitempty"`
+}
+
+// ToRouterUpdateMap casts an UpdateOpts struct to a map.
+func (opts RouterUpdateOptsExt) ToRouterUpdateMap() (map[string]any, error) {
+	base, err := opts.UpdateOptsBuilder.ToRouterUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	router := base["router"].(map[string]any)
+
+	if opts.EnableNDPProxy != nil {
+		router["enable_ndp_proxy"] = *opts.EnableNDPProxy
+	}
+
+	return base, nil
+}
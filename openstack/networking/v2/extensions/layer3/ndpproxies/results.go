@@ -0,0 +1,127 @@
+package ndpproxies
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// NDPProxy represents the publication of an internal IPv6 address by a
+// router, which then answers the neighbor solicitations for it on the
+// external network.
+type NDPProxy struct {
+	// ID is the unique ID of the NDP proxy.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the NDP proxy.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the NDP proxy.
+	Description string `json:"description"`
+
+	// ProjectID is the project owner of the NDP proxy.
+	ProjectID string `json:"project_id"`
+
+	// RouterID is the ID of the router answering the neighbor solicitations.
+	RouterID string `json:"router_id"`
+
+	// PortID is the ID of the internal port whose IPv6 address is published.
+	PortID string `json:"port_id"`
+
+	// IPAddress is the published IPv6 address.
+	IPAddress string `json:"ip_address"`
+
+	// RevisionNumber is the revision number of the NDP proxy.
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt is the time at which the NDP proxy was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time at which the NDP proxy was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an NDPProxy.
+func (r commonResult) Extract() (*NDPProxy, error) {
+	var s struct {
+		NDPProxy *NDPProxy `json:"ndp_proxy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.NDPProxy, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as an NDPProxy.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as an NDPProxy.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as an NDPProxy.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NDPProxyPage is the page returned by a pager when traversing over a
+// collection of NDP proxies.
+type NDPProxyPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of NDP proxies has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r NDPProxyPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"ndp_proxies_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an NDPProxyPage struct is empty.
+func (r NDPProxyPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractNDPProxies(r)
+	return len(is) == 0, err
+}
+
+// ExtractNDPProxies accepts a Page struct, specifically an NDPProxyPage
+// struct, and extracts the elements into a slice of NDPProxy structs.
+func ExtractNDPProxies(r pagination.Page) ([]NDPProxy, error) {
+	var s struct {
+		NDPProxies []NDPProxy `json:"ndp_proxies"`
+	}
+	err := (r.(NDPProxyPage)).ExtractInto(&s)
+	return s.NDPProxies, err
+}
+
+// RouterNDPProxyExt represents the NDP proxy toggle of a router. It is meant
+// to be embedded along routers.Router when calling ExtractIntoStructPtr.
+type RouterNDPProxyExt struct {
+	// EnableNDPProxy specifies whether NDP proxying is enabled on the router.
+	EnableNDPProxy bool `json:"enable_ndp_proxy"`
+}
@@ -0,0 +1,171 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/ndpproxies"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const (
+	NDPProxyID = "5f1c1b5e-7d4a-4a8e-8d43-3c0f3a1e2b6d"
+	RouterID   = "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+)
+
+const ListResponse = `
+{
+    "ndp_proxies": [
+        {
+            "id": "5f1c1b5e-7d4a-4a8e-8d43-3c0f3a1e2b6d",
+            "name": "web-1",
+            "description": "",
+            "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+            "router_id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+            "port_id": "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+            "ip_address": "2001:db8::10",
+            "revision_number": 0,
+            "created_at": "2024-03-14T10:11:12Z",
+            "updated_at": "2024-03-14T10:11:12Z"
+        }
+    ]
+}
+`
+
+const NDPProxyResponse = `
+{
+    "ndp_proxy": {
+        "id": "5f1c1b5e-7d4a-4a8e-8d43-3c0f3a1e2b6d",
+        "name": "web-1",
+        "description": "",
+        "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+        "router_id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "port_id": "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+        "ip_address": "2001:db8::10",
+        "revision_number": 0,
+        "created_at": "2024-03-14T10:11:12Z",
+        "updated_at": "2024-03-14T10:11:12Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "ndp_proxy": {
+        "name": "web-1",
+        "router_id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "port_id": "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+        "ip_address": "2001:db8::10"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "ndp_proxy": {
+        "name": "web-1"
+    }
+}
+`
+
+const RouterUpdateRequest = `
+{
+    "router": {
+        "name": "edge",
+        "enable_ndp_proxy": true
+    }
+}
+`
+
+const RouterUpdateResponse = `
+{
+    "router": {
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "name": "edge",
+        "status": "ACTIVE",
+        "admin_state_up": true,
+        "enable_ndp_proxy": true
+    }
+}
+`
+
+var NDPProxyWeb1 = ndpproxies.NDPProxy{
+	ID:        NDPProxyID,
+	Name:      "web-1",
+	ProjectID: "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+	RouterID:  RouterID,
+	PortID:    "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+	IPAddress: "2001:db8::10",
+	CreatedAt: time.Date(2024, 3, 14, 10, 11, 12, 0, time.UTC),
+	UpdatedAt: time.Date(2024, 3, 14, 10, 11, 12, 0, time.UTC),
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"router_id": RouterID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, NDPProxyResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, NDPProxyResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, NDPProxyResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleRouterUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, RouterUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, RouterUpdateResponse)
+	})
+}
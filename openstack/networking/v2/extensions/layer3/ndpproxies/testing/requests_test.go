@@ -0,0 +1,137 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/ndpproxies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	count := 0
+	err := ndpproxies.List(fake.ServiceClient(fakeServer), ndpproxies.ListOpts{RouterID: RouterID}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := ndpproxies.ExtractNDPProxies(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []ndpproxies.NDPProxy{NDPProxyWeb1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := ndpproxies.CreateOpts{
+		Name:      "web-1",
+		RouterID:  RouterID,
+		PortID:    "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+		IPAddress: "2001:db8::10",
+	}
+	actual, err := ndpproxies.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, NDPProxyWeb1, *actual)
+}
+
+func TestCreateRequiredOpts(t *testing.T) {
+	_, err := ndpproxies.CreateOpts{RouterID: RouterID}.ToNDPProxyCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when PortID is not set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := ndpproxies.Get(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, NDPProxyWeb1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	name := "web-1"
+	actual, err := ndpproxies.Update(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID, ndpproxies.UpdateOpts{Name: &name}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, NDPProxyWeb1, *actual)
+}
+
+func TestUpdateRevision(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "If-Match", "revision_number=42")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, err := w.Write([]byte(`{"NeutronError": {"type": "RevisionNumberConstraintFailed", "message": "Constrained to 42, but current revision is 43", "detail": ""}}`))
+		th.AssertNoErr(t, err)
+	})
+
+	name := "web-1"
+	revisionNumber := 42
+	updateOpts := ndpproxies.UpdateOpts{
+		Name:           &name,
+		RevisionNumber: &revisionNumber,
+	}
+	_, err := ndpproxies.Update(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID, updateOpts).Extract()
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 42, conflict.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := ndpproxies.Delete(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRouterUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleRouterUpdateSuccessfully(t, fakeServer)
+
+	name := "edge"
+	enabled := true
+	updateOpts := ndpproxies.RouterUpdateOptsExt{
+		UpdateOptsBuilder: routers.UpdateOpts{Name: name},
+		EnableNDPProxy:    &enabled,
+	}
+
+	var actual struct {
+		routers.Router
+		ndpproxies.RouterNDPProxyExt
+	}
+	err := routers.Update(context.TODO(), fake.ServiceClient(fakeServer), RouterID, updateOpts).ExtractIntoStructPtr(&actual, "router")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, RouterID, actual.ID)
+	th.AssertEquals(t, "edge", actual.Name)
+	th.AssertEquals(t, true, actual.EnableNDPProxy)
+}
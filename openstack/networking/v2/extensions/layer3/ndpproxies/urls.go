@@ -0,0 +1,13 @@
+package ndpproxies
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const resourcePath = "ndp_proxies"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
@@ -170,6 +171,7 @@ func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts U
 		OkCodes:     []int{200},
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
 	return
 }
 
@@ -0,0 +1,72 @@
+/*
+Package localips manages and retrieves the Local IPs of the OpenStack
+Networking Service. A Local IP is a virtual IP shared by the ports associated
+with it, whose traffic is kept local to the hypervisor of each port.
+
+Example to List Local IPs
+
+	allPages, err := localips.List(networkClient, localips.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLocalIPs, err := localips.ExtractLocalIPs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, localIP := range allLocalIPs {
+		fmt.Printf("%+v\n", localIP)
+	}
+
+Example to Create a Local IP
+
+	createOpts := localips.CreateOpts{
+		Name:      "dns-cache",
+		NetworkID: "8d4c70a5-8f8e-4c3a-b4cf-6a4b7f6c1b2a",
+		IPMode:    localips.IPModeTranslate,
+	}
+
+	localIP, err := localips.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Port with a Local IP
+
+	createOpts := localips.CreateAssociationOpts{
+		FixedPortID: "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+	}
+
+	association, err := localips.CreateAssociation(context.TODO(), networkClient, localIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Associations of a Local IP
+
+	allPages, err := localips.ListAssociations(networkClient, localIPID, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAssociations, err := localips.ExtractAssociations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove the Association of a Port with a Local IP
+
+	err := localips.DeleteAssociation(context.TODO(), networkClient, localIPID, portID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Local IP
+
+	err := localips.Delete(context.TODO(), networkClient, localIPID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package localips
@@ -0,0 +1,268 @@
+package localips
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// IPMode is the way the traffic to a Local IP is handled.
+type IPMode string
+
+const (
+	// IPModeTranslate translates the Local IP to the fixed IP of the
+	// associated port.
+	IPModeTranslate IPMode = "translate"
+
+	// IPModePassthrough passes the traffic to the Local IP unchanged to the
+	// associated port.
+	IPModePassthrough IPMode = "passthrough"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToLocalIPListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the Local IP attributes you want to see returned.
+type ListOpts struct {
+	ID             string `q:"id"`
+	Name           string `q:"name"`
+	Description    string `q:"description"`
+	ProjectID      string `q:"project_id"`
+	LocalPortID    string `q:"local_port_id"`
+	NetworkID      string `q:"network_id"`
+	LocalIPAddress string `q:"local_ip_address"`
+	IPMode         IPMode `q:"ip_mode"`
+	RevisionNumber *int   `q:"revision_number"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+}
+
+// ToLocalIPListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLocalIPListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// Local IPs. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToLocalIPListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LocalIPPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLocalIPCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new Local IP. Either
+// LocalPortID or NetworkID must be set.
+type CreateOpts struct {
+	// Name is the human-readable name of the Local IP.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the Local IP.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the project owner of the Local IP. Only administrative
+	// users can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// LocalPortID is the ID of the port holding the Local IP. A port is
+	// created on NetworkID when it is not set.
+	LocalPortID string `json:"local_port_id,omitempty" or:"NetworkID"`
+
+	// NetworkID is the ID of the network to create the port holding the
+	// Local IP on.
+	NetworkID string `json:"network_id,omitempty" or:"LocalPortID"`
+
+	// LocalIPAddress is the IP address of the Local IP. It must be one of
+	// the fixed IPs of LocalPortID when it is set.
+	LocalIPAddress string `json:"local_ip_address,omitempty"`
+
+	// IPMode is the way the traffic to the Local IP is handled. Defaults to
+	// translate.
+	IPMode IPMode `json:"ip_mode,omitempty"`
+}
+
+// ToLocalIPCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToLocalIPCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "local_ip")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// Local IP.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLocalIPCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular Local IP based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLocalIPUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a Local IP.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
+	// will set revision_number=%s. If the revision number does not match, the
+	// update will fail.
+	RevisionNumber *int `json:"-" h:"If-Match"`
+}
+
+// ToLocalIPUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToLocalIPUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "local_ip")
+}
+
+// Update allows Local IPs to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLocalIPUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	for k := range h {
+		if k == "If-Match" {
+			h[k] = fmt.Sprintf("revision_number=%s", h[k])
+		}
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
+	return
+}
+
+// Delete will permanently delete a particular Local IP based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListAssociationsOptsBuilder allows extensions to add additional parameters
+// to the ListAssociations request.
+type ListAssociationsOptsBuilder interface {
+	ToAssociationListQuery() (string, error)
+}
+
+// ListAssociationsOpts allows the filtering and sorting of the associations
+// of a Local IP.
+type ListAssociationsOpts struct {
+	FixedPortID string `q:"fixed_port_id"`
+	FixedIP     string `q:"fixed_ip"`
+	Host        string `q:"host"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToAssociationListQuery formats a ListAssociationsOpts into a query string.
+func (opts ListAssociationsOpts) ToAssociationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListAssociations returns a Pager which allows you to iterate over the
+// ports associated with a Local IP.
+func ListAssociations(c *gophercloud.ServiceClient, id string, opts ListAssociationsOptsBuilder) pagination.Pager {
+	url := associationsURL(c, id)
+	if opts != nil {
+		query, err := opts.ToAssociationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AssociationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateAssociationOptsBuilder allows extensions to add additional parameters
+// to the CreateAssociation request.
+type CreateAssociationOptsBuilder interface {
+	ToAssociationCreateMap() (map[string]any, error)
+}
+
+// CreateAssociationOpts contains the values needed to associate a port with a
+// Local IP.
+type CreateAssociationOpts struct {
+	// FixedPortID is the ID of the port to associate.
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+
+	// FixedIP is the fixed IP of the port the traffic to the Local IP is
+	// sent to. It is required when the port has several fixed IPs.
+	FixedIP string `json:"fixed_ip,omitempty"`
+}
+
+// ToAssociationCreateMap casts a CreateAssociationOpts struct to a map.
+func (opts CreateAssociationOpts) ToAssociationCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_association")
+}
+
+// CreateAssociation associates a port with a Local IP.
+func CreateAssociation(ctx context.Context, c *gophercloud.ServiceClient, id string, opts CreateAssociationOptsBuilder) (r CreateAssociationResult) {
+	b, err := opts.ToAssociationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, associationsURL(c, id), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteAssociation removes the association of a port with a Local IP.
+func DeleteAssociation(ctx context.Context, c *gophercloud.ServiceClient, id, portID string) (r DeleteAssociationResult) {
+	resp, err := c.Delete(ctx, associationURL(c, id, portID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,203 @@
+package localips
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// LocalIP represents a virtual IP shared by the ports associated with it,
+// whose traffic is kept local to the hypervisor of each port.
+type LocalIP struct {
+	// ID is the unique ID of the Local IP.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the Local IP.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the Local IP.
+	Description string `json:"description"`
+
+	// ProjectID is the project owner of the Local IP.
+	ProjectID string `json:"project_id"`
+
+	// LocalPortID is the ID of the port holding the Local IP.
+	LocalPortID string `json:"local_port_id"`
+
+	// NetworkID is the ID of the network of LocalPortID.
+	NetworkID string `json:"network_id"`
+
+	// LocalIPAddress is the IP address of the Local IP.
+	LocalIPAddress string `json:"local_ip_address"`
+
+	// IPMode is the way the traffic to the Local IP is handled.
+	IPMode IPMode `json:"ip_mode"`
+
+	// RevisionNumber is the revision number of the Local IP.
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt is the time at which the Local IP was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time at which the Local IP was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a LocalIP.
+func (r commonResult) Extract() (*LocalIP, error) {
+	var s struct {
+		LocalIP *LocalIP `json:"local_ip"`
+	}
+	err := r.ExtractInto(&s)
+	return s.LocalIP, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a LocalIP.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a LocalIP.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a LocalIP.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LocalIPPage is the page returned by a pager when traversing over a
+// collection of Local IPs.
+type LocalIPPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of Local IPs has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r LocalIPPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"local_ips_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LocalIPPage struct is empty.
+func (r LocalIPPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLocalIPs(r)
+	return len(is) == 0, err
+}
+
+// ExtractLocalIPs accepts a Page struct, specifically a LocalIPPage struct,
+// and extracts the elements into a slice of LocalIP structs.
+func ExtractLocalIPs(r pagination.Page) ([]LocalIP, error) {
+	var s struct {
+		LocalIPs []LocalIP `json:"local_ips"`
+	}
+	err := (r.(LocalIPPage)).ExtractInto(&s)
+	return s.LocalIPs, err
+}
+
+// Association represents the association of a port with a Local IP.
+type Association struct {
+	// LocalIPID is the ID of the Local IP.
+	LocalIPID string `json:"local_ip_id"`
+
+	// LocalIPAddress is the IP address of the Local IP.
+	LocalIPAddress string `json:"local_ip_address"`
+
+	// FixedPortID is the ID of the associated port.
+	FixedPortID string `json:"fixed_port_id"`
+
+	// FixedIP is the fixed IP of the port the traffic to the Local IP is
+	// sent to.
+	FixedIP string `json:"fixed_ip"`
+
+	// Host is the host of the associated port.
+	Host string `json:"host"`
+}
+
+// CreateAssociationResult represents the result of a create association
+// operation. Call its Extract method to interpret it as an Association.
+type CreateAssociationResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an Association.
+func (r CreateAssociationResult) Extract() (*Association, error) {
+	var s struct {
+		Association *Association `json:"port_association"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Association, err
+}
+
+// DeleteAssociationResult represents the result of a delete association
+// operation. Call its ExtractErr method to determine if the request succeeded
+// or failed.
+type DeleteAssociationResult struct {
+	gophercloud.ErrResult
+}
+
+// AssociationPage is the page returned by a pager when traversing over the
+// associations of a Local IP.
+type AssociationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of associations has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r AssociationPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_associations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an AssociationPage struct is empty.
+func (r AssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractAssociations(r)
+	return len(is) == 0, err
+}
+
+// ExtractAssociations accepts a Page struct, specifically an AssociationPage
+// struct, and extracts the elements into a slice of Association structs.
+func ExtractAssociations(r pagination.Page) ([]Association, error) {
+	var s struct {
+		Associations []Association `json:"port_associations"`
+	}
+	err := (r.(AssociationPage)).ExtractInto(&s)
+	return s.Associations, err
+}
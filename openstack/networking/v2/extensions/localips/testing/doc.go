@@ -0,0 +1,2 @@
+// localips unit tests
+package testing
@@ -0,0 +1,212 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/localips"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const LocalIPID = "1ab7a5ce-6b1a-4a4e-9c1a-bd8f0e2b7d7e"
+
+const ListResponse = `
+{
+    "local_ips": [
+        {
+            "id": "1ab7a5ce-6b1a-4a4e-9c1a-bd8f0e2b7d7e",
+            "name": "dns-cache",
+            "description": "node local DNS cache",
+            "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+            "local_port_id": "0d2c6d06-2c7f-4d3a-9c1b-5ad1b4b6c2f1",
+            "network_id": "8d4c70a5-8f8e-4c3a-b4cf-6a4b7f6c1b2a",
+            "local_ip_address": "10.0.0.42",
+            "ip_mode": "translate",
+            "revision_number": 1,
+            "created_at": "2024-03-14T10:11:12Z",
+            "updated_at": "2024-03-14T10:11:12Z"
+        }
+    ]
+}
+`
+
+const LocalIPResponse = `
+{
+    "local_ip": {
+        "id": "1ab7a5ce-6b1a-4a4e-9c1a-bd8f0e2b7d7e",
+        "name": "dns-cache",
+        "description": "node local DNS cache",
+        "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+        "local_port_id": "0d2c6d06-2c7f-4d3a-9c1b-5ad1b4b6c2f1",
+        "network_id": "8d4c70a5-8f8e-4c3a-b4cf-6a4b7f6c1b2a",
+        "local_ip_address": "10.0.0.42",
+        "ip_mode": "translate",
+        "revision_number": 1,
+        "created_at": "2024-03-14T10:11:12Z",
+        "updated_at": "2024-03-14T10:11:12Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "local_ip": {
+        "name": "dns-cache",
+        "description": "node local DNS cache",
+        "network_id": "8d4c70a5-8f8e-4c3a-b4cf-6a4b7f6c1b2a",
+        "local_ip_address": "10.0.0.42",
+        "ip_mode": "translate"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "local_ip": {
+        "name": "dns-cache",
+        "description": ""
+    }
+}
+`
+
+const ListAssociationsResponse = `
+{
+    "port_associations": [
+        {
+            "local_ip_id": "1ab7a5ce-6b1a-4a4e-9c1a-bd8f0e2b7d7e",
+            "local_ip_address": "10.0.0.42",
+            "fixed_port_id": "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+            "fixed_ip": "192.168.1.5",
+            "host": "compute-1"
+        }
+    ]
+}
+`
+
+const CreateAssociationRequest = `
+{
+    "port_association": {
+        "fixed_port_id": "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+        "fixed_ip": "192.168.1.5"
+    }
+}
+`
+
+const CreateAssociationResponse = `
+{
+    "port_association": {
+        "local_ip_id": "1ab7a5ce-6b1a-4a4e-9c1a-bd8f0e2b7d7e",
+        "local_ip_address": "10.0.0.42",
+        "fixed_port_id": "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+        "fixed_ip": "192.168.1.5",
+        "host": "compute-1"
+    }
+}
+`
+
+var LocalIPDNSCache = localips.LocalIP{
+	ID:             LocalIPID,
+	Name:           "dns-cache",
+	Description:    "node local DNS cache",
+	ProjectID:      "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+	LocalPortID:    "0d2c6d06-2c7f-4d3a-9c1b-5ad1b4b6c2f1",
+	NetworkID:      "8d4c70a5-8f8e-4c3a-b4cf-6a4b7f6c1b2a",
+	LocalIPAddress: "10.0.0.42",
+	IPMode:         localips.IPModeTranslate,
+	RevisionNumber: 1,
+	CreatedAt:      time.Date(2024, 3, 14, 10, 11, 12, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 3, 14, 10, 11, 12, 0, time.UTC),
+}
+
+var AssociationCompute1 = localips.Association{
+	LocalIPID:      LocalIPID,
+	LocalIPAddress: "10.0.0.42",
+	FixedPortID:    "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+	FixedIP:        "192.168.1.5",
+	Host:           "compute-1",
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, LocalIPResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, LocalIPResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, LocalIPResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleAssociationsSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID+"/port_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"host": "compute-1"})
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, ListAssociationsResponse)
+		case "POST":
+			th.TestJSONRequest(t, r, CreateAssociationRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, CreateAssociationResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+func HandleDeleteAssociationSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID+"/port_associations/"+AssociationCompute1.FixedPortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/localips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	count := 0
+	err := localips.List(fake.ServiceClient(fakeServer), localips.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := localips.ExtractLocalIPs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []localips.LocalIP{LocalIPDNSCache}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := localips.CreateOpts{
+		Name:           "dns-cache",
+		Description:    "node local DNS cache",
+		NetworkID:      "8d4c70a5-8f8e-4c3a-b4cf-6a4b7f6c1b2a",
+		LocalIPAddress: "10.0.0.42",
+		IPMode:         localips.IPModeTranslate,
+	}
+	actual, err := localips.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LocalIPDNSCache, *actual)
+}
+
+func TestCreateRequiresPortOrNetwork(t *testing.T) {
+	_, err := localips.CreateOpts{Name: "dns-cache"}.ToLocalIPCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when neither LocalPortID nor NetworkID is set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := localips.Get(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LocalIPDNSCache, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	name := "dns-cache"
+	description := ""
+	updateOpts := localips.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+	actual, err := localips.Update(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LocalIPDNSCache, *actual)
+}
+
+func TestUpdateRevision(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "If-Match", "revision_number=42")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, err := w.Write([]byte(`{"NeutronError": {"type": "RevisionNumberConstraintFailed", "message": "Constrained to 42, but current revision is 43", "detail": ""}}`))
+		th.AssertNoErr(t, err)
+	})
+
+	name := "dns-cache"
+	description := ""
+	revisionNumber := 42
+	updateOpts := localips.UpdateOpts{
+		Name:           &name,
+		Description:    &description,
+		RevisionNumber: &revisionNumber,
+	}
+	_, err := localips.Update(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID, updateOpts).Extract()
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 42, conflict.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := localips.Delete(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListAssociations(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleAssociationsSuccessfully(t, fakeServer)
+
+	listOpts := localips.ListAssociationsOpts{Host: "compute-1"}
+	allPages, err := localips.ListAssociations(fake.ServiceClient(fakeServer), LocalIPID, listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := localips.ExtractAssociations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []localips.Association{AssociationCompute1}, actual)
+}
+
+func TestCreateAssociation(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleAssociationsSuccessfully(t, fakeServer)
+
+	createOpts := localips.CreateAssociationOpts{
+		FixedPortID: "6e1f5b1c-2a4c-4d6e-8f0a-1b3c5d7e9f1a",
+		FixedIP:     "192.168.1.5",
+	}
+	actual, err := localips.CreateAssociation(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, AssociationCompute1, *actual)
+}
+
+func TestDeleteAssociation(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteAssociationSuccessfully(t, fakeServer)
+
+	err := localips.DeleteAssociation(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID, AssociationCompute1.FixedPortID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,24 @@
+package localips
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	rootPath        = "local_ips"
+	associationPath = "port_associations"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
+
+func associationsURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id, associationPath)
+}
+
+func associationURL(c *gophercloud.ServiceClient, id, portID string) string {
+	return c.ServiceURL(rootPath, id, associationPath, portID)
+}
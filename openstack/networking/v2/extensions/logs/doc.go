@@ -0,0 +1,93 @@
+/*
+Package logs manages and retrieves the logs of the logging extension of the
+OpenStack Networking Service, which log the packets accepted or dropped by
+security groups and fwaas_v2 firewall groups.
+
+Example to List Loggable Resources
+
+	allPages, err := logs.ListLoggableResources(networkClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allResources, err := logs.ExtractLoggableResources(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range allResources {
+		fmt.Println(resource.Type)
+	}
+
+Example to List Logs
+
+	listOpts := logs.ListOpts{
+		ResourceType: logs.ResourceTypeSecurityGroup,
+	}
+
+	allPages, err := logs.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLogs, err := logs.ExtractLogs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, log := range allLogs {
+		fmt.Printf("%+v\n", log)
+	}
+
+Example to Log the Dropped Packets of a Security Group
+
+	createOpts := logs.CreateOpts{
+		Name:         "sg-drops",
+		ResourceType: logs.ResourceTypeSecurityGroup,
+		ResourceID:   "85cc3048-abc3-43cc-89b3-377341426ac5",
+		Event:        logs.EventDrop,
+	}
+
+	log, err := logs.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Log the Packets of a Firewall Group on a Port
+
+	createOpts := logs.CreateOpts{
+		Name:         "fwg-port",
+		ResourceType: logs.ResourceTypeFirewallGroup,
+		ResourceID:   "3c0d1b1f-4a5e-4c4b-9a7e-8d2f0c5b6a71",
+		TargetID:     "65c0ee9f-d634-4522-8954-51021b570b0d",
+		Event:        logs.EventAll,
+	}
+
+	log, err := logs.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable a Log
+
+	logID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+
+	enabled := false
+	updateOpts := logs.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	log, err := logs.Update(context.TODO(), networkClient, logID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Log
+
+	logID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+	err := logs.Delete(context.TODO(), networkClient, logID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package logs
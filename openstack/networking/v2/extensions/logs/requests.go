@@ -0,0 +1,236 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Event is the type of the packets which are logged.
+type Event string
+
+const (
+	// EventAll logs both the accepted and the dropped packets.
+	EventAll Event = "ALL"
+
+	// EventAccept logs the accepted packets.
+	EventAccept Event = "ACCEPT"
+
+	// EventDrop logs the dropped packets.
+	EventDrop Event = "DROP"
+)
+
+// ResourceType is the type of the resource a log is created for. The types
+// supported by a deployment are returned by ListLoggableResources.
+type ResourceType string
+
+const (
+	// ResourceTypeSecurityGroup logs the packets matching the rules of a
+	// security group.
+	ResourceTypeSecurityGroup ResourceType = "security_group"
+
+	// ResourceTypeFirewallGroup logs the packets matching the rules of a
+	// fwaas_v2 firewall group.
+	ResourceTypeFirewallGroup ResourceType = "firewall_group"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToLogListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the log attributes you want to see returned.
+type ListOpts struct {
+	ID           string       `q:"id"`
+	Name         string       `q:"name"`
+	Description  string       `q:"description"`
+	TenantID     string       `q:"tenant_id"`
+	ProjectID    string       `q:"project_id"`
+	ResourceType ResourceType `q:"resource_type"`
+	ResourceID   string       `q:"resource_id"`
+	TargetID     string       `q:"target_id"`
+	Event        Event        `q:"event"`
+	Enabled      *bool        `q:"enabled"`
+	Limit        int          `q:"limit"`
+	Marker       string       `q:"marker"`
+	SortKey      string       `q:"sort_key"`
+	SortDir      string       `q:"sort_dir"`
+}
+
+// ToLogListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLogListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// logs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToLogListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LogPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLogCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new log.
+type CreateOpts struct {
+	// Name is the human-readable name of the log.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the log.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the project owner of the log. Only administrative users
+	// can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// ResourceType is the type of the resource to log.
+	ResourceType ResourceType `json:"resource_type" required:"true"`
+
+	// ResourceID is the ID of the security group or firewall group to log.
+	// All the resources of ResourceType are logged when it is not set.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// TargetID is the ID of the port to log. All the ports of the resource
+	// are logged when it is not set.
+	TargetID string `json:"target_id,omitempty"`
+
+	// Event is the type of the packets to log. Defaults to EventAll.
+	Event Event `json:"event,omitempty"`
+
+	// Enabled enables the log. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToLogCreateMap casts a CreateOpts struct to a map. It checks that Event is
+// one of the known events, and that ResourceID and TargetID are UUIDs.
+func (opts CreateOpts) ToLogCreateMap() (map[string]any, error) {
+	switch opts.Event {
+	case "", EventAll, EventAccept, EventDrop:
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "logs.CreateOpts.Event"
+		err.Value = opts.Event
+		return nil, err
+	}
+	if opts.ResourceID != "" && !uuidPattern.MatchString(opts.ResourceID) {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "logs.CreateOpts.ResourceID"
+		err.Value = opts.ResourceID
+		return nil, err
+	}
+	if opts.TargetID != "" && !uuidPattern.MatchString(opts.TargetID) {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "logs.CreateOpts.TargetID"
+		err.Value = opts.TargetID
+		return nil, err
+	}
+	return gophercloud.BuildRequestBody(opts, "log")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new log.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLogCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular log based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLogUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a log. The resource,
+// target and event of a log cannot be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+
+	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
+	// will set revision_number=%s. If the revision number does not match, the
+	// update will fail.
+	RevisionNumber *int `json:"-" h:"If-Match"`
+}
+
+// ToLogUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToLogUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "log")
+}
+
+// Update allows logs to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLogUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	for k := range h {
+		if k == "If-Match" {
+			h[k] = fmt.Sprintf("revision_number=%s", h[k])
+		}
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
+	return
+}
+
+// Delete will permanently delete a particular log based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListLoggableResources returns a Pager which allows you to iterate over the
+// types of resources which can be logged.
+func ListLoggableResources(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, loggableResourcesURL(c), func(r pagination.PageResult) pagination.Page {
+		return LoggableResourcePage{pagination.SinglePageBase(r)}
+	})
+}
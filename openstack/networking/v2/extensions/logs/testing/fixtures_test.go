@@ -0,0 +1,239 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/logs"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const LogID = "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+
+const ListResponse = `
+{
+    "logs": [
+        {
+            "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+            "name": "sg-drops",
+            "description": "",
+            "tenant_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+            "project_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+            "resource_type": "security_group",
+            "resource_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+            "target_id": null,
+            "event": "DROP",
+            "enabled": true,
+            "revision_number": 1,
+            "created_at": "2024-05-13T10:20:30Z",
+            "updated_at": "2024-05-13T10:20:31Z"
+        },
+        {
+            "id": "7c1c5f3e-0e0a-4c3b-9f4d-2a6e8b9d0c1f",
+            "name": "fwg-port",
+            "description": "firewall group on a port",
+            "tenant_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+            "project_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+            "resource_type": "firewall_group",
+            "resource_id": "3c0d1b1f-4a5e-4c4b-9a7e-8d2f0c5b6a71",
+            "target_id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+            "event": "ALL",
+            "enabled": false,
+            "revision_number": 3,
+            "created_at": "2024-05-14T08:00:00Z",
+            "updated_at": "2024-05-15T09:30:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "log": {
+        "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+        "name": "sg-drops",
+        "description": "",
+        "tenant_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+        "project_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+        "resource_type": "security_group",
+        "resource_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+        "target_id": null,
+        "event": "DROP",
+        "enabled": true,
+        "revision_number": 1,
+        "created_at": "2024-05-13T10:20:30Z",
+        "updated_at": "2024-05-13T10:20:31Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "log": {
+        "name": "sg-drops",
+        "resource_type": "security_group",
+        "resource_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+        "event": "DROP"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "log": {
+        "description": "disabled during maintenance",
+        "enabled": false
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "log": {
+        "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+        "name": "sg-drops",
+        "description": "disabled during maintenance",
+        "tenant_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+        "project_id": "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+        "resource_type": "security_group",
+        "resource_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+        "target_id": null,
+        "event": "DROP",
+        "enabled": false,
+        "revision_number": 2,
+        "created_at": "2024-05-13T10:20:30Z",
+        "updated_at": "2024-05-16T11:00:00Z"
+    }
+}
+`
+
+const ListLoggableResourcesResponse = `
+{
+    "loggable_resources": [
+        {
+            "type": "security_group"
+        },
+        {
+            "type": "firewall_group"
+        }
+    ]
+}
+`
+
+var (
+	Log1 = logs.Log{
+		ID:             "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+		Name:           "sg-drops",
+		TenantID:       "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+		ProjectID:      "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+		ResourceType:   logs.ResourceTypeSecurityGroup,
+		ResourceID:     "85cc3048-abc3-43cc-89b3-377341426ac5",
+		Event:          logs.EventDrop,
+		Enabled:        true,
+		RevisionNumber: 1,
+		CreatedAt:      time.Date(2024, 5, 13, 10, 20, 30, 0, time.UTC),
+		UpdatedAt:      time.Date(2024, 5, 13, 10, 20, 31, 0, time.UTC),
+	}
+
+	Log2 = logs.Log{
+		ID:             "7c1c5f3e-0e0a-4c3b-9f4d-2a6e8b9d0c1f",
+		Name:           "fwg-port",
+		Description:    "firewall group on a port",
+		TenantID:       "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+		ProjectID:      "8f9a6c3a14a44d0d9b6f5b6d7c8a1b2c",
+		ResourceType:   logs.ResourceTypeFirewallGroup,
+		ResourceID:     "3c0d1b1f-4a5e-4c4b-9a7e-8d2f0c5b6a71",
+		TargetID:       "65c0ee9f-d634-4522-8954-51021b570b0d",
+		Event:          logs.EventAll,
+		Enabled:        false,
+		RevisionNumber: 3,
+		CreatedAt:      time.Date(2024, 5, 14, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2024, 5, 15, 9, 30, 0, 0, time.UTC),
+	}
+)
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"resource_type": "security_group", "enabled": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListLoggableResourcesSuccessfully configures the test server to
+// respond to a ListLoggableResources request.
+func HandleListLoggableResourcesSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/log/loggable-resources", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListLoggableResourcesResponse)
+	})
+}
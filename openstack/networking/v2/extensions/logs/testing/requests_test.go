@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/logs"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	enabled := true
+	listOpts := logs.ListOpts{
+		ResourceType: logs.ResourceTypeSecurityGroup,
+		Enabled:      &enabled,
+	}
+
+	count := 0
+	err := logs.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := logs.ExtractLogs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []logs.Log{Log1, Log2}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := logs.CreateOpts{
+		Name:         "sg-drops",
+		ResourceType: logs.ResourceTypeSecurityGroup,
+		ResourceID:   "85cc3048-abc3-43cc-89b3-377341426ac5",
+		Event:        logs.EventDrop,
+	}
+	actual, err := logs.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Log1, *actual)
+}
+
+func TestCreateOptsValidation(t *testing.T) {
+	for name, opts := range map[string]logs.CreateOpts{
+		"missing resource type": {},
+		"event":                 {ResourceType: logs.ResourceTypeSecurityGroup, Event: "REJECT"},
+		"resource id":           {ResourceType: logs.ResourceTypeSecurityGroup, ResourceID: "default"},
+		"target id":             {ResourceType: logs.ResourceTypeFirewallGroup, TargetID: "port-1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := opts.ToLogCreateMap()
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+
+	_, err := logs.CreateOpts{ResourceType: logs.ResourceTypeSecurityGroup, ResourceID: "default"}.ToLogCreateMap()
+	var invalid gophercloud.ErrInvalidInput
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected an ErrInvalidInput, got %v", err)
+	}
+	th.AssertEquals(t, "logs.CreateOpts.ResourceID", invalid.Argument)
+
+	_, err = logs.CreateOpts{
+		ResourceType: logs.ResourceTypeFirewallGroup,
+		ResourceID:   "3c0d1b1f4a5e4c4b9a7e8d2f0c5b6a71",
+		TargetID:     "65c0ee9f-d634-4522-8954-51021b570b0d",
+		Event:        logs.EventAccept,
+	}.ToLogCreateMap()
+	th.AssertNoErr(t, err)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := logs.Get(context.TODO(), fake.ServiceClient(fakeServer), LogID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Log1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	description := "disabled during maintenance"
+	enabled := false
+	updateOpts := logs.UpdateOpts{
+		Description: &description,
+		Enabled:     &enabled,
+	}
+	actual, err := logs.Update(context.TODO(), fake.ServiceClient(fakeServer), LogID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := Log1
+	expected.Description = description
+	expected.Enabled = false
+	expected.RevisionNumber = 2
+	expected.UpdatedAt = time.Date(2024, 5, 16, 11, 0, 0, 0, time.UTC)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestUpdateRevision(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "If-Match", "revision_number=42")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, err := w.Write([]byte(`{"NeutronError": {"type": "RevisionNumberConstraintFailed", "message": "Constrained to 42, but current revision is 43", "detail": ""}}`))
+		th.AssertNoErr(t, err)
+	})
+
+	description := "disabled during maintenance"
+	enabled := false
+	revisionNumber := 42
+	updateOpts := logs.UpdateOpts{
+		Description:    &description,
+		Enabled:        &enabled,
+		RevisionNumber: &revisionNumber,
+	}
+	_, err := logs.Update(context.TODO(), fake.ServiceClient(fakeServer), LogID, updateOpts).Extract()
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 42, conflict.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := logs.Delete(context.TODO(), fake.ServiceClient(fakeServer), LogID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListLoggableResources(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListLoggableResourcesSuccessfully(t, fakeServer)
+
+	allPages, err := logs.ListLoggableResources(fake.ServiceClient(fakeServer)).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := logs.ExtractLoggableResources(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []logs.LoggableResource{
+		{Type: logs.ResourceTypeSecurityGroup},
+		{Type: logs.ResourceTypeFirewallGroup},
+	}, actual)
+}
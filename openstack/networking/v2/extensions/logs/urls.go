@@ -0,0 +1,21 @@
+package logs
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	rootPath              = "log"
+	resourcePath          = "logs"
+	loggableResourcesPath = "loggable-resources"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
+
+func loggableResourcesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, loggableResourcesPath)
+}
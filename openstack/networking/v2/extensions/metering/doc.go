@@ -0,0 +1,8 @@
+// Package metering contains functionality to work with the metering label and
+// metering label rule Neutron resources.
+//
+// A metering label groups the metering label rules matching the traffic to
+// account for. The layer 3 agents count the bytes and packets of the traffic
+// going through the routers of the project owning the label, which is then
+// reported to the telemetry service for billing.
+package metering
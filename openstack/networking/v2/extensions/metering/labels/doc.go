@@ -0,0 +1,40 @@
+/*
+Package labels manages and retrieves the metering labels of the OpenStack
+Networking Service.
+
+Example to List Metering Labels
+
+	allPages, err := labels.List(networkClient, labels.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLabels, err := labels.ExtractMeteringLabels(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, label := range allLabels {
+		fmt.Printf("%+v\n", label)
+	}
+
+Example to Create a Metering Label
+
+	createOpts := labels.CreateOpts{
+		Name:        "internet",
+		Description: "Traffic to and from the Internet",
+	}
+
+	label, err := labels.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label
+
+	err := labels.Delete(context.TODO(), networkClient, labelID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package labels
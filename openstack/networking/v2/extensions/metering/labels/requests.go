@@ -0,0 +1,113 @@
+package labels
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMeteringLabelListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the metering label attributes you want to see returned.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	Shared      *bool  `q:"shared"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToMeteringLabelListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMeteringLabelListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// metering labels. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToMeteringLabelListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return MeteringLabelPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMeteringLabelCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new metering label.
+type CreateOpts struct {
+	// Name is the human-readable name of the metering label.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the metering label.
+	Description string `json:"description,omitempty"`
+
+	// Shared specifies whether the metering label applies to the routers of
+	// all projects.
+	Shared *bool `json:"shared,omitempty"`
+
+	// TenantID is the project owner of the metering label. Only
+	// administrative users can specify a project ID other than their own.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID is the project owner of the metering label. Only
+	// administrative users can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToMeteringLabelCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToMeteringLabelCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// metering label.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMeteringLabelCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular metering label based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular metering label and its rules
+// based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
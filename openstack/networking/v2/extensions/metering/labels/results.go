@@ -0,0 +1,101 @@
+package labels
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// MeteringLabel represents a group of metering label rules matching the
+// traffic to account for.
+type MeteringLabel struct {
+	// ID is the unique ID of the metering label.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the metering label.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the metering label.
+	Description string `json:"description"`
+
+	// Shared specifies whether the metering label applies to the routers of
+	// all projects.
+	Shared bool `json:"shared"`
+
+	// TenantID is the project owner of the metering label.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the metering label.
+	ProjectID string `json:"project_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a MeteringLabel.
+func (r commonResult) Extract() (*MeteringLabel, error) {
+	var s struct {
+		MeteringLabel *MeteringLabel `json:"metering_label"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MeteringLabel, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a MeteringLabel.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a MeteringLabel.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// MeteringLabelPage is the page returned by a pager when traversing over a
+// collection of metering labels.
+type MeteringLabelPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering labels has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r MeteringLabelPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_labels_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a MeteringLabelPage struct is empty.
+func (r MeteringLabelPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractMeteringLabels(r)
+	return len(is) == 0, err
+}
+
+// ExtractMeteringLabels accepts a Page struct, specifically a
+// MeteringLabelPage struct, and extracts the elements into a slice of
+// MeteringLabel structs.
+func ExtractMeteringLabels(r pagination.Page) ([]MeteringLabel, error) {
+	var s struct {
+		MeteringLabels []MeteringLabel `json:"metering_labels"`
+	}
+	err := (r.(MeteringLabelPage)).ExtractInto(&s)
+	return s.MeteringLabels, err
+}
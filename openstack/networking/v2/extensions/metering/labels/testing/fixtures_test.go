@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const LabelID = "bc91b832-8465-40a7-a5d8-ba87de442266"
+
+const ListResponse = `
+{
+    "metering_labels": [
+        {
+            "id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+            "name": "internet",
+            "description": "Traffic to and from the Internet",
+            "shared": false,
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        }
+    ]
+}
+`
+
+const LabelResponse = `
+{
+    "metering_label": {
+        "id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "name": "internet",
+        "description": "Traffic to and from the Internet",
+        "shared": false,
+        "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+        "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "metering_label": {
+        "name": "internet",
+        "description": "Traffic to and from the Internet",
+        "shared": false
+    }
+}
+`
+
+var LabelInternet = labels.MeteringLabel{
+	ID:          LabelID,
+	Name:        "internet",
+	Description: "Traffic to and from the Internet",
+	TenantID:    "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:   "45345b0ee1ea477fac0f541b2cb79cd4",
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"shared": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, LabelResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels/"+LabelID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, LabelResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels/"+LabelID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	shared := false
+	count := 0
+	err := labels.List(fake.ServiceClient(fakeServer), labels.ListOpts{Shared: &shared}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := labels.ExtractMeteringLabels(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []labels.MeteringLabel{LabelInternet}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	shared := false
+	createOpts := labels.CreateOpts{
+		Name:        "internet",
+		Description: "Traffic to and from the Internet",
+		Shared:      &shared,
+	}
+	actual, err := labels.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LabelInternet, *actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := labels.Get(context.TODO(), fake.ServiceClient(fakeServer), LabelID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LabelInternet, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := labels.Delete(context.TODO(), fake.ServiceClient(fakeServer), LabelID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
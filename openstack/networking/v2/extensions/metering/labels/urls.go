@@ -0,0 +1,13 @@
+package labels
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const rootPath = "metering/metering-labels"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
@@ -0,0 +1,45 @@
+/*
+Package rules manages and retrieves the metering label rules of the OpenStack
+Networking Service.
+
+Example to List the Rules of a Metering Label
+
+	listOpts := rules.ListOpts{
+		MeteringLabelID: labelID,
+	}
+
+	allPages, err := rules.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRules, err := rules.ExtractMeteringLabelRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rule := range allRules {
+		fmt.Printf("%+v\n", rule)
+	}
+
+Example to Create a Metering Label Rule
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     labelID,
+		Direction:           rules.DirEgress,
+		DestinationIPPrefix: "0.0.0.0/0",
+	}
+
+	rule, err := rules.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label Rule
+
+	err := rules.Delete(context.TODO(), networkClient, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package rules
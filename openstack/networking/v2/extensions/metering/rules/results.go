@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// MeteringLabelRule represents the traffic matched by a metering label.
+type MeteringLabelRule struct {
+	// ID is the unique ID of the metering label rule.
+	ID string `json:"id"`
+
+	// MeteringLabelID is the ID of the metering label the rule belongs to.
+	MeteringLabelID string `json:"metering_label_id"`
+
+	// Direction is the direction of the matched traffic.
+	Direction RuleDirection `json:"direction"`
+
+	// Excluded specifies whether the matched traffic is excluded from the
+	// count of the metering label.
+	Excluded bool `json:"excluded"`
+
+	// RemoteIPPrefix is the remote CIDR of the matched traffic.
+	RemoteIPPrefix string `json:"remote_ip_prefix"`
+
+	// SourceIPPrefix is the source CIDR of the matched traffic.
+	SourceIPPrefix string `json:"source_ip_prefix"`
+
+	// DestinationIPPrefix is the destination CIDR of the matched traffic.
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+
+	// TenantID is the project owner of the metering label rule.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the metering label rule.
+	ProjectID string `json:"project_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a
+// MeteringLabelRule.
+func (r commonResult) Extract() (*MeteringLabelRule, error) {
+	var s struct {
+		MeteringLabelRule *MeteringLabelRule `json:"metering_label_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MeteringLabelRule, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a MeteringLabelRule.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a MeteringLabelRule.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// MeteringLabelRulePage is the page returned by a pager when traversing over
+// a collection of metering label rules.
+type MeteringLabelRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering label rules
+// has reached the end of a page and the pager seeks to traverse over a new
+// one. In order to do this, it needs to construct the next page's URL.
+func (r MeteringLabelRulePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_label_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a MeteringLabelRulePage struct is empty.
+func (r MeteringLabelRulePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractMeteringLabelRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractMeteringLabelRules accepts a Page struct, specifically a
+// MeteringLabelRulePage struct, and extracts the elements into a slice of
+// MeteringLabelRule structs.
+func ExtractMeteringLabelRules(r pagination.Page) ([]MeteringLabelRule, error) {
+	var s struct {
+		MeteringLabelRules []MeteringLabelRule `json:"metering_label_rules"`
+	}
+	err := (r.(MeteringLabelRulePage)).ExtractInto(&s)
+	return s.MeteringLabelRules, err
+}
@@ -0,0 +1,2 @@
+// rules unit tests
+package testing
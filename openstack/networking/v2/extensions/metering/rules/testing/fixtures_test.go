@@ -0,0 +1,112 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const (
+	RuleID  = "00e13b58-b4f2-4579-9c9c-7ac94615f9ae"
+	LabelID = "bc91b832-8465-40a7-a5d8-ba87de442266"
+)
+
+const ListResponse = `
+{
+    "metering_label_rules": [
+        {
+            "id": "00e13b58-b4f2-4579-9c9c-7ac94615f9ae",
+            "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+            "direction": "egress",
+            "excluded": false,
+            "remote_ip_prefix": null,
+            "source_ip_prefix": null,
+            "destination_ip_prefix": "0.0.0.0/0",
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        }
+    ]
+}
+`
+
+const RuleResponse = `
+{
+    "metering_label_rule": {
+        "id": "00e13b58-b4f2-4579-9c9c-7ac94615f9ae",
+        "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "direction": "egress",
+        "excluded": false,
+        "remote_ip_prefix": null,
+        "source_ip_prefix": null,
+        "destination_ip_prefix": "0.0.0.0/0",
+        "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+        "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "metering_label_rule": {
+        "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "direction": "egress",
+        "destination_ip_prefix": "0.0.0.0/0"
+    }
+}
+`
+
+var RuleEgress = rules.MeteringLabelRule{
+	ID:                  RuleID,
+	MeteringLabelID:     LabelID,
+	Direction:           rules.DirEgress,
+	DestinationIPPrefix: "0.0.0.0/0",
+	TenantID:            "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:           "45345b0ee1ea477fac0f541b2cb79cd4",
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"metering_label_id": LabelID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, RuleResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules/"+RuleID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, RuleResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules/"+RuleID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	count := 0
+	err := rules.List(fake.ServiceClient(fakeServer), rules.ListOpts{MeteringLabelID: LabelID}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractMeteringLabelRules(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []rules.MeteringLabelRule{RuleEgress}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     LabelID,
+		Direction:           rules.DirEgress,
+		DestinationIPPrefix: "0.0.0.0/0",
+	}
+	actual, err := rules.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, RuleEgress, *actual)
+}
+
+func TestCreateRequiredOpts(t *testing.T) {
+	_, err := rules.CreateOpts{Direction: rules.DirIngress}.ToMeteringLabelRuleCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when MeteringLabelID is not set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := rules.Get(context.TODO(), fake.ServiceClient(fakeServer), RuleID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, RuleEgress, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := rules.Delete(context.TODO(), fake.ServiceClient(fakeServer), RuleID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
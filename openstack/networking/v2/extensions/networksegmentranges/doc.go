@@ -0,0 +1,64 @@
+/*
+Package networksegmentranges manages and retrieves the network segment ranges
+of the OpenStack Networking Service. Network segment ranges let administrators
+manage the segmentation IDs available to tenant networks at runtime, and
+reserve ranges for specific projects.
+
+Example to List Network Segment Ranges
+
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+	}
+
+	allPages, err := networksegmentranges.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRanges, err := networksegmentranges.ExtractNetworkSegmentRanges(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range allRanges {
+		fmt.Printf("%s: %d segmentation IDs available\n", r.Name, len(r.Available))
+	}
+
+Example to Create a Network Segment Range
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "telco-vlans",
+		Shared:          &shared,
+		ProjectID:       "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         199,
+	}
+
+	segmentRange, err := networksegmentranges.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Extend a Network Segment Range
+
+	maximum := 299
+	updateOpts := networksegmentranges.UpdateOpts{
+		Maximum: &maximum,
+	}
+
+	segmentRange, err := networksegmentranges.Update(context.TODO(), networkClient, rangeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Network Segment Range
+
+	err := networksegmentranges.Delete(context.TODO(), networkClient, rangeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package networksegmentranges
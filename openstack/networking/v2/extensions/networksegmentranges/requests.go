@@ -0,0 +1,183 @@
+package networksegmentranges
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNetworkSegmentRangeListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the network segment range attributes you want to see returned.
+type ListOpts struct {
+	ID              string `q:"id"`
+	Name            string `q:"name"`
+	Description     string `q:"description"`
+	ProjectID       string `q:"project_id"`
+	NetworkType     string `q:"network_type"`
+	PhysicalNetwork string `q:"physical_network"`
+	Default         *bool  `q:"default"`
+	Shared          *bool  `q:"shared"`
+	RevisionNumber  *int   `q:"revision_number"`
+	Tags            string `q:"tags"`
+	TagsAny         string `q:"tags-any"`
+	NotTags         string `q:"not-tags"`
+	NotTagsAny      string `q:"not-tags-any"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+}
+
+// ToNetworkSegmentRangeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNetworkSegmentRangeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// network segment ranges. It accepts a ListOpts struct, which allows you to
+// filter and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToNetworkSegmentRangeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkSegmentRangePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNetworkSegmentRangeCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new network segment
+// range.
+type CreateOpts struct {
+	// Name is the human-readable name of the range.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the range.
+	Description string `json:"description,omitempty"`
+
+	// Shared specifies whether the range is available to all projects. A
+	// range that is not shared must have a ProjectID.
+	Shared *bool `json:"shared,omitempty"`
+
+	// ProjectID is the project the range is reserved for.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// NetworkType is the type of the segments of the range: vlan, vxlan,
+	// gre or geneve.
+	NetworkType string `json:"network_type" required:"true"`
+
+	// PhysicalNetwork is the physical network of the segments of a vlan
+	// range.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+
+	// Minimum is the lowest segmentation ID of the range.
+	Minimum int `json:"minimum" required:"true"`
+
+	// Maximum is the highest segmentation ID of the range.
+	Maximum int `json:"maximum" required:"true"`
+}
+
+// ToNetworkSegmentRangeCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToNetworkSegmentRangeCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// network segment range.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNetworkSegmentRangeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular network segment range based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNetworkSegmentRangeUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a network segment range.
+// The type, physical network and ownership of a range can't be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Minimum     *int    `json:"minimum,omitempty"`
+	Maximum     *int    `json:"maximum,omitempty"`
+
+	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
+	// will set revision_number=%s. If the revision number does not match, the
+	// update will fail.
+	RevisionNumber *int `json:"-" h:"If-Match"`
+}
+
+// ToNetworkSegmentRangeUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToNetworkSegmentRangeUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Update allows network segment ranges to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNetworkSegmentRangeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	for k := range h {
+		if k == "If-Match" {
+			h[k] = fmt.Sprintf("revision_number=%s", h[k])
+		}
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
+	return
+}
+
+// Delete will permanently delete a particular network segment range based on
+// its ID. The default ranges can't be deleted.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
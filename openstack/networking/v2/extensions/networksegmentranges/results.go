@@ -0,0 +1,140 @@
+package networksegmentranges
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// NetworkSegmentRange represents a range of segmentation IDs available to
+// the tenant networks of a network type.
+type NetworkSegmentRange struct {
+	// ID is the unique ID of the range.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the range.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the range.
+	Description string `json:"description"`
+
+	// Default specifies whether the range is loaded from the configuration
+	// of the Networking service.
+	Default bool `json:"default"`
+
+	// Shared specifies whether the range is available to all projects.
+	Shared bool `json:"shared"`
+
+	// ProjectID is the project the range is reserved for.
+	ProjectID string `json:"project_id"`
+
+	// NetworkType is the type of the segments of the range.
+	NetworkType string `json:"network_type"`
+
+	// PhysicalNetwork is the physical network of the segments of the range.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// Minimum is the lowest segmentation ID of the range.
+	Minimum int `json:"minimum"`
+
+	// Maximum is the highest segmentation ID of the range.
+	Maximum int `json:"maximum"`
+
+	// Used maps the segmentation IDs in use to the project using them.
+	Used map[string]string `json:"used"`
+
+	// Available lists the segmentation IDs still available in the range.
+	Available []int `json:"available"`
+
+	// RevisionNumber is the revision number of the range.
+	RevisionNumber int `json:"revision_number"`
+
+	// Tags optionally set via extensions/attributestags.
+	Tags []string `json:"tags"`
+
+	// CreatedAt is the time at which the range was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time at which the range was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a
+// NetworkSegmentRange.
+func (r commonResult) Extract() (*NetworkSegmentRange, error) {
+	var s struct {
+		NetworkSegmentRange *NetworkSegmentRange `json:"network_segment_range"`
+	}
+	err := r.ExtractInto(&s)
+	return s.NetworkSegmentRange, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NetworkSegmentRangePage is the page returned by a pager when traversing
+// over a collection of network segment ranges.
+type NetworkSegmentRangePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of network segment
+// ranges has reached the end of a page and the pager seeks to traverse over a
+// new one. In order to do this, it needs to construct the next page's URL.
+func (r NetworkSegmentRangePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"network_segment_ranges_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a NetworkSegmentRangePage struct is empty.
+func (r NetworkSegmentRangePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractNetworkSegmentRanges(r)
+	return len(is) == 0, err
+}
+
+// ExtractNetworkSegmentRanges accepts a Page struct, specifically a
+// NetworkSegmentRangePage struct, and extracts the elements into a slice of
+// NetworkSegmentRange structs.
+func ExtractNetworkSegmentRanges(r pagination.Page) ([]NetworkSegmentRange, error) {
+	var s struct {
+		NetworkSegmentRanges []NetworkSegmentRange `json:"network_segment_ranges"`
+	}
+	err := (r.(NetworkSegmentRangePage)).ExtractInto(&s)
+	return s.NetworkSegmentRanges, err
+}
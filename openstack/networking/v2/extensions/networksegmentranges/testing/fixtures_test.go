@@ -0,0 +1,159 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const RangeID = "7c0c4d8e-2f3a-4b5c-9d6e-1f2a3b4c5d6e"
+
+const ListResponse = `
+{
+    "network_segment_ranges": [
+        {
+            "id": "7c0c4d8e-2f3a-4b5c-9d6e-1f2a3b4c5d6e",
+            "name": "telco-vlans",
+            "description": "",
+            "default": false,
+            "shared": false,
+            "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+            "network_type": "vlan",
+            "physical_network": "physnet1",
+            "minimum": 100,
+            "maximum": 103,
+            "used": {
+                "100": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c"
+            },
+            "available": [101, 102, 103],
+            "revision_number": 1,
+            "tags": ["telco"],
+            "created_at": "2024-03-14T10:11:12Z",
+            "updated_at": "2024-03-14T10:11:12Z"
+        }
+    ]
+}
+`
+
+const RangeResponse = `
+{
+    "network_segment_range": {
+        "id": "7c0c4d8e-2f3a-4b5c-9d6e-1f2a3b4c5d6e",
+        "name": "telco-vlans",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103,
+        "used": {
+            "100": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c"
+        },
+        "available": [101, 102, 103],
+        "revision_number": 1,
+        "tags": ["telco"],
+        "created_at": "2024-03-14T10:11:12Z",
+        "updated_at": "2024-03-14T10:11:12Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "network_segment_range": {
+        "name": "telco-vlans",
+        "shared": false,
+        "project_id": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "network_segment_range": {
+        "maximum": 103
+    }
+}
+`
+
+var RangeTelco = networksegmentranges.NetworkSegmentRange{
+	ID:              RangeID,
+	Name:            "telco-vlans",
+	ProjectID:       "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet1",
+	Minimum:         100,
+	Maximum:         103,
+	Used:            map[string]string{"100": "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c"},
+	Available:       []int{101, 102, 103},
+	RevisionNumber:  1,
+	Tags:            []string{"telco"},
+	CreatedAt:       time.Date(2024, 3, 14, 10, 11, 12, 0, time.UTC),
+	UpdatedAt:       time.Date(2024, 3, 14, 10, 11, 12, 0, time.UTC),
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"network_type": "vlan", "shared": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, RangeResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, RangeResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, RangeResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
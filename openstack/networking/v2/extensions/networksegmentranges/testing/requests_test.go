@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	shared := false
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+		Shared:      &shared,
+	}
+
+	count := 0
+	err := networksegmentranges.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := networksegmentranges.ExtractNetworkSegmentRanges(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []networksegmentranges.NetworkSegmentRange{RangeTelco}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "telco-vlans",
+		Shared:          &shared,
+		ProjectID:       "a0c2ad6e1ae04dcb9e2a3a4e8f6a7b5c",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         103,
+	}
+	actual, err := networksegmentranges.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, RangeTelco, *actual)
+}
+
+func TestCreateRequiredOpts(t *testing.T) {
+	_, err := networksegmentranges.CreateOpts{Minimum: 100, Maximum: 103}.ToNetworkSegmentRangeCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when NetworkType is not set")
+	}
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := networksegmentranges.Get(context.TODO(), fake.ServiceClient(fakeServer), RangeID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, RangeTelco, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	maximum := 103
+	actual, err := networksegmentranges.Update(context.TODO(), fake.ServiceClient(fakeServer), RangeID, networksegmentranges.UpdateOpts{Maximum: &maximum}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, RangeTelco, *actual)
+}
+
+func TestUpdateRevision(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "If-Match", "revision_number=42")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, err := w.Write([]byte(`{"NeutronError": {"type": "RevisionNumberConstraintFailed", "message": "Constrained to 42, but current revision is 43", "detail": ""}}`))
+		th.AssertNoErr(t, err)
+	})
+
+	maximum := 103
+	revisionNumber := 42
+	updateOpts := networksegmentranges.UpdateOpts{
+		Maximum:        &maximum,
+		RevisionNumber: &revisionNumber,
+	}
+	_, err := networksegmentranges.Update(context.TODO(), fake.ServiceClient(fakeServer), RangeID, updateOpts).Extract()
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 42, conflict.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := networksegmentranges.Delete(context.TODO(), fake.ServiceClient(fakeServer), RangeID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
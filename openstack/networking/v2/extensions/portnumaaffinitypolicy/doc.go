@@ -0,0 +1,25 @@
+/*
+Package portnumaaffinitypolicy provides information and interaction with the
+port NUMA affinity policy extension for the OpenStack Networking service.
+
+Example to Create a Port with a NUMA Affinity Policy
+
+	portCreateOpts := ports.CreateOpts{
+		NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+	}
+
+	createOpts := portnumaaffinitypolicy.CreateOptsExt{
+		CreateOptsBuilder:  portCreateOpts,
+		NUMAAffinityPolicy: portnumaaffinitypolicy.Required,
+	}
+
+	var port struct {
+		ports.Port
+		portnumaaffinitypolicy.PortNUMAAffinityPolicyExt
+	}
+	err := ports.Create(context.TODO(), networkClient, createOpts).ExtractInto(&port)
+	if err != nil {
+		panic(err)
+	}
+*/
+package portnumaaffinitypolicy
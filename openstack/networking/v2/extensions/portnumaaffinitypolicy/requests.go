@@ -0,0 +1,84 @@
+package portnumaaffinitypolicy
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+)
+
+// NUMAAffinityPolicy is the NUMA affinity policy of a port, which overrides
+// the one of the flavor and image of the instance it is bound to.
+type NUMAAffinityPolicy string
+
+const (
+	// Required only schedules the instance on hosts where the port's
+	// device is affined to the instance's NUMA nodes.
+	Required NUMAAffinityPolicy = "required"
+
+	// Preferred favors hosts where the port's device is affined to the
+	// instance's NUMA nodes.
+	Preferred NUMAAffinityPolicy = "preferred"
+
+	// Legacy requires NUMA affinity for the device when the host reports
+	// it, and ignores it otherwise.
+	Legacy NUMAAffinityPolicy = "legacy"
+
+	// Socket requires the device to be affined to the same socket as one of
+	// the instance's NUMA nodes.
+	Socket NUMAAffinityPolicy = "socket"
+)
+
+// CreateOptsExt adds the NUMA affinity policy to the base ports.CreateOpts.
+type CreateOptsExt struct {
+	// CreateOptsBuilder is the interface options structs have to satisfy in order
+	// to be used in the main Create operation in this package.
+	ports.CreateOptsBuilder
+
+	// NUMAAffinityPolicy is the NUMA affinity policy of the port.
+	NUMAAffinityPolicy NUMAAffinityPolicy `json:"numa_affinity_policy,omitempty"`
+}
+
+// ToPortCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOptsExt) ToPortCreateMap() (map[string]any, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]any)
+
+	if opts.NUMAAffinityPolicy != "" {
+		port["numa_affinity_policy"] = opts.NUMAAffinityPolicy
+	}
+
+	return base, nil
+}
+
+// UpdateOptsExt adds the NUMA affinity policy to the base ports.UpdateOpts.
+type UpdateOptsExt struct {
+	// UpdateOptsBuilder is the interface options structs have to satisfy in order
+	// to be used in the main Update operation in this package.
+	ports.UpdateOptsBuilder
+
+	// NUMAAffinityPolicy is the NUMA affinity policy of the port. Set it to
+	// a pointer to an empty string to clear the policy.
+	NUMAAffinityPolicy *NUMAAffinityPolicy `json:"numa_affinity_policy,omitempty"`
+}
+
+// ToPortUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOptsExt) ToPortUpdateMap() (map[string]any, error) {
+	base, err := opts.UpdateOptsBuilder.ToPortUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]any)
+
+	if opts.NUMAAffinityPolicy != nil {
+		if *opts.NUMAAffinityPolicy == "" {
+			port["numa_affinity_policy"] = nil
+		} else {
+			port["numa_affinity_policy"] = *opts.NUMAAffinityPolicy
+		}
+	}
+
+	return base, nil
+}
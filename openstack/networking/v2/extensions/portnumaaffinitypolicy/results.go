@@ -0,0 +1,9 @@
+package portnumaaffinitypolicy
+
+// PortNUMAAffinityPolicyExt represents a decorated form of a Port with the
+// additional NUMA affinity policy information.
+type PortNUMAAffinityPolicyExt struct {
+	// NUMAAffinityPolicy is the NUMA affinity policy of the port. It is
+	// empty when the policy of the instance applies.
+	NUMAAffinityPolicy NUMAAffinityPolicy `json:"numa_affinity_policy"`
+}
@@ -0,0 +1,2 @@
+// portnumaaffinitypolicy unit tests
+package testing
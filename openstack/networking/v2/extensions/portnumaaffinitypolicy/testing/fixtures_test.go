@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const PortID = "65c0ee9f-d634-4522-8954-51021b570b0d"
+
+const CreateRequest = `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "numa_affinity_policy": "required"
+    }
+}
+`
+
+const CreateResponse = `
+{
+    "port": {
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "status": "DOWN",
+        "admin_state_up": true,
+        "numa_affinity_policy": "required"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port": {
+        "numa_affinity_policy": null
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port": {
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "status": "DOWN",
+        "admin_state_up": true,
+        "numa_affinity_policy": null
+    }
+}
+`
+
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/ports/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
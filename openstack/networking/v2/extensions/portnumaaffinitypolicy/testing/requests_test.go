@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portnumaaffinitypolicy"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+type PortWithExt struct {
+	ports.Port
+	portnumaaffinitypolicy.PortNUMAAffinityPolicyExt
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := portnumaaffinitypolicy.CreateOptsExt{
+		CreateOptsBuilder: ports.CreateOpts{
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		NUMAAffinityPolicy: portnumaaffinitypolicy.Required,
+	}
+
+	var actual PortWithExt
+	err := ports.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).ExtractInto(&actual)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, PortID, actual.ID)
+	th.AssertEquals(t, portnumaaffinitypolicy.Required, actual.NUMAAffinityPolicy)
+}
+
+func TestUpdateClearsPolicy(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	policy := portnumaaffinitypolicy.NUMAAffinityPolicy("")
+	updateOpts := portnumaaffinitypolicy.UpdateOptsExt{
+		UpdateOptsBuilder:  ports.UpdateOpts{},
+		NUMAAffinityPolicy: &policy,
+	}
+
+	var actual PortWithExt
+	err := ports.Update(context.TODO(), fake.ServiceClient(fakeServer), PortID, updateOpts).ExtractInto(&actual)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, PortID, actual.ID)
+	th.AssertEquals(t, portnumaaffinitypolicy.NUMAAffinityPolicy(""), actual.NUMAAffinityPolicy)
+}
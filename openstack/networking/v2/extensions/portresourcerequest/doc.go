@@ -0,0 +1,23 @@
+/*
+Package portresourcerequest provides information about the resources a port
+requests from the Placement service, such as minimum bandwidth or packet rate
+guarantees set by its QoS policy.
+
+Example to Get the Resource Request of a Port
+
+	var port struct {
+		ports.Port
+		portresourcerequest.PortResourceRequestExt
+	}
+	err := ports.Get(context.TODO(), networkClient, portID).ExtractInto(&port)
+	if err != nil {
+		panic(err)
+	}
+
+	if port.ResourceRequest != nil {
+		for _, group := range port.ResourceRequest.RequestGroups {
+			fmt.Printf("%s: %v %v\n", group.ID, group.Required, group.Resources)
+		}
+	}
+*/
+package portresourcerequest
@@ -0,0 +1,43 @@
+package portresourcerequest
+
+// PortResourceRequestExt represents a decorated form of a Port with the
+// additional resource request information. The resource request is only
+// visible to administrative users.
+type PortResourceRequestExt struct {
+	// ResourceRequest is the request of the port to the Placement service.
+	// It is nil when the port doesn't request any resources.
+	ResourceRequest *ResourceRequest `json:"resource_request"`
+}
+
+// ResourceRequest is the set of resources and traits a port requests from
+// the Placement service.
+type ResourceRequest struct {
+	// RequestGroups are the request groups of the port. They are set by the
+	// port-resource-request-groups extension.
+	RequestGroups []RequestGroup `json:"request_groups"`
+
+	// SameSubtree lists the IDs of the request groups which must be
+	// fulfilled by resource providers of the same subtree.
+	SameSubtree []string `json:"same_subtree"`
+
+	// Required are the traits the resource provider must have. It is only
+	// set by the original port-resource-request extension.
+	Required []string `json:"required"`
+
+	// Resources maps the requested resource classes to their amount. It is
+	// only set by the original port-resource-request extension.
+	Resources map[string]int `json:"resources"`
+}
+
+// RequestGroup is a group of resources and traits which must be fulfilled
+// by a single resource provider.
+type RequestGroup struct {
+	// ID is the unique ID of the request group.
+	ID string `json:"id"`
+
+	// Required are the traits the resource provider must have.
+	Required []string `json:"required"`
+
+	// Resources maps the requested resource classes to their amount.
+	Resources map[string]int `json:"resources"`
+}
@@ -0,0 +1,2 @@
+// portresourcerequest unit tests
+package testing
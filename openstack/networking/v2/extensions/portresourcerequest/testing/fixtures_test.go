@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const PortID = "65c0ee9f-d634-4522-8954-51021b570b0d"
+
+const GetResponse = `
+{
+    "port": {
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "status": "ACTIVE",
+        "admin_state_up": true,
+        "resource_request": {
+            "request_groups": [
+                {
+                    "id": "9b3c7f1e-6b2a-5c4d-8e9f-0a1b2c3d4e5f",
+                    "required": ["CUSTOM_PHYSNET_PHYSNET1", "CUSTOM_VNIC_TYPE_NORMAL"],
+                    "resources": {
+                        "NET_BW_EGR_KILOBIT_PER_SEC": 1000,
+                        "NET_BW_IGR_KILOBIT_PER_SEC": 1000
+                    }
+                },
+                {
+                    "id": "1d2e3f4a-5b6c-5d7e-8f9a-0b1c2d3e4f5a",
+                    "required": ["CUSTOM_VNIC_TYPE_NORMAL"],
+                    "resources": {
+                        "NET_PACKET_RATE_KILOPACKET_PER_SEC": 100
+                    }
+                }
+            ],
+            "same_subtree": [
+                "9b3c7f1e-6b2a-5c4d-8e9f-0a1b2c3d4e5f",
+                "1d2e3f4a-5b6c-5d7e-8f9a-0b1c2d3e4f5a"
+            ]
+        }
+    }
+}
+`
+
+const GetResponseNoRequest = `
+{
+    "port": {
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "status": "ACTIVE",
+        "admin_state_up": true,
+        "resource_request": null
+    }
+}
+`
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer, response string) {
+	fakeServer.Mux.HandleFunc("/v2.0/ports/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, response)
+	})
+}
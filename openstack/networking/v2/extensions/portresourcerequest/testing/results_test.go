@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portresourcerequest"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+type PortWithExt struct {
+	ports.Port
+	portresourcerequest.PortResourceRequestExt
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer, GetResponse)
+
+	var actual PortWithExt
+	err := ports.Get(context.TODO(), fake.ServiceClient(fakeServer), PortID).ExtractInto(&actual)
+	th.AssertNoErr(t, err)
+
+	expected := &portresourcerequest.ResourceRequest{
+		RequestGroups: []portresourcerequest.RequestGroup{
+			{
+				ID:       "9b3c7f1e-6b2a-5c4d-8e9f-0a1b2c3d4e5f",
+				Required: []string{"CUSTOM_PHYSNET_PHYSNET1", "CUSTOM_VNIC_TYPE_NORMAL"},
+				Resources: map[string]int{
+					"NET_BW_EGR_KILOBIT_PER_SEC": 1000,
+					"NET_BW_IGR_KILOBIT_PER_SEC": 1000,
+				},
+			},
+			{
+				ID:       "1d2e3f4a-5b6c-5d7e-8f9a-0b1c2d3e4f5a",
+				Required: []string{"CUSTOM_VNIC_TYPE_NORMAL"},
+				Resources: map[string]int{
+					"NET_PACKET_RATE_KILOPACKET_PER_SEC": 100,
+				},
+			},
+		},
+		SameSubtree: []string{
+			"9b3c7f1e-6b2a-5c4d-8e9f-0a1b2c3d4e5f",
+			"1d2e3f4a-5b6c-5d7e-8f9a-0b1c2d3e4f5a",
+		},
+	}
+
+	th.AssertEquals(t, PortID, actual.ID)
+	th.CheckDeepEquals(t, expected, actual.ResourceRequest)
+}
+
+func TestGetNoResourceRequest(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer, GetResponseNoRequest)
+
+	var actual PortWithExt
+	err := ports.Get(context.TODO(), fake.ServiceClient(fakeServer), PortID).ExtractInto(&actual)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, PortID, actual.ID)
+	if actual.ResourceRequest != nil {
+		t.Fatalf("expected no resource request, got %+v", actual.ResourceRequest)
+	}
+}
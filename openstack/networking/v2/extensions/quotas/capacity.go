@@ -0,0 +1,272 @@
+package quotas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networkipavailabilities"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// DefaultConcurrency is the number of projects whose quota details are
+// retrieved concurrently by GetCapacityReport when
+// CapacityReportOpts.Concurrency is not set.
+const DefaultConcurrency = 4
+
+// CapacityReportOpts represents the options of a capacity report.
+type CapacityReportOpts struct {
+	// ProjectIDs are the projects to report on. When it is empty, the report
+	// covers the projects returned by List, which only includes the projects
+	// not using the default quotas: pass the projects of the Identity service
+	// to cover them all.
+	ProjectIDs []string
+
+	// Concurrency is the number of projects whose quota details are retrieved
+	// concurrently. Defaults to DefaultConcurrency.
+	Concurrency int
+}
+
+// ProjectCapacity represents the quota usage and the IP availability of the
+// networks of a project.
+type ProjectCapacity struct {
+	// ProjectID is the ID of the project.
+	ProjectID string
+
+	// Quota contains the usage, the reservations and the limit of the quotas
+	// of the project.
+	Quota QuotaDetailSet
+
+	// Networks contains the IP availability of the networks owned by the
+	// project.
+	Networks []networkipavailabilities.NetworkIPAvailability
+}
+
+// SubnetPoolCapacity represents the consumption of the prefixes of a subnet
+// pool by the subnets allocated from it.
+type SubnetPoolCapacity struct {
+	// SubnetPool is the subnet pool.
+	SubnetPool subnetpools.SubnetPool
+
+	// TotalAddresses is the number of addresses in the prefixes of the pool.
+	TotalAddresses string
+
+	// UsedAddresses is the number of addresses in the subnets allocated from
+	// the pool.
+	UsedAddresses string
+
+	// UsedAddressesByProject is the number of addresses in the subnets
+	// allocated from the pool, by project ID.
+	UsedAddressesByProject map[string]string
+}
+
+// CapacityReport combines the quota details of projects, the consumption of
+// the subnet pools and the IP availability of the networks.
+type CapacityReport struct {
+	// Projects contains the capacity of every project, in the order of
+	// CapacityReportOpts.ProjectIDs.
+	Projects []ProjectCapacity
+
+	// SubnetPools contains the consumption of every subnet pool.
+	SubnetPools []SubnetPoolCapacity
+}
+
+// GetCapacityReport retrieves the quota details of every project, with
+// opts.Concurrency requests in flight at most, along with the consumption of
+// the subnet pools and the IP availability of the networks. It requires
+// administrative privileges to cover the resources of other projects.
+//
+// It stops at the first error.
+func GetCapacityReport(ctx context.Context, c *gophercloud.ServiceClient, opts CapacityReportOpts) (*CapacityReport, error) {
+	projectIDs := opts.ProjectIDs
+	if len(projectIDs) == 0 {
+		allPages, err := List(c).AllPages(ctx)
+		if err != nil {
+			return nil, err
+		}
+		allQuotas, err := ExtractQuotas(allPages)
+		if err != nil {
+			return nil, err
+		}
+		for _, q := range allQuotas {
+			projectIDs = append(projectIDs, q.ProjectID)
+		}
+	}
+
+	report := &CapacityReport{
+		Projects: make([]ProjectCapacity, len(projectIDs)),
+	}
+
+	if err := getQuotaDetails(ctx, c, projectIDs, opts.Concurrency, report.Projects); err != nil {
+		return nil, err
+	}
+
+	allPages, err := networkipavailabilities.List(c, nil).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	availabilities, err := networkipavailabilities.ExtractNetworkIPAvailabilities(allPages)
+	if err != nil {
+		return nil, err
+	}
+	projects := make(map[string]*ProjectCapacity, len(report.Projects))
+	for i := range report.Projects {
+		projects[report.Projects[i].ProjectID] = &report.Projects[i]
+	}
+	for _, availability := range availabilities {
+		if p, ok := projects[availability.ProjectID]; ok {
+			p.Networks = append(p.Networks, availability)
+		}
+	}
+
+	report.SubnetPools, err = getSubnetPoolCapacities(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// getQuotaDetails retrieves the quota details of the projects concurrently
+// into capacities, and stops at the first error.
+func getQuotaDetails(ctx context.Context, c *gophercloud.ServiceClient, projectIDs []string, concurrency int, capacities []ProjectCapacity) error {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	queue := make(chan int)
+	for range min(concurrency, len(projectIDs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				quota, err := GetDetail(ctx, c, projectIDs[i]).Extract()
+				if err != nil {
+					errOnce.Do(func() { firstErr = fmt.Errorf("getting quota details of project %s: %w", projectIDs[i], err) })
+					cancel()
+					continue
+				}
+				capacities[i] = ProjectCapacity{
+					ProjectID: projectIDs[i],
+					Quota:     *quota,
+				}
+			}
+		}()
+	}
+
+enqueue:
+	for i := range projectIDs {
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			break enqueue
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return firstErr
+}
+
+// getSubnetPoolCapacities computes the consumption of the subnet pools by
+// the subnets allocated from them.
+func getSubnetPoolCapacities(ctx context.Context, c *gophercloud.ServiceClient) ([]SubnetPoolCapacity, error) {
+	allPages, err := subnetpools.List(c, nil).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allSubnetPools, err := subnetpools.ExtractSubnetPools(allPages)
+	if err != nil {
+		return nil, err
+	}
+	if len(allSubnetPools) == 0 {
+		return nil, nil
+	}
+
+	allPages, err = subnets.List(c, nil).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	type usage struct {
+		used      *big.Int
+		byProject map[string]*big.Int
+	}
+	usages := make(map[string]*usage, len(allSubnetPools))
+	for _, subnet := range allSubnets {
+		if subnet.SubnetPoolID == "" {
+			continue
+		}
+		size, err := prefixSize(subnet.CIDR)
+		if err != nil {
+			return nil, err
+		}
+
+		u, ok := usages[subnet.SubnetPoolID]
+		if !ok {
+			u = &usage{used: new(big.Int), byProject: make(map[string]*big.Int)}
+			usages[subnet.SubnetPoolID] = u
+		}
+		u.used.Add(u.used, size)
+		if u.byProject[subnet.ProjectID] == nil {
+			u.byProject[subnet.ProjectID] = new(big.Int)
+		}
+		u.byProject[subnet.ProjectID].Add(u.byProject[subnet.ProjectID], size)
+	}
+
+	capacities := make([]SubnetPoolCapacity, len(allSubnetPools))
+	for i, pool := range allSubnetPools {
+		total := new(big.Int)
+		for _, prefix := range pool.Prefixes {
+			size, err := prefixSize(prefix)
+			if err != nil {
+				return nil, err
+			}
+			total.Add(total, size)
+		}
+
+		capacities[i] = SubnetPoolCapacity{
+			SubnetPool:             pool,
+			TotalAddresses:         total.String(),
+			UsedAddresses:          "0",
+			UsedAddressesByProject: make(map[string]string),
+		}
+		if u, ok := usages[pool.ID]; ok {
+			capacities[i].UsedAddresses = u.used.String()
+			for projectID, used := range u.byProject {
+				capacities[i].UsedAddressesByProject[projectID] = used.String()
+			}
+		}
+	}
+
+	return capacities, nil
+}
+
+// prefixSize returns the number of addresses in a CIDR.
+func prefixSize(cidr string) (*big.Int, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	ones, bits := ipNet.Mask.Size()
+	return new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)), nil
+}
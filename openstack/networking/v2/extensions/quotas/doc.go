@@ -53,5 +53,34 @@ Example to Delete project quotas
 	}
 
 	fmt.Printf("Deleted quotas for project: %s\n", projectID)
+
+Example to Get the default quotas of a project
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	quotasInfo, err := quotas.GetDefault(context.TODO(), networkClient, projectID).Extract()
+	if err != nil {
+	    log.Fatal(err)
+	}
+
+	fmt.Printf("default quotas: %#v\n", quotasInfo)
+
+Example to report the capacity of projects
+
+	opts := quotas.CapacityReportOpts{
+	    ProjectIDs:  []string{"23d5d3f79dfa4f73b72b8b0b0063ec55", "0a73845280574ad389c292f6a74afa76"},
+	    Concurrency: 8,
+	}
+	report, err := quotas.GetCapacityReport(context.TODO(), networkClient, opts)
+	if err != nil {
+	    log.Fatal(err)
+	}
+
+	for _, project := range report.Projects {
+	    fmt.Printf("%s: %d/%d ports\n", project.ProjectID, project.Quota.Port.Used, project.Quota.Port.Limit)
+	}
+
+	for _, pool := range report.SubnetPools {
+	    fmt.Printf("%s: %s/%s addresses\n", pool.SubnetPool.Name, pool.UsedAddresses, pool.TotalAddresses)
+	}
 */
 package quotas
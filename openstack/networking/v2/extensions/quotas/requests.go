@@ -4,8 +4,17 @@ import (
 	"context"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
+// List returns a Pager which allows you to iterate over the Networking Quotas
+// of the projects which don't use the default quotas.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return QuotaPage{pagination.SinglePageBase(r)}
+	})
+}
+
 // Get returns Networking Quotas for a project.
 func Get(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r GetResult) {
 	resp, err := client.Get(ctx, getURL(client, projectID), &r.Body, nil)
@@ -20,6 +29,13 @@ func GetDetail(ctx context.Context, client *gophercloud.ServiceClient, projectID
 	return
 }
 
+// GetDefault returns the default Networking Quotas applied to a project.
+func GetDefault(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r GetDefaultResult) {
+	resp, err := client.Get(ctx, getDefaultURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
 type commonResult struct {
@@ -46,6 +47,12 @@ type GetDetailResult struct {
 	detailResult
 }
 
+// GetDefaultResult represents the result of a get default operation. Call
+// its Extract method to interpret it as a Quota.
+type GetDefaultResult struct {
+	commonResult
+}
+
 // UpdateResult represents the result of an update operation. Call its Extract
 // method to interpret it as a Quota.
 type UpdateResult struct {
@@ -83,6 +90,14 @@ type Quota struct {
 
 	// Trunk represents a number of trunks. A "-1" value means no limit.
 	Trunk int `json:"trunk"`
+
+	// ProjectID is the ID of the project the quotas apply to. It is only set
+	// by List.
+	ProjectID string `json:"project_id"`
+
+	// TenantID is the ID of the project the quotas apply to. It is only set
+	// by List.
+	TenantID string `json:"tenant_id"`
 }
 
 // QuotaDetailSet represents details of both operational limits of Networking resources for a project
@@ -172,6 +187,32 @@ func (q *QuotaDetail) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// QuotaPage is the page returned by a pager when traversing over the quotas
+// of the projects.
+type QuotaPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a QuotaPage struct is empty.
+func (r QuotaPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	quotas, err := ExtractQuotas(r)
+	return len(quotas) == 0, err
+}
+
+// ExtractQuotas accepts a Page struct, specifically a QuotaPage struct, and
+// extracts the elements into a slice of Quota structs.
+func ExtractQuotas(r pagination.Page) ([]Quota, error) {
+	var s struct {
+		Quotas []Quota `json:"quotas"`
+	}
+	err := (r.(QuotaPage)).ExtractInto(&s)
+	return s.Quotas, err
+}
+
 // DeleteResult is the response from a Delete operation. Call its ExtractErr
 // method to determine if the request succeeded or failed.
 type DeleteResult struct {
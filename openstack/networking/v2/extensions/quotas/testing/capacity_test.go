@@ -0,0 +1,144 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+// HandleCapacityReport handles the requests of a capacity report. The quota
+// details of failingProjectID fail, and the maximum number of quota details
+// requests in flight is recorded in maxInFlight.
+func HandleCapacityReport(t *testing.T, fakeServer th.FakeServer, failingProjectID string, maxInFlight *int) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+	)
+
+	handleJSON := func(path, body string) {
+		fakeServer.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, body)
+		})
+	}
+	handleJSON("/v2.0/quotas", ListResponseRaw)
+	handleJSON("/v2.0/network-ip-availabilities", NetworkIPAvailabilitiesResponseRaw)
+	handleJSON("/v2.0/subnetpools", SubnetPoolsResponseRaw)
+	handleJSON("/v2.0/subnets", SubnetsResponseRaw)
+
+	fakeServer.Mux.HandleFunc("/v2.0/quotas/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		mu.Lock()
+		inFlight++
+		*maxInFlight = max(*maxInFlight, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		projectID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v2.0/quotas/"), "/details.json")
+		if projectID == failingProjectID {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetDetailedResponseRaw)
+	})
+}
+
+func TestGetCapacityReport(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var maxInFlight int
+	HandleCapacityReport(t, fakeServer, "", &maxInFlight)
+
+	projectIDs := []string{
+		"0a73845280574ad389c292f6a74afa76",
+		"9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3",
+		"fb57277ef2f84a0e85b9018ec2dedbf7",
+		"5e2b1b1fd0f44f1c8f6bd2c9a1d9d6a1",
+		"c3c1fbda8d3147b4a6b2c7e1a4e3b0d2",
+	}
+	report, err := quotas.GetCapacityReport(context.TODO(), fake.ServiceClient(fakeServer), quotas.CapacityReportOpts{
+		ProjectIDs:  projectIDs,
+		Concurrency: 2,
+	})
+	th.AssertNoErr(t, err)
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+
+	th.AssertEquals(t, len(projectIDs), len(report.Projects))
+	for i, p := range report.Projects {
+		th.AssertEquals(t, projectIDs[i], p.ProjectID)
+		th.CheckDeepEquals(t, GetDetailResponse, p.Quota)
+	}
+	th.AssertEquals(t, 1, len(report.Projects[0].Networks))
+	th.AssertEquals(t, "080ee064-036d-405a-a307-3bde4a213a1b", report.Projects[0].Networks[0].NetworkID)
+	th.AssertEquals(t, 0, len(report.Projects[1].Networks))
+	th.AssertEquals(t, 1, len(report.Projects[2].Networks))
+
+	th.AssertEquals(t, 2, len(report.SubnetPools))
+	poolV4 := report.SubnetPools[0]
+	th.AssertEquals(t, "pool-v4", poolV4.SubnetPool.Name)
+	th.AssertEquals(t, "65792", poolV4.TotalAddresses)
+	th.AssertEquals(t, "320", poolV4.UsedAddresses)
+	th.CheckDeepEquals(t, map[string]string{
+		"0a73845280574ad389c292f6a74afa76": "256",
+		"9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3": "64",
+	}, poolV4.UsedAddressesByProject)
+
+	poolV6 := report.SubnetPools[1]
+	th.AssertEquals(t, "1208925819614629174706176", poolV6.TotalAddresses)
+	th.AssertEquals(t, "0", poolV6.UsedAddresses)
+	th.CheckDeepEquals(t, map[string]string{}, poolV6.UsedAddressesByProject)
+}
+
+func TestGetCapacityReportListedProjects(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var maxInFlight int
+	HandleCapacityReport(t, fakeServer, "", &maxInFlight)
+
+	report, err := quotas.GetCapacityReport(context.TODO(), fake.ServiceClient(fakeServer), quotas.CapacityReportOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(report.Projects))
+	th.AssertEquals(t, "0a73845280574ad389c292f6a74afa76", report.Projects[0].ProjectID)
+	th.AssertEquals(t, "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3", report.Projects[1].ProjectID)
+}
+
+func TestGetCapacityReportError(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var maxInFlight int
+	HandleCapacityReport(t, fakeServer, "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3", &maxInFlight)
+
+	_, err := quotas.GetCapacityReport(context.TODO(), fake.ServiceClient(fakeServer), quotas.CapacityReportOpts{})
+	if err == nil || !strings.Contains(err.Error(), "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3") {
+		t.Fatalf("expected the quota details of 9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3 to fail, got %v", err)
+	}
+}
@@ -154,3 +154,146 @@ func MockDeleteResponse(t *testing.T, fakeServer th.FakeServer) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+const ListResponseRaw = `
+{
+    "quotas": [
+        {
+            "floatingip": 15,
+            "network": 20,
+            "port": 25,
+            "rbac_policy": -1,
+            "router": 30,
+            "security_group": 35,
+            "security_group_rule": 40,
+            "subnet": 45,
+            "subnetpool": -1,
+            "trunk": 50,
+            "project_id": "0a73845280574ad389c292f6a74afa76",
+            "tenant_id": "0a73845280574ad389c292f6a74afa76"
+        },
+        {
+            "floatingip": 0,
+            "network": -1,
+            "port": 5,
+            "rbac_policy": 10,
+            "router": 15,
+            "security_group": 20,
+            "security_group_rule": -1,
+            "subnet": 25,
+            "subnetpool": 0,
+            "trunk": 5,
+            "project_id": "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3",
+            "tenant_id": "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3"
+        }
+    ]
+}
+`
+
+var ListResponse = []quotas.Quota{
+	{
+		FloatingIP:        15,
+		Network:           20,
+		Port:              25,
+		RBACPolicy:        -1,
+		Router:            30,
+		SecurityGroup:     35,
+		SecurityGroupRule: 40,
+		Subnet:            45,
+		SubnetPool:        -1,
+		Trunk:             50,
+		ProjectID:         "0a73845280574ad389c292f6a74afa76",
+		TenantID:          "0a73845280574ad389c292f6a74afa76",
+	},
+	{
+		FloatingIP:        0,
+		Network:           -1,
+		Port:              5,
+		RBACPolicy:        10,
+		Router:            15,
+		SecurityGroup:     20,
+		SecurityGroupRule: -1,
+		Subnet:            25,
+		SubnetPool:        0,
+		Trunk:             5,
+		ProjectID:         "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3",
+		TenantID:          "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3",
+	},
+}
+
+const NetworkIPAvailabilitiesResponseRaw = `
+{
+    "network_ip_availabilities": [
+        {
+            "network_id": "080ee064-036d-405a-a307-3bde4a213a1b",
+            "network_name": "private",
+            "project_id": "0a73845280574ad389c292f6a74afa76",
+            "tenant_id": "0a73845280574ad389c292f6a74afa76",
+            "subnet_ip_availability": [],
+            "total_ips": 253,
+            "used_ips": 3
+        },
+        {
+            "network_id": "cf11ab78-2302-49fa-870f-851a08c7afb8",
+            "network_name": "other",
+            "project_id": "fb57277ef2f84a0e85b9018ec2dedbf7",
+            "tenant_id": "fb57277ef2f84a0e85b9018ec2dedbf7",
+            "subnet_ip_availability": [],
+            "total_ips": 253,
+            "used_ips": 1
+        }
+    ]
+}
+`
+
+const SubnetPoolsResponseRaw = `
+{
+    "subnetpools": [
+        {
+            "id": "d43a57fe-3390-4608-b437-b1307b0adb40",
+            "name": "pool-v4",
+            "ip_version": 4,
+            "min_prefixlen": "24",
+            "max_prefixlen": "28",
+            "default_prefixlen": "26",
+            "prefixes": ["10.10.0.0/16", "10.20.0.0/24"]
+        },
+        {
+            "id": "0ba6b9c6-2f6f-4c74-8cd4-1e1d9ae6b4b5",
+            "name": "pool-v6",
+            "ip_version": 6,
+            "min_prefixlen": "64",
+            "max_prefixlen": "64",
+            "default_prefixlen": "64",
+            "prefixes": ["2001:db8::/48"]
+        }
+    ]
+}
+`
+
+const SubnetsResponseRaw = `
+{
+    "subnets": [
+        {
+            "id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+            "cidr": "10.10.0.0/24",
+            "ip_version": 4,
+            "subnetpool_id": "d43a57fe-3390-4608-b437-b1307b0adb40",
+            "project_id": "0a73845280574ad389c292f6a74afa76"
+        },
+        {
+            "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+            "cidr": "10.10.1.0/26",
+            "ip_version": 4,
+            "subnetpool_id": "d43a57fe-3390-4608-b437-b1307b0adb40",
+            "project_id": "9fcdc2d6c7bd4e1fa8e41c7a56c8a1b3"
+        },
+        {
+            "id": "ab78df4f-2c53-41fb-a4ba-3a6a0a2b2df8",
+            "cidr": "192.168.0.0/24",
+            "ip_version": 4,
+            "project_id": "0a73845280574ad389c292f6a74afa76"
+        }
+    ]
+}
+`
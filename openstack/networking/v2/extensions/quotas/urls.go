@@ -4,6 +4,7 @@ import "github.com/gophercloud/gophercloud/v2"
 
 const resourcePath = "quotas"
 const resourcePathDetail = "details.json"
+const resourcePathDefault = "default"
 
 func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
 	return c.ServiceURL(resourcePath, projectID)
@@ -13,6 +14,18 @@ func resourceDetailURL(c *gophercloud.ServiceClient, projectID string) string {
 	return c.ServiceURL(resourcePath, projectID, resourcePathDetail)
 }
 
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getDefaultURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID, resourcePathDefault)
+}
+
 func getURL(c *gophercloud.ServiceClient, projectID string) string {
 	return resourceURL(c, projectID)
 }
@@ -0,0 +1,47 @@
+/*
+Package revisions provides support for the standard-attr-revisions and
+revision-if-match Neutron extensions, which allow updates to be applied only
+when a resource was not changed since it was read.
+
+The UpdateOpts of the resources which expose a revision_number have a
+RevisionNumber field, sent as an If-Match header. When the revision number of
+the resource no longer matches, the update fails with an ErrPreconditionFailed.
+
+Example to Add an Allowed Address Pair to a Port
+
+	portID := "65c0ee9f-d634-4522-8954-51021b570b0d"
+	pair := ports.AddressPair{IPAddress: "10.0.0.4"}
+
+	err := revisions.Update(context.TODO(),
+		func(ctx context.Context) (*ports.Port, error) {
+			return ports.Get(ctx, networkClient, portID).Extract()
+		},
+		func(ctx context.Context, port *ports.Port) error {
+			pairs := append(port.AllowedAddressPairs, pair)
+			updateOpts := ports.UpdateOpts{
+				AllowedAddressPairs: &pairs,
+				RevisionNumber:      &port.RevisionNumber,
+			}
+			_, err := ports.Update(ctx, networkClient, portID, updateOpts).Extract()
+			return err
+		},
+		revisions.UpdateOpts{},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check for a Conflicting Update
+
+	revisionNumber := 42
+	updateOpts := routers.UpdateOpts{
+		Routes:         &routes,
+		RevisionNumber: &revisionNumber,
+	}
+
+	_, err := routers.Update(context.TODO(), networkClient, routerID, updateOpts).Extract()
+	if errors.As(err, &revisions.ErrPreconditionFailed{}) {
+		// The router was changed by another client.
+	}
+*/
+package revisions
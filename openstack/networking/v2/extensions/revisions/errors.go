@@ -0,0 +1,58 @@
+package revisions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrPreconditionFailed is returned by the updates sent with a revision
+// number which no longer matches the one of the resource, because it was
+// changed by another client. It wraps the ErrUnexpectedResponseCode of the
+// 412 response, so gophercloud.ResponseCodeIs(err, http.StatusPreconditionFailed)
+// also reports it.
+type ErrPreconditionFailed struct {
+	gophercloud.ErrUnexpectedResponseCode
+
+	// RevisionNumber is the revision number sent in the If-Match header.
+	RevisionNumber int
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return fmt.Sprintf("revision_number %d does not match the current revision of the resource at [%s %s]: %s",
+		e.RevisionNumber, e.Method, e.URL, strings.TrimSpace(string(e.Body)))
+}
+
+func (e ErrPreconditionFailed) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+
+// CheckPrecondition returns an ErrPreconditionFailed when err reports that a
+// request sent with the If-Match header in headers failed with a 412 response.
+// It returns err unchanged otherwise. It is used by the Update functions of
+// the resources which support revision numbers.
+func CheckPrecondition(err error, headers map[string]string) error {
+	ifMatch, ok := headers["If-Match"]
+	if !ok {
+		return err
+	}
+	var codeError gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &codeError) || codeError.Actual != http.StatusPreconditionFailed {
+		return err
+	}
+	revisionNumber, _ := strconv.Atoi(strings.TrimPrefix(ifMatch, "revision_number="))
+	return ErrPreconditionFailed{
+		ErrUnexpectedResponseCode: codeError,
+		RevisionNumber:            revisionNumber,
+	}
+}
+
+// IsConflict returns true when err reports that an update failed because of
+// a revision number mismatch.
+func IsConflict(err error) bool {
+	return gophercloud.ResponseCodeIs(err, http.StatusPreconditionFailed)
+}
@@ -0,0 +1,61 @@
+package revisions
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultMaxAttempts is the number of times Update applies a mutation when
+// UpdateOpts.MaxAttempts is not set.
+const DefaultMaxAttempts = 5
+
+// UpdateOpts holds the parameters of a read-modify-write update run with
+// Update.
+type UpdateOpts struct {
+	// MaxAttempts is the maximum number of times the resource is read and the
+	// mutation applied. Defaults to DefaultMaxAttempts.
+	MaxAttempts int
+
+	// Delay is the time to wait before reading the resource again after a
+	// conflict. It is doubled after each conflict.
+	Delay time.Duration
+}
+
+// Update runs a read-modify-write update of a resource. It reads the resource
+// with get and passes it to mutate, which must send the update along with the
+// revision number of the resource it was given. When the update fails
+// because the resource was changed in the meantime, the resource is read
+// again and mutate applied to it again, up to UpdateOpts.MaxAttempts times.
+//
+// The last ErrPreconditionFailed is returned when all the attempts conflict.
+// Any other error returned by get or mutate is returned immediately.
+func Update[T any](ctx context.Context, get func(context.Context) (T, error), mutate func(context.Context, T) error, opts UpdateOpts) error {
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	delay := opts.Delay
+
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		var current T
+		current, err = get(ctx)
+		if err != nil {
+			return err
+		}
+		err = mutate(ctx, current)
+		if !IsConflict(err) {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,2 @@
+// revisions unit tests
+package testing
@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const PortID = "65c0ee9f-d634-4522-8954-51021b570b0d"
+
+const GetResponse = `
+{
+    "port": {
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "name": "private-port",
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "allowed_address_pairs": [%s],
+        "revision_number": %d
+    }
+}
+`
+
+const PreconditionFailedResponse = `
+{
+    "NeutronError": {
+        "type": "RevisionNumberConstraintFailed",
+        "message": "Constrained to 3, but current revision is 4",
+        "detail": ""
+    }
+}
+`
+
+// FakePort is a port whose revision number is increased by a concurrent
+// client the first conflicts times it is read.
+type FakePort struct {
+	mu        sync.Mutex
+	revision  int
+	pairs     string
+	conflicts int
+	Gets      int
+	Updates   []string
+}
+
+// HandlePortReadModifyWrite serves the port, and fails the updates sent with
+// a revision number which does not match its current one.
+func HandlePortReadModifyWrite(t *testing.T, fakeServer th.FakeServer, conflicts int) *FakePort {
+	port := &FakePort{revision: 3, pairs: `{"ip_address": "10.0.0.4", "mac_address": "fa:16:3e:c9:cb:f0"}`, conflicts: conflicts}
+
+	fakeServer.Mux.HandleFunc("/v2.0/ports/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		port.mu.Lock()
+		defer port.mu.Unlock()
+
+		switch r.Method {
+		case "GET":
+			port.Gets++
+			revision := port.revision
+			if port.Gets <= port.conflicts {
+				// Another client updates the port right after it is read.
+				port.revision++
+			}
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetResponse, port.pairs, revision)
+		case "PUT":
+			ifMatch := r.Header.Get("If-Match")
+			port.Updates = append(port.Updates, ifMatch)
+			if ifMatch != fmt.Sprintf("revision_number=%d", port.revision) {
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusPreconditionFailed)
+				fmt.Fprint(w, PreconditionFailedResponse)
+				return
+			}
+			port.revision++
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetResponse, port.pairs, port.revision)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	return port
+}
@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func addAddressPair(client *gophercloud.ServiceClient, mutations *int) func(context.Context, *ports.Port) error {
+	return func(ctx context.Context, port *ports.Port) error {
+		*mutations++
+		pairs := append(port.AllowedAddressPairs, ports.AddressPair{IPAddress: "10.0.0.5"})
+		updateOpts := ports.UpdateOpts{
+			AllowedAddressPairs: &pairs,
+			RevisionNumber:      &port.RevisionNumber,
+		}
+		_, err := ports.Update(ctx, client, PortID, updateOpts).Extract()
+		return err
+	}
+}
+
+func getPort(client *gophercloud.ServiceClient) func(context.Context) (*ports.Port, error) {
+	return func(ctx context.Context) (*ports.Port, error) {
+		return ports.Get(ctx, client, PortID).Extract()
+	}
+}
+
+func TestUpdatePreconditionFailed(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandlePortReadModifyWrite(t, fakeServer, 0)
+
+	name := "new-name"
+	revisionNumber := 2
+	_, err := ports.Update(context.TODO(), fake.ServiceClient(fakeServer), PortID, ports.UpdateOpts{
+		Name:           &name,
+		RevisionNumber: &revisionNumber,
+	}).Extract()
+
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 2, conflict.RevisionNumber)
+	th.AssertEquals(t, http.StatusPreconditionFailed, conflict.Actual)
+	th.AssertTrue(t, gophercloud.ResponseCodeIs(err, http.StatusPreconditionFailed))
+	th.AssertTrue(t, revisions.IsConflict(err))
+}
+
+func TestCheckPrecondition(t *testing.T) {
+	codeError := gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusPreconditionFailed}
+
+	// Without If-Match, the 412 is not caused by a revision mismatch.
+	err := revisions.CheckPrecondition(codeError, map[string]string{})
+	th.AssertDeepEquals(t, error(codeError), err)
+
+	err = revisions.CheckPrecondition(codeError, map[string]string{"If-Match": "revision_number=7"})
+	th.AssertDeepEquals(t, error(revisions.ErrPreconditionFailed{ErrUnexpectedResponseCode: codeError, RevisionNumber: 7}), err)
+
+	notFound := gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusNotFound}
+	err = revisions.CheckPrecondition(notFound, map[string]string{"If-Match": "revision_number=7"})
+	th.AssertDeepEquals(t, error(notFound), err)
+
+	th.AssertNoErr(t, revisions.CheckPrecondition(nil, map[string]string{"If-Match": "revision_number=7"}))
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	port := HandlePortReadModifyWrite(t, fakeServer, 2)
+	client := fake.ServiceClient(fakeServer)
+
+	var mutations int
+	err := revisions.Update(context.TODO(), getPort(client), addAddressPair(client, &mutations), revisions.UpdateOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 3, port.Gets)
+	th.AssertEquals(t, 3, mutations)
+	th.AssertDeepEquals(t, []string{"revision_number=3", "revision_number=4", "revision_number=5"}, port.Updates)
+}
+
+func TestUpdateMaxAttempts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	port := HandlePortReadModifyWrite(t, fakeServer, 10)
+	client := fake.ServiceClient(fakeServer)
+
+	var mutations int
+	err := revisions.Update(context.TODO(), getPort(client), addAddressPair(client, &mutations), revisions.UpdateOpts{MaxAttempts: 2})
+
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 4, conflict.RevisionNumber)
+	th.AssertEquals(t, 2, port.Gets)
+	th.AssertEquals(t, 2, mutations)
+}
+
+func TestUpdateError(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandlePortReadModifyWrite(t, fakeServer, 0)
+	client := fake.ServiceClient(fakeServer)
+
+	failed := errors.New("mutation failed")
+	var mutations int
+	err := revisions.Update(context.TODO(), getPort(client), func(context.Context, *ports.Port) error {
+		mutations++
+		return failed
+	}, revisions.UpdateOpts{})
+	th.AssertEquals(t, failed, err)
+	th.AssertEquals(t, 1, mutations)
+}
@@ -46,5 +46,26 @@ Example to Delete a Security Group Rule
 	if err != nil {
 		panic(err)
 	}
+
+Example to Reconcile the Rules of a Security Group
+
+	secGroupID := "a7734e61-b545-452d-a3cd-0189cbd9747a"
+	desired := []rules.CreateOpts{
+		{
+			Direction:      rules.DirIngress,
+			EtherType:      rules.EtherType4,
+			Protocol:       rules.ProtocolTCP,
+			PortRangeMin:   ptr.To(22),
+			PortRangeMax:   ptr.To(22),
+			RemoteIPPrefix: "10.0.0.0/8",
+		},
+	}
+
+	report, err := rules.Reconcile(context.TODO(), networkClient, secGroupID, desired, rules.ReconcileOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("created %d rules, deleted %d rules\n", len(report.Created), len(report.Deleted))
 */
 package rules
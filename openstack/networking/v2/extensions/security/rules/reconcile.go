@@ -0,0 +1,338 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ReconcileOpts represents the options of a security group reconciliation.
+type ReconcileOpts struct {
+	// DryRun only computes the changes without applying them.
+	DryRun bool
+
+	// DisableBulk creates the missing rules one by one instead of in a single
+	// bulk request.
+	DisableBulk bool
+}
+
+// ReconcileReport describes the changes needed to make a security group
+// contain exactly the desired rules.
+type ReconcileReport struct {
+	// Create are the desired rules missing from the security group.
+	Create []CreateOpts
+
+	// Delete are the rules of the security group which aren't desired,
+	// including the semantic duplicates of a desired rule.
+	Delete []SecGroupRule
+
+	// Unchanged are the rules of the security group matching a desired rule.
+	Unchanged []SecGroupRule
+
+	// Created are the rules created by the reconciliation. It is empty on
+	// dry-run.
+	Created []SecGroupRule
+
+	// Deleted are the IDs of the rules deleted by the reconciliation. It is
+	// empty on dry-run.
+	Deleted []string
+}
+
+// protocolNames maps IANA protocol numbers to the names known to Neutron.
+var protocolNames = map[string]RuleProtocol{
+	"1":   ProtocolICMP,
+	"2":   ProtocolIGMP,
+	"4":   ProtocolIPIP,
+	"6":   ProtocolTCP,
+	"8":   ProtocolEGP,
+	"17":  ProtocolUDP,
+	"33":  ProtocolDCCP,
+	"41":  ProtocolIPv6Encap,
+	"43":  ProtocolIPv6Route,
+	"44":  ProtocolIPv6Frag,
+	"46":  ProtocolRSVP,
+	"47":  ProtocolGRE,
+	"50":  ProtocolESP,
+	"51":  ProtocolAH,
+	"58":  ProtocolIPv6ICMP,
+	"59":  ProtocolIPv6NoNxt,
+	"60":  ProtocolIPv6Opts,
+	"89":  ProtocolOSPF,
+	"112": ProtocolVRRP,
+	"113": ProtocolPGM,
+	"132": ProtocolSCTP,
+	"136": ProtocolUDPLite,
+}
+
+// ruleKey is the normalized form of a rule, under which two rules matching
+// the same traffic are equal.
+type ruleKey struct {
+	direction    string
+	etherType    string
+	protocol     string
+	portRangeMin int
+	portRangeMax int
+	remote       string
+}
+
+// noPort stands for an unset port in a ruleKey, since 0 is a valid ICMP
+// type.
+const noPort = -1
+
+func normalizeEtherType(etherType, remoteIPPrefix string) string {
+	switch strings.ToLower(etherType) {
+	case "ipv6":
+		return string(EtherType6)
+	case "ipv4":
+		return string(EtherType4)
+	}
+	if strings.Contains(remoteIPPrefix, ":") {
+		return string(EtherType6)
+	}
+	return string(EtherType4)
+}
+
+func normalizeProtocol(protocol, etherType string) string {
+	p := strings.ToLower(strings.TrimSpace(protocol))
+	if name, ok := protocolNames[p]; ok {
+		p = string(name)
+	}
+	switch p {
+	case "any", "0":
+		return string(ProtocolAny)
+	case "icmpv6":
+		return string(ProtocolIPv6ICMP)
+	case string(ProtocolICMP):
+		if etherType == string(EtherType6) {
+			return string(ProtocolIPv6ICMP)
+		}
+	}
+	return p
+}
+
+func normalizeRemote(remoteGroupID, remoteAddressGroupID, remoteIPPrefix string) string {
+	switch {
+	case remoteGroupID != "":
+		return "group:" + remoteGroupID
+	case remoteAddressGroupID != "":
+		return "address-group:" + remoteAddressGroupID
+	case remoteIPPrefix == "":
+		return ""
+	}
+
+	prefix := remoteIPPrefix
+	if !strings.Contains(prefix, "/") {
+		if ip := net.ParseIP(prefix); ip != nil && ip.To4() == nil {
+			prefix += "/128"
+		} else {
+			prefix += "/32"
+		}
+	}
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "cidr:" + remoteIPPrefix
+	}
+	if ones, _ := ipNet.Mask.Size(); ones == 0 {
+		return ""
+	}
+	return "cidr:" + ipNet.String()
+}
+
+func normalizePorts(protocol string, portRangeMin, portRangeMax *int) (int, int) {
+	portMin, portMax := noPort, noPort
+	if portRangeMin != nil {
+		portMin = *portRangeMin
+	}
+	if portRangeMax != nil {
+		portMax = *portRangeMax
+	}
+
+	switch RuleProtocol(protocol) {
+	case ProtocolTCP, ProtocolUDP, ProtocolSCTP, ProtocolDCCP, ProtocolUDPLite:
+		// The whole port range is the same as no port range.
+		if (portMin == noPort || portMin == 1) && portMax == 65535 {
+			return noPort, noPort
+		}
+		if portMin != noPort && portMax == noPort {
+			portMax = portMin
+		}
+	case ProtocolAny:
+		return noPort, noPort
+	}
+	return portMin, portMax
+}
+
+func newRuleKey(direction, etherType, protocol string, portRangeMin, portRangeMax *int, remoteGroupID, remoteAddressGroupID, remoteIPPrefix string) ruleKey {
+	k := ruleKey{
+		direction: strings.ToLower(direction),
+		etherType: normalizeEtherType(etherType, remoteIPPrefix),
+		remote:    normalizeRemote(remoteGroupID, remoteAddressGroupID, remoteIPPrefix),
+	}
+	k.protocol = normalizeProtocol(protocol, k.etherType)
+	k.portRangeMin, k.portRangeMax = normalizePorts(k.protocol, portRangeMin, portRangeMax)
+	return k
+}
+
+func (opts CreateOpts) ruleKey() ruleKey {
+	return newRuleKey(string(opts.Direction), string(opts.EtherType), string(opts.Protocol),
+		opts.PortRangeMin, opts.PortRangeMax,
+		opts.RemoteGroupID, opts.RemoteAddressGroupID, opts.RemoteIPPrefix)
+}
+
+// existingRule is a rule of the security group along with its port range,
+// which SecGroupRule can't tell apart from an unset one.
+type existingRule struct {
+	SecGroupRule
+	portRangeMin *int
+	portRangeMax *int
+}
+
+func (r existingRule) ruleKey() ruleKey {
+	return newRuleKey(r.Direction, r.EtherType, r.Protocol,
+		r.portRangeMin, r.portRangeMax,
+		r.RemoteGroupID, r.RemoteAddressGroupID, r.RemoteIPPrefix)
+}
+
+func listExistingRules(ctx context.Context, c *gophercloud.ServiceClient, secGroupID string) ([]existingRule, error) {
+	allPages, err := List(c, ListOpts{SecGroupID: secGroupID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allRules, err := ExtractRules(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var s struct {
+		SecGroupRules []struct {
+			PortRangeMin *int `json:"port_range_min"`
+			PortRangeMax *int `json:"port_range_max"`
+		} `json:"security_group_rules"`
+	}
+	if err := allPages.(SecGroupRulePage).ExtractInto(&s); err != nil {
+		return nil, err
+	}
+
+	existing := make([]existingRule, len(allRules))
+	for i, rule := range allRules {
+		existing[i].SecGroupRule = rule
+		if i < len(s.SecGroupRules) {
+			existing[i].portRangeMin = s.SecGroupRules[i].PortRangeMin
+			existing[i].portRangeMax = s.SecGroupRules[i].PortRangeMax
+		}
+	}
+	return existing, nil
+}
+
+// Reconcile makes a security group contain exactly the desired rules. The
+// rules are compared semantically: protocol numbers and names, the case of
+// the ethertype, whole and unset port ranges, and the any-address and unset
+// remote IP prefixes are considered equal. Descriptions are ignored, and the
+// SecGroupID of the desired rules is set to secGroupID.
+//
+// The missing rules are created before the undesired ones are deleted, so
+// that the traffic allowed by both is never interrupted. They are created in
+// a single bulk request unless opts.DisableBulk is set, falling back to one
+// request per rule when Neutron doesn't support bulk creation.
+//
+// The returned report describes the changes, and what has been applied when
+// an error interrupts the reconciliation. Nothing is changed when
+// opts.DryRun is set.
+func Reconcile(ctx context.Context, c *gophercloud.ServiceClient, secGroupID string, desired []CreateOpts, opts ReconcileOpts) (*ReconcileReport, error) {
+	existing, err := listExistingRules(ctx, c, secGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := new(ReconcileReport)
+	matched := make(map[ruleKey]bool, len(existing))
+	desiredKeys := make(map[ruleKey]bool, len(desired))
+	for _, d := range desired {
+		desiredKeys[d.ruleKey()] = true
+	}
+
+	for _, rule := range existing {
+		k := rule.ruleKey()
+		if desiredKeys[k] && !matched[k] {
+			matched[k] = true
+			report.Unchanged = append(report.Unchanged, rule.SecGroupRule)
+			continue
+		}
+		report.Delete = append(report.Delete, rule.SecGroupRule)
+	}
+
+	for _, d := range desired {
+		k := d.ruleKey()
+		if matched[k] {
+			continue
+		}
+		matched[k] = true
+		d.SecGroupID = secGroupID
+		report.Create = append(report.Create, d)
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	if err := createRules(ctx, c, report, opts.DisableBulk); err != nil {
+		return report, err
+	}
+
+	for _, rule := range report.Delete {
+		err := Delete(ctx, c, rule.ID).ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return report, fmt.Errorf("deleting security group rule %s: %w", rule.ID, err)
+		}
+		report.Deleted = append(report.Deleted, rule.ID)
+	}
+
+	return report, nil
+}
+
+// createRules creates the rules missing from the security group, recording
+// them in the report.
+func createRules(ctx context.Context, c *gophercloud.ServiceClient, report *ReconcileReport, disableBulk bool) error {
+	if len(report.Create) == 0 {
+		return nil
+	}
+
+	if !disableBulk && len(report.Create) > 1 {
+		created, err := CreateBulk(ctx, c, report.Create).Extract()
+		if err == nil {
+			report.Created = created
+			return nil
+		}
+		// Bulk creation is atomic, so the rules can be created one by one
+		// when it isn't supported.
+		if !bulkUnsupported(err) {
+			return fmt.Errorf("creating security group rules: %w", err)
+		}
+	}
+
+	for _, opts := range report.Create {
+		rule, err := Create(ctx, c, opts).Extract()
+		if err != nil {
+			return fmt.Errorf("creating %s security group rule %q: %w", opts.Direction, opts.Protocol, err)
+		}
+		report.Created = append(report.Created, *rule)
+	}
+	return nil
+}
+
+// bulkUnsupported reports whether a bulk creation failed because Neutron
+// doesn't support it. A bad request means that Neutron rejected one of the
+// rules, which must not be retried one by one.
+func bulkUnsupported(err error) bool {
+	for _, status := range []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented} {
+		if gophercloud.ResponseCodeIs(err, status) {
+			return true
+		}
+	}
+	return false
+}
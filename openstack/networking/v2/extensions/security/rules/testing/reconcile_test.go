@@ -0,0 +1,335 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/ptr"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const reconcileSecGroupID = "a7734e61-b545-452d-a3cd-0189cbd9747a"
+
+// ReconcileListResult lists the rules of a security group, with an HTTP rule
+// and an ICMP echo reply rule which aren't desired, a default egress rule,
+// and rules which are semantically equal to the desired ones.
+const ReconcileListResult = `
+{
+    "security_group_rules": [
+        {
+            "id": "rule-egress",
+            "direction": "egress",
+            "ethertype": "IPv4",
+            "port_range_max": null,
+            "port_range_min": null,
+            "protocol": null,
+            "remote_group_id": null,
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "rule-ssh",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_max": 22,
+            "port_range_min": 22,
+            "protocol": "tcp",
+            "remote_group_id": null,
+            "remote_ip_prefix": "0.0.0.0/0",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "rule-icmpv6",
+            "direction": "ingress",
+            "ethertype": "IPv6",
+            "port_range_max": null,
+            "port_range_min": null,
+            "protocol": "ipv6-icmp",
+            "remote_group_id": null,
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "rule-echo-reply",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_max": null,
+            "port_range_min": 0,
+            "protocol": "icmp",
+            "remote_group_id": null,
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "rule-http",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_max": 80,
+            "port_range_min": 80,
+            "protocol": "tcp",
+            "remote_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "rule-members",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_max": null,
+            "port_range_min": null,
+            "protocol": "tcp",
+            "remote_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a",
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        }
+    ]
+}
+`
+
+// ReconcileCreateBulkRequest creates the desired rules missing from the
+// security group.
+const ReconcileCreateBulkRequest = `
+{
+    "security_group_rules": [
+        {
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "protocol": "icmp",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_max": 80,
+            "port_range_min": 80,
+            "protocol": "tcp",
+            "remote_ip_prefix": "10.0.0.0/8",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        }
+    ]
+}
+`
+
+// ReconcileCreateBulkResponse is the response to ReconcileCreateBulkRequest.
+const ReconcileCreateBulkResponse = `
+{
+    "security_group_rules": [
+        {
+            "id": "rule-icmp",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "protocol": "icmp",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "rule-http-cidr",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_max": 80,
+            "port_range_min": 80,
+            "protocol": "tcp",
+            "remote_ip_prefix": "10.0.0.0/8",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        }
+    ]
+}
+`
+
+// desiredRules are the rules the security group must contain exactly.
+var desiredRules = []rules.CreateOpts{
+	{
+		Direction:    rules.DirIngress,
+		EtherType:    "ipv4",
+		Protocol:     "6",
+		PortRangeMin: ptr.To(22),
+	},
+	{
+		Direction: rules.DirIngress,
+		EtherType: rules.EtherType6,
+		Protocol:  rules.ProtocolICMP,
+	},
+	{
+		Direction: rules.DirIngress,
+		EtherType: rules.EtherType4,
+		Protocol:  rules.ProtocolICMP,
+	},
+	{
+		Direction:      rules.DirIngress,
+		EtherType:      rules.EtherType4,
+		Protocol:       rules.ProtocolTCP,
+		PortRangeMin:   ptr.To(80),
+		PortRangeMax:   ptr.To(80),
+		RemoteIPPrefix: "10.0.0.0/8",
+	},
+	{
+		Direction:     rules.DirIngress,
+		EtherType:     rules.EtherType4,
+		Protocol:      rules.ProtocolTCP,
+		PortRangeMin:  ptr.To(1),
+		PortRangeMax:  ptr.To(65535),
+		RemoteGroupID: reconcileSecGroupID,
+		Description:   "members",
+	},
+}
+
+// HandleReconcile handles the requests of a security group reconciliation,
+// and records the creations and deletions. The bulk creation fails with
+// bulkStatus when it isn't http.StatusCreated.
+func HandleReconcile(t *testing.T, fakeServer th.FakeServer, bulkStatus int, calls *[]string) {
+	fakeServer.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"security_group_id": reconcileSecGroupID})
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, ReconcileListResult)
+		case "POST":
+			var body map[string]json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+
+			w.Header().Add("Content-Type", "application/json")
+			if rule, ok := body["security_group_rule"]; ok {
+				*calls = append(*calls, "create")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(w, `{"security_group_rule": %s}`, rule)
+				return
+			}
+
+			*calls = append(*calls, "create bulk")
+			th.CheckJSONEquals(t, ReconcileCreateBulkRequest, body)
+			w.WriteHeader(bulkStatus)
+			if bulkStatus == http.StatusCreated {
+				fmt.Fprint(w, ReconcileCreateBulkResponse)
+			} else {
+				fmt.Fprint(w, `{"NeutronError": {"message": "Bulk operation not supported"}}`)
+			}
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/security-group-rules/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		*calls = append(*calls, "delete "+strings.TrimPrefix(r.URL.Path, "/v2.0/security-group-rules/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func ruleIDs(secGroupRules []rules.SecGroupRule) []string {
+	var ids []string
+	for _, rule := range secGroupRules {
+		ids = append(ids, rule.ID)
+	}
+	return ids
+}
+
+func TestReconcileDryRun(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var calls []string
+	HandleReconcile(t, fakeServer, http.StatusCreated, &calls)
+
+	report, err := rules.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), reconcileSecGroupID, desiredRules, rules.ReconcileOpts{DryRun: true})
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{"rule-ssh", "rule-icmpv6", "rule-members"}, ruleIDs(report.Unchanged))
+	th.CheckDeepEquals(t, []string{"rule-egress", "rule-echo-reply", "rule-http"}, ruleIDs(report.Delete))
+
+	expected := []rules.CreateOpts{desiredRules[2], desiredRules[3]}
+	for i := range expected {
+		expected[i].SecGroupID = reconcileSecGroupID
+	}
+	th.CheckDeepEquals(t, expected, report.Create)
+	th.CheckDeepEquals(t, []rules.SecGroupRule(nil), report.Created)
+	th.CheckDeepEquals(t, []string(nil), report.Deleted)
+	th.CheckDeepEquals(t, []string(nil), calls)
+}
+
+func TestReconcile(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var calls []string
+	HandleReconcile(t, fakeServer, http.StatusCreated, &calls)
+
+	report, err := rules.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), reconcileSecGroupID, desiredRules, rules.ReconcileOpts{})
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{"rule-icmp", "rule-http-cidr"}, ruleIDs(report.Created))
+	th.CheckDeepEquals(t, []string{"rule-egress", "rule-echo-reply", "rule-http"}, report.Deleted)
+	th.CheckDeepEquals(t, []string{
+		"create bulk",
+		"delete rule-egress",
+		"delete rule-echo-reply",
+		"delete rule-http",
+	}, calls)
+}
+
+func TestReconcileBulkUnsupported(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var calls []string
+	HandleReconcile(t, fakeServer, http.StatusNotImplemented, &calls)
+
+	report, err := rules.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), reconcileSecGroupID, desiredRules, rules.ReconcileOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(report.Created))
+	th.CheckDeepEquals(t, []string{
+		"create bulk",
+		"create",
+		"create",
+		"delete rule-egress",
+		"delete rule-echo-reply",
+		"delete rule-http",
+	}, calls)
+}
+
+func TestReconcileBulkRejected(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var calls []string
+	HandleReconcile(t, fakeServer, http.StatusBadRequest, &calls)
+
+	// A rejected rule fails the whole bulk creation, which is not retried
+	// rule by rule.
+	_, err := rules.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), reconcileSecGroupID, desiredRules, rules.ReconcileOpts{})
+	if !gophercloud.ResponseCodeIs(err, http.StatusBadRequest) {
+		t.Fatalf("expected a bad request, got %v", err)
+	}
+	th.CheckDeepEquals(t, []string{"create bulk"}, calls)
+}
+
+func TestReconcileDisableBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var calls []string
+	HandleReconcile(t, fakeServer, http.StatusCreated, &calls)
+
+	_, err := rules.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), reconcileSecGroupID, desiredRules[:3], rules.ReconcileOpts{DisableBulk: true})
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{
+		"create",
+		"delete rule-egress",
+		"delete rule-echo-reply",
+		"delete rule-http",
+		"delete rule-members",
+	}, calls)
+}
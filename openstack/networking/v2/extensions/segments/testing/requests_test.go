@@ -2,10 +2,12 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 
 	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/segments"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 	th "github.com/gophercloud/gophercloud/v2/testhelper"
@@ -113,6 +115,37 @@ func TestUpdateSegment(t *testing.T) {
 	th.CheckDeepEquals(t, expected, *actual)
 }
 
+func TestUpdateSegmentRevision(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/segments/"+SegmentID1, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "If-Match", "revision_number=42")
+		th.TestJSONRequest(t, r, updateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, err := w.Write([]byte(`{"NeutronError": {"type": "RevisionNumberConstraintFailed", "message": "Constrained to 42, but current revision is 43", "detail": ""}}`))
+		th.AssertNoErr(t, err)
+	})
+
+	newName := "new-name"
+	newDesc := "new-desc"
+	revisionNumber := 42
+	opts := segments.UpdateOpts{
+		Name:           &newName,
+		Description:    &newDesc,
+		RevisionNumber: &revisionNumber,
+	}
+	_, err := segments.Update(context.TODO(), fake.ServiceClient(fakeServer), SegmentID1, opts).Extract()
+	var conflict revisions.ErrPreconditionFailed
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected an ErrPreconditionFailed, got %v", err)
+	}
+	th.AssertEquals(t, 42, conflict.RevisionNumber)
+}
+
 func TestDeleteSegment(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
@@ -0,0 +1,46 @@
+/*
+Package flowclassifiers manages and retrieves the flow classifiers of the
+networking-sfc extension of the OpenStack Networking Service. A flow classifier
+selects the traffic steered through a port chain.
+
+Example to List Flow Classifiers
+
+	allPages, err := flowclassifiers.List(networkClient, flowclassifiers.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allFlowClassifiers, err := flowclassifiers.ExtractFlowClassifiers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flowClassifier := range allFlowClassifiers {
+		fmt.Printf("%+v\n", flowClassifier)
+	}
+
+Example to Create a Flow Classifier
+
+	createOpts := flowclassifiers.CreateOpts{
+		Name:                    "web",
+		EtherType:               flowclassifiers.EtherType4,
+		Protocol:                flowclassifiers.ProtocolTCP,
+		DestinationPortRangeMin: 80,
+		DestinationPortRangeMax: 80,
+		SourceIPPrefix:          "22.12.34.44/32",
+		LogicalSourcePort:       "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	}
+
+	flowClassifier, err := flowclassifiers.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flow Classifier
+
+	err := flowclassifiers.Delete(context.TODO(), networkClient, flowClassifierID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flowclassifiers
@@ -0,0 +1,189 @@
+package flowclassifiers
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// EtherType is the type of the traffic matched by a flow classifier.
+type EtherType string
+
+const (
+	EtherType4 EtherType = "IPv4"
+	EtherType6 EtherType = "IPv6"
+)
+
+// Protocol is the IP protocol matched by a flow classifier.
+type Protocol string
+
+const (
+	ProtocolTCP    Protocol = "tcp"
+	ProtocolUDP    Protocol = "udp"
+	ProtocolICMP   Protocol = "icmp"
+	ProtocolICMPv6 Protocol = "icmpv6"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlowClassifierListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the flow classifier attributes you want to see returned.
+type ListOpts struct {
+	ID                     string    `q:"id"`
+	Name                   string    `q:"name"`
+	Description            string    `q:"description"`
+	TenantID               string    `q:"tenant_id"`
+	ProjectID              string    `q:"project_id"`
+	Protocol               Protocol  `q:"protocol"`
+	EtherType              EtherType `q:"ethertype"`
+	LogicalSourcePort      string    `q:"logical_source_port"`
+	LogicalDestinationPort string    `q:"logical_destination_port"`
+	Limit                  int       `q:"limit"`
+	Marker                 string    `q:"marker"`
+	SortKey                string    `q:"sort_key"`
+	SortDir                string    `q:"sort_dir"`
+}
+
+// ToFlowClassifierListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlowClassifierListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flow classifiers. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlowClassifierListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlowClassifierPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlowClassifierCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new flow classifier.
+type CreateOpts struct {
+	// Name is the human-readable name of the flow classifier.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the flow classifier.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the project owner of the flow classifier. Only administrative users
+	// can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// EtherType is the type of the matched traffic. Defaults to IPv4.
+	EtherType EtherType `json:"ethertype,omitempty"`
+
+	// Protocol is the matched IP protocol.
+	Protocol Protocol `json:"protocol,omitempty"`
+
+	// SourcePortRangeMin and SourcePortRangeMax are the range of the matched
+	// TCP or UDP source ports.
+	SourcePortRangeMin int `json:"source_port_range_min,omitempty"`
+	SourcePortRangeMax int `json:"source_port_range_max,omitempty"`
+
+	// DestinationPortRangeMin and DestinationPortRangeMax are the range of the
+	// matched TCP or UDP destination ports.
+	DestinationPortRangeMin int `json:"destination_port_range_min,omitempty"`
+	DestinationPortRangeMax int `json:"destination_port_range_max,omitempty"`
+
+	// SourceIPPrefix is the matched source CIDR.
+	SourceIPPrefix string `json:"source_ip_prefix,omitempty"`
+
+	// DestinationIPPrefix is the matched destination CIDR.
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+
+	// LogicalSourcePort is the ID of the port the matched traffic comes from.
+	// It is required by most of the drivers.
+	LogicalSourcePort string `json:"logical_source_port,omitempty"`
+
+	// LogicalDestinationPort is the ID of the port the matched traffic goes
+	// to.
+	LogicalDestinationPort string `json:"logical_destination_port,omitempty"`
+
+	// L7Parameters are the matched layer 7 fields.
+	L7Parameters map[string]string `json:"l7_parameters,omitempty"`
+}
+
+// ToFlowClassifierCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToFlowClassifierCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flow_classifier")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// flow classifier.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlowClassifierCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular flow classifier based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlowClassifierUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a flow classifier.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToFlowClassifierUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToFlowClassifierUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flow_classifier")
+}
+
+// Update allows flow classifiers to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlowClassifierUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular flow classifier based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
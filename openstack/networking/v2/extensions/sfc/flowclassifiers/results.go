@@ -0,0 +1,134 @@
+package flowclassifiers
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// FlowClassifier represents the fields of the traffic steered through a port
+// chain.
+type FlowClassifier struct {
+	// ID is the unique ID of the flow classifier.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the flow classifier.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the flow classifier.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the flow classifier.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the flow classifier.
+	ProjectID string `json:"project_id"`
+
+	// EtherType is the type of the matched traffic.
+	EtherType EtherType `json:"ethertype"`
+
+	// Protocol is the matched IP protocol.
+	Protocol Protocol `json:"protocol"`
+
+	// SourcePortRangeMin and SourcePortRangeMax are the range of the matched
+	// source ports.
+	SourcePortRangeMin int `json:"source_port_range_min"`
+	SourcePortRangeMax int `json:"source_port_range_max"`
+
+	// DestinationPortRangeMin and DestinationPortRangeMax are the range of the
+	// matched destination ports.
+	DestinationPortRangeMin int `json:"destination_port_range_min"`
+	DestinationPortRangeMax int `json:"destination_port_range_max"`
+
+	// SourceIPPrefix is the matched source CIDR.
+	SourceIPPrefix string `json:"source_ip_prefix"`
+
+	// DestinationIPPrefix is the matched destination CIDR.
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+
+	// LogicalSourcePort is the ID of the port the matched traffic comes from.
+	LogicalSourcePort string `json:"logical_source_port"`
+
+	// LogicalDestinationPort is the ID of the port the matched traffic goes
+	// to.
+	LogicalDestinationPort string `json:"logical_destination_port"`
+
+	// L7Parameters are the matched layer 7 fields.
+	L7Parameters map[string]string `json:"l7_parameters"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a FlowClassifier.
+func (r commonResult) Extract() (*FlowClassifier, error) {
+	var s struct {
+		FlowClassifier *FlowClassifier `json:"flow_classifier"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FlowClassifier, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// FlowClassifierPage is the page returned by a pager when traversing over a collection
+// of flow classifiers.
+type FlowClassifierPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flow classifiers has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r FlowClassifierPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flow_classifiers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlowClassifierPage struct is empty.
+func (r FlowClassifierPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractFlowClassifiers(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlowClassifiers accepts a Page struct, specifically a FlowClassifierPage struct, and
+// extracts the elements into a slice of FlowClassifier structs.
+func ExtractFlowClassifiers(r pagination.Page) ([]FlowClassifier, error) {
+	var s struct {
+		FlowClassifiers []FlowClassifier `json:"flow_classifiers"`
+	}
+	err := (r.(FlowClassifierPage)).ExtractInto(&s)
+	return s.FlowClassifiers, err
+}
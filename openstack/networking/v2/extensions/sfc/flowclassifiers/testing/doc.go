@@ -0,0 +1,2 @@
+// flowclassifiers unit tests
+package testing
@@ -0,0 +1,196 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/flowclassifiers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const FlowClassifierID = "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+
+const ListResponse = `
+{
+    "flow_classifiers": [
+        {
+            "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+            "name": "web",
+            "description": "",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "ethertype": "IPv4",
+            "protocol": "tcp",
+            "source_port_range_min": 100,
+            "source_port_range_max": 4000,
+            "destination_port_range_min": 80,
+            "destination_port_range_max": 80,
+            "source_ip_prefix": "22.12.34.44/32",
+            "destination_ip_prefix": "22.12.34.45/32",
+            "logical_source_port": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+            "logical_destination_port": null,
+            "l7_parameters": {}
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "flow_classifier": {
+        "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+        "name": "web",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "source_port_range_min": 100,
+        "source_port_range_max": 4000,
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "22.12.34.44/32",
+        "destination_ip_prefix": "22.12.34.45/32",
+        "logical_source_port": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "logical_destination_port": null,
+        "l7_parameters": {}
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "flow_classifier": {
+        "name": "web",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "source_port_range_min": 100,
+        "source_port_range_max": 4000,
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "22.12.34.44/32",
+        "destination_ip_prefix": "22.12.34.45/32",
+        "logical_source_port": "dace4513-24fc-4fae-af4b-321c5e2eb3d1"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "flow_classifier": {
+        "description": "HTTP traffic"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "flow_classifier": {
+        "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+        "name": "web",
+        "description": "HTTP traffic",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "source_port_range_min": 100,
+        "source_port_range_max": 4000,
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "22.12.34.44/32",
+        "destination_ip_prefix": "22.12.34.45/32",
+        "logical_source_port": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "logical_destination_port": null,
+        "l7_parameters": {}
+    }
+}
+`
+
+var FlowClassifier1 = flowclassifiers.FlowClassifier{
+	ID:                      "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+	Name:                    "web",
+	TenantID:                "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:               "d382007aa9904763a801f68ecf065cf5",
+	EtherType:               flowclassifiers.EtherType4,
+	Protocol:                flowclassifiers.ProtocolTCP,
+	SourcePortRangeMin:      100,
+	SourcePortRangeMax:      4000,
+	DestinationPortRangeMin: 80,
+	DestinationPortRangeMax: 80,
+	SourceIPPrefix:          "22.12.34.44/32",
+	DestinationIPPrefix:     "22.12.34.45/32",
+	LogicalSourcePort:       "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	L7Parameters:            map[string]string{},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"protocol": "tcp", "logical_source_port": "dace4513-24fc-4fae-af4b-321c5e2eb3d1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/"+FlowClassifierID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/"+FlowClassifierID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/"+FlowClassifierID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
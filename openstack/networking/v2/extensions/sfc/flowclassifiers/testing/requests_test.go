@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/flowclassifiers"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	listOpts := flowclassifiers.ListOpts{
+		Protocol:          flowclassifiers.ProtocolTCP,
+		LogicalSourcePort: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	}
+
+	count := 0
+	err := flowclassifiers.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := flowclassifiers.ExtractFlowClassifiers(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []flowclassifiers.FlowClassifier{FlowClassifier1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := flowclassifiers.CreateOpts{
+		Name:                    "web",
+		EtherType:               flowclassifiers.EtherType4,
+		Protocol:                flowclassifiers.ProtocolTCP,
+		SourcePortRangeMin:      100,
+		SourcePortRangeMax:      4000,
+		DestinationPortRangeMin: 80,
+		DestinationPortRangeMax: 80,
+		SourceIPPrefix:          "22.12.34.44/32",
+		DestinationIPPrefix:     "22.12.34.45/32",
+		LogicalSourcePort:       "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	}
+	actual, err := flowclassifiers.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FlowClassifier1, *actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := flowclassifiers.Get(context.TODO(), fake.ServiceClient(fakeServer), FlowClassifierID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FlowClassifier1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	description := "HTTP traffic"
+	updateOpts := flowclassifiers.UpdateOpts{
+		Description: &description,
+	}
+	actual, err := flowclassifiers.Update(context.TODO(), fake.ServiceClient(fakeServer), FlowClassifierID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := FlowClassifier1
+	expected.Description = description
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := flowclassifiers.Delete(context.TODO(), fake.ServiceClient(fakeServer), FlowClassifierID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
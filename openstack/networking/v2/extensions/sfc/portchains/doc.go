@@ -0,0 +1,58 @@
+/*
+Package portchains manages and retrieves the port chains of the networking-sfc
+extension of the OpenStack Networking Service. A port chain steers the traffic
+selected by flow classifiers through an ordered list of port pair groups.
+
+Example to List Port Chains
+
+	allPages, err := portchains.List(networkClient, portchains.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allPortChains, err := portchains.ExtractPortChains(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, portChain := range allPortChains {
+		fmt.Printf("%+v\n", portChain)
+	}
+
+Example to Create a Symmetric Port Chain
+
+	createOpts := portchains.CreateOpts{
+		Name:            "firewall-then-ids",
+		PortPairGroups:  []string{firewallsID, idsID},
+		FlowClassifiers: []string{flowClassifierID},
+		ChainParameters: &portchains.ChainParameters{
+			Correlation: portchains.CorrelationMPLS,
+			Symmetric:   true,
+		},
+	}
+
+	portChain, err := portchains.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Flow Classifiers of a Port Chain
+
+	flowClassifiers := []string{flowClassifierID, otherFlowClassifierID}
+	updateOpts := portchains.UpdateOpts{
+		FlowClassifiers: &flowClassifiers,
+	}
+
+	portChain, err := portchains.Update(context.TODO(), networkClient, portChainID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Chain
+
+	err := portchains.Delete(context.TODO(), networkClient, portChainID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portchains
@@ -0,0 +1,175 @@
+package portchains
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Correlation is the encapsulation used to carry the chain information along
+// the service functions of a port chain.
+type Correlation string
+
+const (
+	// CorrelationMPLS is the MPLS encapsulation.
+	CorrelationMPLS Correlation = "mpls"
+
+	// CorrelationNSH is the Network Service Header encapsulation.
+	CorrelationNSH Correlation = "nsh"
+)
+
+// ChainParameters are the parameters of a port chain.
+type ChainParameters struct {
+	// Correlation is the encapsulation of the chain. Neutron defaults to
+	// mpls.
+	Correlation Correlation `json:"correlation,omitempty"`
+
+	// Symmetric steers the reverse traffic through the service functions as
+	// well, in the reverse order.
+	Symmetric bool `json:"symmetric,omitempty"`
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortChainListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port chain attributes you want to see returned.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	ChainID     int    `q:"chain_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortChainListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortChainListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port chains. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToPortChainListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortChainPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortChainCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new port chain.
+type CreateOpts struct {
+	// Name is the human-readable name of the port chain.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the port chain.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the project owner of the port chain. Only administrative users
+	// can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// PortPairGroups are the IDs of the port pair groups the traffic is
+	// steered through, in order.
+	PortPairGroups []string `json:"port_pair_groups" required:"true"`
+
+	// FlowClassifiers are the IDs of the flow classifiers selecting the
+	// traffic of the chain.
+	FlowClassifiers []string `json:"flow_classifiers,omitempty"`
+
+	// ChainParameters are the parameters of the chain.
+	ChainParameters *ChainParameters `json:"chain_parameters,omitempty"`
+
+	// ChainID is the ID of the chain in the data path. It is allocated when
+	// not set.
+	ChainID int `json:"chain_id,omitempty"`
+}
+
+// ToPortChainCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToPortChainCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_chain")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// port chain.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortChainCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular port chain based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortChainUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a port chain.
+type UpdateOpts struct {
+	Name            *string   `json:"name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	PortPairGroups  *[]string `json:"port_pair_groups,omitempty"`
+	FlowClassifiers *[]string `json:"flow_classifiers,omitempty"`
+}
+
+// ToPortChainUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToPortChainUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_chain")
+}
+
+// Update allows port chains to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortChainUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular port chain based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,116 @@
+package portchains
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// PortChain represents the ordered port pair groups the traffic selected
+// by flow classifiers is steered through.
+type PortChain struct {
+	// ID is the unique ID of the port chain.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the port chain.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the port chain.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the port chain.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the port chain.
+	ProjectID string `json:"project_id"`
+
+	// PortPairGroups are the IDs of the port pair groups the traffic is
+	// steered through, in order.
+	PortPairGroups []string `json:"port_pair_groups"`
+
+	// FlowClassifiers are the IDs of the flow classifiers selecting the
+	// traffic of the chain.
+	FlowClassifiers []string `json:"flow_classifiers"`
+
+	// ChainParameters are the parameters of the chain.
+	ChainParameters ChainParameters `json:"chain_parameters"`
+
+	// ChainID is the ID of the chain in the data path.
+	ChainID int `json:"chain_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PortChain.
+func (r commonResult) Extract() (*PortChain, error) {
+	var s struct {
+		PortChain *PortChain `json:"port_chain"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortChain, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortChain.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortChain.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortChain.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortChainPage is the page returned by a pager when traversing over a collection
+// of port chains.
+type PortChainPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port chains has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r PortChainPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_chains_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortChainPage struct is empty.
+func (r PortChainPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortChains(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortChains accepts a Page struct, specifically a PortChainPage struct, and
+// extracts the elements into a slice of PortChain structs.
+func ExtractPortChains(r pagination.Page) ([]PortChain, error) {
+	var s struct {
+		PortChains []PortChain `json:"port_chains"`
+	}
+	err := (r.(PortChainPage)).ExtractInto(&s)
+	return s.PortChains, err
+}
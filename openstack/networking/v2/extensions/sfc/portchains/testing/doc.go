@@ -0,0 +1,2 @@
+// portchains unit tests
+package testing
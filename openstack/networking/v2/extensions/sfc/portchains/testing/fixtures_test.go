@@ -0,0 +1,193 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/portchains"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const PortChainID = "1278dcd4-459f-62ed-754b-87fc5e4a6751"
+
+const ListResponse = `
+{
+    "port_chains": [
+        {
+            "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+            "name": "firewall-then-ids",
+            "description": "",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "port_pair_groups": [
+                "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+                "4a634d49-76dc-4fae-af4b-321c5e23d651"
+            ],
+            "flow_classifiers": ["4a334cd4-fe9c-4fae-af4b-321c5e2eb051"],
+            "chain_parameters": {
+                "correlation": "mpls",
+                "symmetric": true
+            },
+            "chain_id": 3
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "port_chain": {
+        "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+        "name": "firewall-then-ids",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": ["4a334cd4-fe9c-4fae-af4b-321c5e2eb051"],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        },
+        "chain_id": 3
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "port_chain": {
+        "name": "firewall-then-ids",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": ["4a334cd4-fe9c-4fae-af4b-321c5e2eb051"],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_chain": {
+        "flow_classifiers": []
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_chain": {
+        "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+        "name": "firewall-then-ids",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        },
+        "chain_id": 3
+    }
+}
+`
+
+var PortChain1 = portchains.PortChain{
+	ID:        "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+	Name:      "firewall-then-ids",
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+	PortPairGroups: []string{
+		"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+		"4a634d49-76dc-4fae-af4b-321c5e23d651",
+	},
+	FlowClassifiers: []string{"4a334cd4-fe9c-4fae-af4b-321c5e2eb051"},
+	ChainParameters: portchains.ChainParameters{
+		Correlation: portchains.CorrelationMPLS,
+		Symmetric:   true,
+	},
+	ChainID: 3,
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_chains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"chain_id": "3"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_chains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_chains/"+PortChainID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_chains/"+PortChainID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_chains/"+PortChainID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/portchains"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	listOpts := portchains.ListOpts{
+		ChainID: 3,
+	}
+
+	count := 0
+	err := portchains.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := portchains.ExtractPortChains(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []portchains.PortChain{PortChain1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := portchains.CreateOpts{
+		Name: "firewall-then-ids",
+		PortPairGroups: []string{
+			"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+			"4a634d49-76dc-4fae-af4b-321c5e23d651",
+		},
+		FlowClassifiers: []string{"4a334cd4-fe9c-4fae-af4b-321c5e2eb051"},
+		ChainParameters: &portchains.ChainParameters{
+			Correlation: portchains.CorrelationMPLS,
+			Symmetric:   true,
+		},
+	}
+	actual, err := portchains.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PortChain1, *actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := portchains.Get(context.TODO(), fake.ServiceClient(fakeServer), PortChainID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PortChain1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	flowClassifiers := []string{}
+	updateOpts := portchains.UpdateOpts{
+		FlowClassifiers: &flowClassifiers,
+	}
+	actual, err := portchains.Update(context.TODO(), fake.ServiceClient(fakeServer), PortChainID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := PortChain1
+	expected.FlowClassifiers = flowClassifiers
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := portchains.Delete(context.TODO(), fake.ServiceClient(fakeServer), PortChainID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := portchains.CreateOpts{Name: "empty"}.ToPortChainCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
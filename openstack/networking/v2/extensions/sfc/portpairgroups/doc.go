@@ -0,0 +1,74 @@
+/*
+Package portpairgroups manages and retrieves the port pair groups of the
+networking-sfc extension of the OpenStack Networking Service. A port pair group
+is a set of port pairs of equivalent service functions, across which the flows
+are balanced.
+
+Example to List Port Pair Groups
+
+	allPages, err := portpairgroups.List(networkClient, portpairgroups.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allPortPairGroups, err := portpairgroups.ExtractPortPairGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, portPairGroup := range allPortPairGroups {
+		fmt.Printf("%+v\n", portPairGroup)
+	}
+
+Example to Create a Port Pair Group
+
+	createOpts := portpairgroups.CreateOpts{
+		Name:      "firewalls",
+		PortPairs: []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"},
+		PortPairGroupParameters: &portpairgroups.PortPairGroupParameters{
+			LBFields: []string{"ip_src", "ip_dst"},
+		},
+	}
+
+	portPairGroup, err := portpairgroups.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Port Pair Group of Passive IDS Appliances
+
+	tapEnabled := true
+	createOpts := portpairgroups.CreateOpts{
+		Name:       "ids",
+		PortPairs:  []string{"d11e9190-73d4-11e8-b85c-df3a1dc41f93"},
+		TapEnabled: &tapEnabled,
+	}
+
+	portPairGroup, err := portpairgroups.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Port Pairs of a Port Pair Group
+
+	portPairs := []string{
+		"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+		"a2c9b6cd-3c1a-4d9e-9f33-4bb7c5b14ec9",
+	}
+	updateOpts := portpairgroups.UpdateOpts{
+		PortPairs: &portPairs,
+	}
+
+	portPairGroup, err := portpairgroups.Update(context.TODO(), networkClient, portPairGroupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Pair Group
+
+	err := portpairgroups.Delete(context.TODO(), networkClient, portPairGroupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portpairgroups
@@ -0,0 +1,176 @@
+package portpairgroups
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// NTuple is a set of fields of the flows, rewritten by the service functions
+// of a port pair group.
+type NTuple struct {
+	SourceIPPrefix          string `json:"source_ip_prefix,omitempty"`
+	DestinationIPPrefix     string `json:"destination_ip_prefix,omitempty"`
+	SourcePortRangeMin      int    `json:"source_port_range_min,omitempty"`
+	SourcePortRangeMax      int    `json:"source_port_range_max,omitempty"`
+	DestinationPortRangeMin int    `json:"destination_port_range_min,omitempty"`
+	DestinationPortRangeMax int    `json:"destination_port_range_max,omitempty"`
+}
+
+// NTupleMapping maps the fields of the flows entering and leaving the service
+// functions of a port pair group, for the service functions which rewrite
+// them, such as NAT.
+type NTupleMapping struct {
+	IngressNTuple *NTuple `json:"ingress_n_tuple,omitempty"`
+	EgressNTuple  *NTuple `json:"egress_n_tuple,omitempty"`
+}
+
+// PortPairGroupParameters are the parameters of a port pair group.
+type PortPairGroupParameters struct {
+	// LBFields are the fields of the packets used to balance the flows across
+	// the port pairs of the group, such as ip_src or tcp_dst.
+	LBFields []string `json:"lb_fields,omitempty"`
+
+	// PPGNTupleMapping is the mapping of the fields of the flows rewritten by
+	// the service functions.
+	PPGNTupleMapping *NTupleMapping `json:"ppg_n_tuple_mapping,omitempty"`
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortPairGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port pair group attributes you want to see returned.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortPairGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortPairGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port pair groups. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToPortPairGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPairGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortPairGroupCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new port pair group.
+type CreateOpts struct {
+	// Name is the human-readable name of the port pair group.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the port pair group.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the project owner of the port pair group. Only administrative users
+	// can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// PortPairs are the IDs of the port pairs of the group. The flows are
+	// balanced across them.
+	PortPairs []string `json:"port_pairs,omitempty"`
+
+	// PortPairGroupParameters are the parameters of the group.
+	PortPairGroupParameters *PortPairGroupParameters `json:"port_pair_group_parameters,omitempty"`
+
+	// TapEnabled makes the service functions of the group passive, receiving
+	// a copy of the traffic. It is used for IDS appliances.
+	TapEnabled *bool `json:"tap_enabled,omitempty"`
+}
+
+// ToPortPairGroupCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToPortPairGroupCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair_group")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// port pair group.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortPairGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular port pair group based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortPairGroupUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a port pair group.
+type UpdateOpts struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	PortPairs   *[]string `json:"port_pairs,omitempty"`
+}
+
+// ToPortPairGroupUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToPortPairGroupUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair_group")
+}
+
+// Update allows port pair groups to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortPairGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular port pair group based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
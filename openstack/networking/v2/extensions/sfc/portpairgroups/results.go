@@ -0,0 +1,115 @@
+package portpairgroups
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// PortPairGroup represents a group of port pairs of equivalent service
+// functions, across which the flows are balanced.
+type PortPairGroup struct {
+	// ID is the unique ID of the port pair group.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the port pair group.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the port pair group.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the port pair group.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the port pair group.
+	ProjectID string `json:"project_id"`
+
+	// PortPairs are the IDs of the port pairs of the group.
+	PortPairs []string `json:"port_pairs"`
+
+	// PortPairGroupParameters are the parameters of the group.
+	PortPairGroupParameters PortPairGroupParameters `json:"port_pair_group_parameters"`
+
+	// TapEnabled is whether the service functions of the group receive a copy
+	// of the traffic.
+	TapEnabled bool `json:"tap_enabled"`
+
+	// GroupID is the ID of the group in the data path.
+	GroupID int `json:"group_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PortPairGroup.
+func (r commonResult) Extract() (*PortPairGroup, error) {
+	var s struct {
+		PortPairGroup *PortPairGroup `json:"port_pair_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortPairGroup, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortPairGroupPage is the page returned by a pager when traversing over a collection
+// of port pair groups.
+type PortPairGroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port pair groups has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r PortPairGroupPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_pair_groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPairGroupPage struct is empty.
+func (r PortPairGroupPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortPairGroups(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortPairGroups accepts a Page struct, specifically a PortPairGroupPage struct, and
+// extracts the elements into a slice of PortPairGroup structs.
+func ExtractPortPairGroups(r pagination.Page) ([]PortPairGroup, error) {
+	var s struct {
+		PortPairGroups []PortPairGroup `json:"port_pair_groups"`
+	}
+	err := (r.(PortPairGroupPage)).ExtractInto(&s)
+	return s.PortPairGroups, err
+}
@@ -0,0 +1,2 @@
+// portpairgroups unit tests
+package testing
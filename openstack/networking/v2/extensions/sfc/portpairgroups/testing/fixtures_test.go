@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairgroups"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const PortPairGroupID = "4512d643-24fc-4fae-af4b-321c5e2eb3d1"
+
+const ListResponse = `
+{
+    "port_pair_groups": [
+        {
+            "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "name": "ids",
+            "description": "",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "port_pairs": ["78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"],
+            "port_pair_group_parameters": {
+                "lb_fields": ["ip_src", "ip_dst"],
+                "ppg_n_tuple_mapping": {
+                    "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                    "egress_n_tuple": {"source_ip_prefix": "172.16.0.0/24"}
+                }
+            },
+            "tap_enabled": true,
+            "group_id": 1
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "port_pair_group": {
+        "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+        "name": "ids",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "port_pairs": ["78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "172.16.0.0/24"}
+            }
+        },
+        "tap_enabled": true,
+        "group_id": 1
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "port_pair_group": {
+        "name": "ids",
+        "port_pairs": ["78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "172.16.0.0/24"}
+            }
+        },
+        "tap_enabled": true
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_pair_group": {
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "a2c9b6cd-3c1a-4d9e-9f33-4bb7c5b14ec9"
+        ]
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_pair_group": {
+        "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+        "name": "ids",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "a2c9b6cd-3c1a-4d9e-9f33-4bb7c5b14ec9"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "172.16.0.0/24"}
+            }
+        },
+        "tap_enabled": true,
+        "group_id": 1
+    }
+}
+`
+
+var PortPairGroup1 = portpairgroups.PortPairGroup{
+	ID:        "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+	Name:      "ids",
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+	PortPairs: []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"},
+	PortPairGroupParameters: portpairgroups.PortPairGroupParameters{
+		LBFields: []string{"ip_src", "ip_dst"},
+		PPGNTupleMapping: &portpairgroups.NTupleMapping{
+			IngressNTuple: &portpairgroups.NTuple{SourceIPPrefix: "10.0.0.0/24"},
+			EgressNTuple:  &portpairgroups.NTuple{SourceIPPrefix: "172.16.0.0/24"},
+		},
+	},
+	TapEnabled: true,
+	GroupID:    1,
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": "ids"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/"+PortPairGroupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/"+PortPairGroupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/"+PortPairGroupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
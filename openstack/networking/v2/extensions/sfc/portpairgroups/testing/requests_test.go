@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairgroups"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	listOpts := portpairgroups.ListOpts{
+		Name: "ids",
+	}
+
+	count := 0
+	err := portpairgroups.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := portpairgroups.ExtractPortPairGroups(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []portpairgroups.PortPairGroup{PortPairGroup1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	tapEnabled := true
+	createOpts := portpairgroups.CreateOpts{
+		Name:      "ids",
+		PortPairs: []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"},
+		PortPairGroupParameters: &portpairgroups.PortPairGroupParameters{
+			LBFields: []string{"ip_src", "ip_dst"},
+			PPGNTupleMapping: &portpairgroups.NTupleMapping{
+				IngressNTuple: &portpairgroups.NTuple{SourceIPPrefix: "10.0.0.0/24"},
+				EgressNTuple:  &portpairgroups.NTuple{SourceIPPrefix: "172.16.0.0/24"},
+			},
+		},
+		TapEnabled: &tapEnabled,
+	}
+	actual, err := portpairgroups.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PortPairGroup1, *actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := portpairgroups.Get(context.TODO(), fake.ServiceClient(fakeServer), PortPairGroupID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PortPairGroup1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	portPairs := []string{
+		"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+		"a2c9b6cd-3c1a-4d9e-9f33-4bb7c5b14ec9",
+	}
+	updateOpts := portpairgroups.UpdateOpts{
+		PortPairs: &portPairs,
+	}
+	actual, err := portpairgroups.Update(context.TODO(), fake.ServiceClient(fakeServer), PortPairGroupID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := PortPairGroup1
+	expected.PortPairs = portPairs
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := portpairgroups.Delete(context.TODO(), fake.ServiceClient(fakeServer), PortPairGroupID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,171 @@
+package portpairs
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Correlation is the type of the service function chain encapsulation
+// supported by a service function.
+type Correlation string
+
+const (
+	// CorrelationMPLS is the MPLS encapsulation.
+	CorrelationMPLS Correlation = "mpls"
+
+	// CorrelationNSH is the Network Service Header encapsulation.
+	CorrelationNSH Correlation = "nsh"
+)
+
+// ServiceFunctionParameters are the parameters of the service function of a
+// port pair.
+type ServiceFunctionParameters struct {
+	// Correlation is the encapsulation supported by the service function.
+	// It is empty when the service function is not aware of the chain.
+	Correlation Correlation `json:"correlation,omitempty"`
+
+	// Weight is the weight of the port pair in the load balancing of its
+	// port pair group.
+	Weight int `json:"weight,omitempty"`
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortPairListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port pair attributes you want to see returned.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Ingress     string `q:"ingress"`
+	Egress      string `q:"egress"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortPairListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortPairListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port pairs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToPortPairListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPairPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortPairCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new port pair.
+type CreateOpts struct {
+	// Name is the human-readable name of the port pair.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the port pair.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the project owner of the port pair. Only administrative users
+	// can specify a project ID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Ingress is the ID of the port the traffic enters the service function
+	// through.
+	Ingress string `json:"ingress" required:"true"`
+
+	// Egress is the ID of the port the traffic leaves the service function
+	// through. It may be the same as Ingress.
+	Egress string `json:"egress" required:"true"`
+
+	// ServiceFunctionParameters are the parameters of the service function.
+	ServiceFunctionParameters *ServiceFunctionParameters `json:"service_function_parameters,omitempty"`
+}
+
+// ToPortPairCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToPortPairCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// port pair.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortPairCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular port pair based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortPairUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a port pair.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToPortPairUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToPortPairUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair")
+}
+
+// Update allows port pairs to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortPairUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular port pair based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
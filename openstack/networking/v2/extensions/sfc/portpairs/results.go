@@ -0,0 +1,113 @@
+package portpairs
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// PortPair represents the ingress and egress ports of a service function,
+// such as a virtual firewall.
+type PortPair struct {
+	// ID is the unique ID of the port pair.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the port pair.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the port pair.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the port pair.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the port pair.
+	ProjectID string `json:"project_id"`
+
+	// Ingress is the ID of the port the traffic enters the service function
+	// through.
+	Ingress string `json:"ingress"`
+
+	// Egress is the ID of the port the traffic leaves the service function
+	// through.
+	Egress string `json:"egress"`
+
+	// ServiceFunctionParameters are the parameters of the service function.
+	ServiceFunctionParameters ServiceFunctionParameters `json:"service_function_parameters"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PortPair.
+func (r commonResult) Extract() (*PortPair, error) {
+	var s struct {
+		PortPair *PortPair `json:"port_pair"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortPair, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortPair.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortPair.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortPair.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortPairPage is the page returned by a pager when traversing over a collection
+// of port pairs.
+type PortPairPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port pairs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r PortPairPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_pairs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPairPage struct is empty.
+func (r PortPairPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortPairs(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortPairs accepts a Page struct, specifically a PortPairPage struct, and
+// extracts the elements into a slice of PortPair structs.
+func ExtractPortPairs(r pagination.Page) ([]PortPair, error) {
+	var s struct {
+		PortPairs []PortPair `json:"port_pairs"`
+	}
+	err := (r.(PortPairPage)).ExtractInto(&s)
+	return s.PortPairs, err
+}
@@ -0,0 +1,2 @@
+// portpairs unit tests
+package testing
@@ -0,0 +1,175 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairs"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const PortPairID = "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"
+
+const ListResponse = `
+{
+    "port_pairs": [
+        {
+            "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "name": "firewall",
+            "description": "",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+            "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+            "service_function_parameters": {
+                "correlation": "mpls",
+                "weight": 1
+            }
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "port_pair": {
+        "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+        "name": "firewall",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        }
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "port_pair": {
+        "name": "firewall",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_pair": {
+        "name": "ids",
+        "description": "intrusion detection"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_pair": {
+        "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+        "name": "ids",
+        "description": "intrusion detection",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        }
+    }
+}
+`
+
+var PortPair1 = portpairs.PortPair{
+	ID:        "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+	Name:      "firewall",
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+	Ingress:   "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	Egress:    "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+	ServiceFunctionParameters: portpairs.ServiceFunctionParameters{
+		Correlation: portpairs.CorrelationMPLS,
+		Weight:      1,
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pairs/"+PortPairID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pairs/"+PortPairID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/port_pairs/"+PortPairID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairs"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	listOpts := portpairs.ListOpts{
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	}
+
+	count := 0
+	err := portpairs.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := portpairs.ExtractPortPairs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []portpairs.PortPair{PortPair1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := portpairs.CreateOpts{
+		Name:    "firewall",
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+		Egress:  "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+		ServiceFunctionParameters: &portpairs.ServiceFunctionParameters{
+			Correlation: portpairs.CorrelationMPLS,
+			Weight:      1,
+		},
+	}
+	actual, err := portpairs.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PortPair1, *actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := portpairs.Get(context.TODO(), fake.ServiceClient(fakeServer), PortPairID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PortPair1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	name := "ids"
+	description := "intrusion detection"
+	updateOpts := portpairs.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+	actual, err := portpairs.Update(context.TODO(), fake.ServiceClient(fakeServer), PortPairID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := PortPair1
+	expected.Name = name
+	expected.Description = description
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := portpairs.Delete(context.TODO(), fake.ServiceClient(fakeServer), PortPairID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := portpairs.CreateOpts{Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1"}.ToPortPairCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
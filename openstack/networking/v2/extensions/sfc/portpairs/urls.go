@@ -0,0 +1,16 @@
+package portpairs
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	rootPath     = "sfc"
+	resourcePath = "port_pairs"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
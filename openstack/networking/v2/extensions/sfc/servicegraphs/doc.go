@@ -0,0 +1,44 @@
+/*
+Package servicegraphs manages and retrieves the service graphs of the
+networking-sfc extension of the OpenStack Networking Service. A service graph
+links port chains, so that the traffic leaving a chain continues through the
+next ones.
+
+Example to List Service Graphs
+
+	allPages, err := servicegraphs.List(networkClient, servicegraphs.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allServiceGraphs, err := servicegraphs.ExtractServiceGraphs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, serviceGraph := range allServiceGraphs {
+		fmt.Printf("%+v\n", serviceGraph)
+	}
+
+Example to Create a Service Graph
+
+	createOpts := servicegraphs.CreateOpts{
+		Name: "branching",
+		PortChains: map[string][]string{
+			ingressChainID: {webChainID, dbChainID},
+		},
+	}
+
+	serviceGraph, err := servicegraphs.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Graph
+
+	err := servicegraphs.Delete(context.TODO(), networkClient, serviceGraphID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package servicegraphs
@@ -0,0 +1,106 @@
+package servicegraphs
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ServiceGraph represents dependencies between port chains, so that the
+// traffic leaving a chain continues through the next ones.
+type ServiceGraph struct {
+	// ID is the unique ID of the service graph.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the service graph.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the service graph.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the service graph.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the service graph.
+	ProjectID string `json:"project_id"`
+
+	// PortChains maps the ID of each port chain of the graph to the IDs of the
+	// port chains its traffic continues through.
+	PortChains map[string][]string `json:"port_chains"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a ServiceGraph.
+func (r commonResult) Extract() (*ServiceGraph, error) {
+	var s struct {
+		ServiceGraph *ServiceGraph `json:"service_graph"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceGraph, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a ServiceGraph.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ServiceGraph.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a ServiceGraph.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ServiceGraphPage is the page returned by a pager when traversing over a collection
+// of service graphs.
+type ServiceGraphPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of service graphs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r ServiceGraphPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"service_graphs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ServiceGraphPage struct is empty.
+func (r ServiceGraphPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractServiceGraphs(r)
+	return len(is) == 0, err
+}
+
+// ExtractServiceGraphs accepts a Page struct, specifically a ServiceGraphPage struct, and
+// extracts the elements into a slice of ServiceGraph structs.
+func ExtractServiceGraphs(r pagination.Page) ([]ServiceGraph, error) {
+	var s struct {
+		ServiceGraphs []ServiceGraph `json:"service_graphs"`
+	}
+	err := (r.(ServiceGraphPage)).ExtractInto(&s)
+	return s.ServiceGraphs, err
+}
@@ -0,0 +1,2 @@
+// servicegraphs unit tests
+package testing
@@ -0,0 +1,174 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/servicegraphs"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const ServiceGraphID = "0e6b9678-19aa-11e8-a2e4-bb1f2ecb96b1"
+
+const ListResponse = `
+{
+    "service_graphs": [
+        {
+            "id": "0e6b9678-19aa-11e8-a2e4-bb1f2ecb96b1",
+            "name": "branching",
+            "description": "",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "port_chains": {
+                "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                    "8f0a2c5d-6e4b-4c1a-9d3e-7b5f1a2c4e6d",
+                    "3b7d9f1e-2a4c-4e6b-8d0f-1c3e5a7b9d2f"
+                ]
+            }
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "service_graph": {
+        "id": "0e6b9678-19aa-11e8-a2e4-bb1f2ecb96b1",
+        "name": "branching",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "8f0a2c5d-6e4b-4c1a-9d3e-7b5f1a2c4e6d",
+                "3b7d9f1e-2a4c-4e6b-8d0f-1c3e5a7b9d2f"
+            ]
+        }
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "service_graph": {
+        "name": "branching",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "8f0a2c5d-6e4b-4c1a-9d3e-7b5f1a2c4e6d",
+                "3b7d9f1e-2a4c-4e6b-8d0f-1c3e5a7b9d2f"
+            ]
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "service_graph": {
+        "name": "web-and-db"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "service_graph": {
+        "id": "0e6b9678-19aa-11e8-a2e4-bb1f2ecb96b1",
+        "name": "web-and-db",
+        "description": "",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "8f0a2c5d-6e4b-4c1a-9d3e-7b5f1a2c4e6d",
+                "3b7d9f1e-2a4c-4e6b-8d0f-1c3e5a7b9d2f"
+            ]
+        }
+    }
+}
+`
+
+var ServiceGraph1 = servicegraphs.ServiceGraph{
+	ID:        "0e6b9678-19aa-11e8-a2e4-bb1f2ecb96b1",
+	Name:      "branching",
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+	PortChains: map[string][]string{
+		"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+			"8f0a2c5d-6e4b-4c1a-9d3e-7b5f1a2c4e6d",
+			"3b7d9f1e-2a4c-4e6b-8d0f-1c3e5a7b9d2f",
+		},
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/service_graphs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"project_id": "d382007aa9904763a801f68ecf065cf5"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/service_graphs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/service_graphs/"+ServiceGraphID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/service_graphs/"+ServiceGraphID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/sfc/service_graphs/"+ServiceGraphID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
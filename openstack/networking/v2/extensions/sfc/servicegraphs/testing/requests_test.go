@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/sfc/servicegraphs"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	listOpts := servicegraphs.ListOpts{
+		ProjectID: "d382007aa9904763a801f68ecf065cf5",
+	}
+
+	count := 0
+	err := servicegraphs.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := servicegraphs.ExtractServiceGraphs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []servicegraphs.ServiceGraph{ServiceGraph1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := servicegraphs.CreateOpts{
+		Name: "branching",
+		PortChains: map[string][]string{
+			"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+				"8f0a2c5d-6e4b-4c1a-9d3e-7b5f1a2c4e6d",
+				"3b7d9f1e-2a4c-4e6b-8d0f-1c3e5a7b9d2f",
+			},
+		},
+	}
+	actual, err := servicegraphs.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ServiceGraph1, *actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := servicegraphs.Get(context.TODO(), fake.ServiceClient(fakeServer), ServiceGraphID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ServiceGraph1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUpdateSuccessfully(t, fakeServer)
+
+	name := "web-and-db"
+	updateOpts := servicegraphs.UpdateOpts{
+		Name: &name,
+	}
+	actual, err := servicegraphs.Update(context.TODO(), fake.ServiceClient(fakeServer), ServiceGraphID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := ServiceGraph1
+	expected.Name = name
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDeleteSuccessfully(t, fakeServer)
+
+	err := servicegraphs.Delete(context.TODO(), fake.ServiceClient(fakeServer), ServiceGraphID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
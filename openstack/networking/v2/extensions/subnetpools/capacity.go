@@ -0,0 +1,153 @@
+package subnetpools
+
+import (
+	"context"
+	"math/big"
+	"net/netip"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// Capacity represents the prefixes of a subnetpool consumed by its subnets
+// and the ones still free.
+type Capacity struct {
+	// SubnetPool is the inspected subnetpool.
+	SubnetPool SubnetPool
+
+	// UsedPrefixes are the CIDRs of the subnets allocated from the
+	// subnetpool.
+	UsedPrefixes []string
+
+	// FreePrefixes are the largest prefixes of the subnetpool not overlapping
+	// any of its subnets, in address order.
+	FreePrefixes []string
+}
+
+// Available returns the number of subnets of the given prefix length which
+// can still be allocated from the subnetpool. It returns zero when the prefix
+// length is outside of the bounds of the subnetpool.
+func (c Capacity) Available(prefixLen int) *big.Int {
+	n := new(big.Int)
+	if prefixLen < c.SubnetPool.MinPrefixLen || (c.SubnetPool.MaxPrefixLen > 0 && prefixLen > c.SubnetPool.MaxPrefixLen) {
+		return n
+	}
+	for _, free := range c.FreePrefixes {
+		p, err := netip.ParsePrefix(free)
+		if err != nil || p.Bits() > prefixLen || prefixLen > p.Addr().BitLen() {
+			continue
+		}
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), uint(prefixLen-p.Bits())))
+	}
+	return n
+}
+
+// GetCapacity retrieves a subnetpool and the subnets allocated from it, and
+// computes its free prefixes.
+//
+// The subnets are the ones the caller can list. For a shared subnetpool, the
+// subnets of other projects are only visible to an administrator, so for
+// other callers UsedPrefixes misses them, and FreePrefixes and Available
+// overstate the remaining capacity.
+func GetCapacity(ctx context.Context, c *gophercloud.ServiceClient, id string) (*Capacity, error) {
+	pool, err := Get(ctx, c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err := subnets.List(c, subnets.ListOpts{SubnetPoolID: id}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := &Capacity{SubnetPool: *pool}
+	for _, subnet := range allSubnets {
+		capacity.UsedPrefixes = append(capacity.UsedPrefixes, subnet.CIDR)
+	}
+
+	capacity.FreePrefixes, err = FreePrefixes(pool.Prefixes, capacity.UsedPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	return capacity, nil
+}
+
+// FreePrefixes returns the largest prefixes contained in prefixes which
+// don't overlap any of the used prefixes, in address order.
+func FreePrefixes(prefixes, used []string) ([]string, error) {
+	parse := func(cidrs []string) ([]netip.Prefix, error) {
+		parsed := make([]netip.Prefix, len(cidrs))
+		for i, cidr := range cidrs {
+			p, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return nil, err
+			}
+			parsed[i] = p.Masked()
+		}
+		return parsed, nil
+	}
+
+	pools, err := parse(prefixes)
+	if err != nil {
+		return nil, err
+	}
+	allocated, err := parse(used)
+	if err != nil {
+		return nil, err
+	}
+
+	var free []netip.Prefix
+	for _, p := range pools {
+		free = append(free, subtractPrefixes(p, allocated)...)
+	}
+
+	sort.Slice(free, func(i, j int) bool {
+		if c := free[i].Addr().Compare(free[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return free[i].Bits() < free[j].Bits()
+	})
+
+	freeCIDRs := make([]string, len(free))
+	for i, p := range free {
+		freeCIDRs[i] = p.String()
+	}
+	return freeCIDRs, nil
+}
+
+// subtractPrefixes returns the largest prefixes contained in p which don't
+// overlap any of the used prefixes.
+func subtractPrefixes(p netip.Prefix, used []netip.Prefix) []netip.Prefix {
+	overlapping := false
+	for _, u := range used {
+		if !u.Overlaps(p) {
+			continue
+		}
+		if u.Bits() <= p.Bits() {
+			// p is entirely used.
+			return nil
+		}
+		overlapping = true
+	}
+	if !overlapping {
+		return []netip.Prefix{p}
+	}
+
+	low, high := splitPrefix(p)
+	return append(subtractPrefixes(low, used), subtractPrefixes(high, used)...)
+}
+
+// splitPrefix splits a prefix into its two halves.
+func splitPrefix(p netip.Prefix) (netip.Prefix, netip.Prefix) {
+	bits := p.Bits()
+	b := p.Addr().AsSlice()
+	b[bits/8] |= 0x80 >> (bits % 8)
+	highAddr, _ := netip.AddrFromSlice(b)
+	return netip.PrefixFrom(p.Addr(), bits+1), netip.PrefixFrom(highAddr, bits+1)
+}
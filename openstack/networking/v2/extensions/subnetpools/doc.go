@@ -68,5 +68,30 @@ Example to Delete a Subnetpool
 	if err != nil {
 		panic(err)
 	}
+
+Example to Onboard the Subnets of a Network into a Subnetpool
+
+	subnetPoolID := "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55"
+	onboardOpts := subnetpools.OnboardNetworkSubnetsOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	}
+
+	onboarded, err := subnetpools.OnboardNetworkSubnets(context.TODO(), networkClient, subnetPoolID, onboardOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v\n", onboarded.CIDRs)
+
+Example to Inspect the Free Prefixes of a Subnetpool
+
+	subnetPoolID := "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55"
+	capacity, err := subnetpools.GetCapacity(context.TODO(), networkClient, subnetPoolID)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("free prefixes: %v\n", capacity.FreePrefixes)
+	fmt.Printf("/64 subnets available: %s\n", capacity.Available(64))
 */
 package subnetpools
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
@@ -238,6 +239,7 @@ func Update(ctx context.Context, c *gophercloud.ServiceClient, subnetPoolID stri
 		OkCodes:     []int{200},
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
 	return
 }
 
@@ -287,6 +289,41 @@ func RemovePrefixes(ctx context.Context, c *gophercloud.ServiceClient, subnetPoo
 	return
 }
 
+// OnboardNetworkSubnetsOptsBuilder allows extensions to add additional
+// parameters to the OnboardNetworkSubnets request.
+type OnboardNetworkSubnetsOptsBuilder interface {
+	ToSubnetPoolOnboardNetworkSubnetsMap() (map[string]any, error)
+}
+
+// OnboardNetworkSubnetsOpts represents the network whose subnets are
+// onboarded.
+type OnboardNetworkSubnetsOpts struct {
+	// NetworkID is the ID of the network whose subnets are onboarded.
+	NetworkID string `json:"network_id" required:"true"`
+}
+
+// ToSubnetPoolOnboardNetworkSubnetsMap builds a request body from
+// OnboardNetworkSubnetsOpts.
+func (opts OnboardNetworkSubnetsOpts) ToSubnetPoolOnboardNetworkSubnetsMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// OnboardNetworkSubnets associates the subnets of a network which don't
+// belong to any subnetpool with an existing subnetpool. Their prefixes are
+// added to the subnetpool.
+func OnboardNetworkSubnets(ctx context.Context, c *gophercloud.ServiceClient, subnetPoolID string, opts OnboardNetworkSubnetsOptsBuilder) (r OnboardNetworkSubnetsResult) {
+	b, err := opts.ToSubnetPoolOnboardNetworkSubnetsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, onboardNetworkSubnetsURL(c, subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Delete accepts a unique ID and deletes the subnetpool associated with it.
 func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
 	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
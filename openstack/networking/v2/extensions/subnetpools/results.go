@@ -56,6 +56,29 @@ type PrefixesOpsResult struct {
 	commonResult
 }
 
+// OnboardNetworkSubnetsResult represents the result of an onboard network
+// subnets operation. Call its Extract method to interpret it as an
+// OnboardedSubnets.
+type OnboardNetworkSubnetsResult struct {
+	gophercloud.Result
+}
+
+// OnboardedSubnets represents the subnets onboarded into a subnetpool.
+type OnboardedSubnets struct {
+	// NetworkID is the ID of the network whose subnets were onboarded.
+	NetworkID string `json:"network_id"`
+
+	// CIDRs are the prefixes of the onboarded subnets.
+	CIDRs []string `json:"cidrs"`
+}
+
+// Extract interprets an OnboardNetworkSubnetsResult as an OnboardedSubnets.
+func (r OnboardNetworkSubnetsResult) Extract() (*OnboardedSubnets, error) {
+	var s OnboardedSubnets
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
 // DeleteResult represents the result of a delete operation. Call its
 // ExtractErr method to determine if the request succeeded or failed.
 type DeleteResult struct {
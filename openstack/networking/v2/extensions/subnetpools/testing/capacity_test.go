@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestFreePrefixes(t *testing.T) {
+	free, err := subnetpools.FreePrefixes(
+		[]string{"2001:db8::a3/48", "10.0.0.0/22"},
+		[]string{"10.0.1.0/24", "2001:db8:0:1::/64", "192.168.0.0/24"},
+	)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{
+		"10.0.0.0/24",
+		"10.0.2.0/23",
+		"2001:db8::/64",
+		"2001:db8:0:2::/63",
+		"2001:db8:0:4::/62",
+		"2001:db8:0:8::/61",
+		"2001:db8:0:10::/60",
+		"2001:db8:0:20::/59",
+		"2001:db8:0:40::/58",
+		"2001:db8:0:80::/57",
+		"2001:db8:0:100::/56",
+		"2001:db8:0:200::/55",
+		"2001:db8:0:400::/54",
+		"2001:db8:0:800::/53",
+		"2001:db8:0:1000::/52",
+		"2001:db8:0:2000::/51",
+		"2001:db8:0:4000::/50",
+		"2001:db8:0:8000::/49",
+	}, free)
+
+	free, err = subnetpools.FreePrefixes([]string{"10.0.0.0/24"}, []string{"10.0.0.0/16"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(free))
+
+	_, err = subnetpools.FreePrefixes([]string{"10.0.0.0/33"}, nil)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestGetCapacity(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, CapacitySubnetPoolGetResult)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"subnetpool_id": "099546ca-788d-41e5-a76d-17d8cd282d3e"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, CapacitySubnetsListResult)
+	})
+
+	capacity, err := subnetpools.GetCapacity(context.TODO(), fake.ServiceClient(fakeServer), "099546ca-788d-41e5-a76d-17d8cd282d3e")
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "my-ipv4-pool", capacity.SubnetPool.Name)
+	th.CheckDeepEquals(t, []string{"10.0.1.0/24", "10.8.0.0/26"}, capacity.UsedPrefixes)
+	th.CheckDeepEquals(t, []string{"10.0.0.0/24", "10.0.2.0/23", "10.8.0.64/26", "10.8.0.128/25"}, capacity.FreePrefixes)
+
+	th.AssertEquals(t, "3", capacity.Available(24).String())
+	th.AssertEquals(t, "15", capacity.Available(26).String())
+	th.AssertEquals(t, "0", capacity.Available(16).String())
+	th.AssertEquals(t, "0", capacity.Available(29).String())
+}
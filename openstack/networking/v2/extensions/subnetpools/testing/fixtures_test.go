@@ -324,3 +324,49 @@ const PrefixesRemoveResponse = `
  "prefixes": ["192.168.1.0/24", "172.16.0.0/21"]
 }
 `
+
+const OnboardNetworkSubnetsRequest = `
+{
+    "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"
+}
+`
+
+const OnboardNetworkSubnetsResponse = `
+{
+    "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "cidrs": ["192.168.0.0/24", "192.168.1.0/24"]
+}
+`
+
+const CapacitySubnetPoolGetResult = `
+{
+    "subnetpool": {
+        "id": "099546ca-788d-41e5-a76d-17d8cd282d3e",
+        "name": "my-ipv4-pool",
+        "ip_version": 4,
+        "min_prefixlen": "24",
+        "max_prefixlen": "28",
+        "default_prefixlen": "24",
+        "prefixes": ["10.0.0.0/22", "10.8.0.0/24"]
+    }
+}
+`
+
+const CapacitySubnetsListResult = `
+{
+    "subnets": [
+        {
+            "id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+            "cidr": "10.0.1.0/24",
+            "ip_version": 4,
+            "subnetpool_id": "099546ca-788d-41e5-a76d-17d8cd282d3e"
+        },
+        {
+            "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+            "cidr": "10.8.0.0/26",
+            "ip_version": 4,
+            "subnetpool_id": "099546ca-788d-41e5-a76d-17d8cd282d3e"
+        }
+    ]
+}
+`
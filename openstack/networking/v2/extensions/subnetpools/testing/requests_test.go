@@ -289,3 +289,29 @@ func TestRemovePrefixes(t *testing.T) {
 
 	th.AssertDeepEquals(t, []string{"192.168.1.0/24", "172.16.0.0/21"}, n)
 }
+
+func TestOnboardNetworkSubnets(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e/onboard_network_subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, OnboardNetworkSubnetsRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, OnboardNetworkSubnetsResponse)
+	})
+
+	s, err := subnetpools.OnboardNetworkSubnets(context.TODO(), fake.ServiceClient(fakeServer), "099546ca-788d-41e5-a76d-17d8cd282d3e", subnetpools.OnboardNetworkSubnetsOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", s.NetworkID)
+	th.AssertDeepEquals(t, []string{"192.168.0.0/24", "192.168.1.0/24"}, s.CIDRs)
+}
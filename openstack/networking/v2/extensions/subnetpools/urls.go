@@ -39,3 +39,7 @@ func addPrefixesURL(c *gophercloud.ServiceClient, id string) string {
 func removePrefixesURL(c *gophercloud.ServiceClient, id string) string {
 	return resourceURL(c, id) + "/remove_prefixes"
 }
+
+func onboardNetworkSubnetsURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id) + "/onboard_network_subnets"
+}
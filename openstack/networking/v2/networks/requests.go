@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
@@ -109,6 +110,26 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk accepts a slice of CreateOpts structs, or of any other
+// CreateOptsBuilder such as the ones of the extensions, and creates all the
+// networks in a single request. Neutron creates either all of them or none.
+// The networks are returned in the order of opts.
+func CreateBulk[createOpts CreateOptsBuilder](ctx context.Context, c *gophercloud.ServiceClient, opts []createOpts) (r CreateBulkResult) {
+	networks := make([]any, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToNetworkCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		networks[i] = b["network"]
+	}
+	b := map[string]any{"networks": networks}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -156,6 +177,7 @@ func Update(ctx context.Context, c *gophercloud.ServiceClient, networkID string,
 		OkCodes:     []int{200, 201},
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
 	return
 }
 
@@ -29,6 +29,26 @@ type CreateResult struct {
 	commonResult
 }
 
+// CreateBulkResult represents the result of a bulk create operation. Call its
+// Extract method to interpret it as a slice of Networks.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the created
+// network resources, in the order of the request.
+func (r CreateBulkResult) Extract() ([]Network, error) {
+	var s []Network
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExtractInto extracts the created networks into a pointer to a slice of
+// structs, which can embed Network along with the fields of extensions.
+func (r CreateBulkResult) ExtractInto(v any) error {
+	return r.ExtractIntoSlicePtr(v, "networks")
+}
+
 // GetResult represents the result of a get operation. Call its Extract
 // method to interpret it as a Network.
 type GetResult struct {
@@ -131,6 +131,46 @@ const CreatePortSecurityResponse = `
     }
 }`
 
+const CreateBulkRequest = `
+{
+    "networks": [
+        {
+            "name": "private",
+            "admin_state_up": true
+        },
+        {
+            "name": "storage",
+            "port_security_enabled": false
+        }
+    ]
+}`
+
+const CreateBulkResponse = `
+{
+    "networks": [
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "private",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "port_security_enabled": true
+        },
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "storage",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "8ae5ee0a-5f06-4a41-9a3b-2ca4e6d1c1b2",
+            "port_security_enabled": false
+        }
+    ]
+}`
+
 const CreateOptionalFieldsRequest = `
 {
   "network": {
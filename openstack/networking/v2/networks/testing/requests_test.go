@@ -164,6 +164,47 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, "2019-06-30T05:18:49Z", n.UpdatedAt.Format(time.RFC3339))
 }
 
+func TestCreateBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateBulkRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBulkResponse)
+	})
+
+	iTrue := true
+	iFalse := false
+	opts := []networks.CreateOptsBuilder{
+		networks.CreateOpts{Name: "private", AdminStateUp: &iTrue},
+		portsecurity.NetworkCreateOptsExt{
+			CreateOptsBuilder:   networks.CreateOpts{Name: "storage"},
+			PortSecurityEnabled: &iFalse,
+		},
+	}
+
+	var networksWithExt []struct {
+		networks.Network
+		portsecurity.PortSecurityExt
+	}
+	err := networks.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), opts).ExtractInto(&networksWithExt)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(networksWithExt))
+	th.AssertEquals(t, "private", networksWithExt[0].Name)
+	th.AssertTrue(t, networksWithExt[0].PortSecurityEnabled)
+	th.AssertEquals(t, "storage", networksWithExt[1].Name)
+	th.AssertEquals(t, "8ae5ee0a-5f06-4a41-9a3b-2ca4e6d1c1b2", networksWithExt[1].ID)
+	th.AssertFalse(t, networksWithExt[1].PortSecurityEnabled)
+}
+
 func TestCreateWithOptionalFields(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
@@ -48,6 +48,24 @@ Example to Create a Port
 		panic(err)
 	}
 
+Example to Create Ports in Bulk
+
+	var createOpts []ports.CreateOptsBuilder
+	for _, host := range []string{"compute-1", "compute-2"} {
+		createOpts = append(createOpts, portsbinding.CreateOptsExt{
+			CreateOptsBuilder: ports.CreateOpts{
+				Name:      "node-" + host,
+				NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			},
+			HostID: host,
+		})
+	}
+
+	allPorts, err := ports.CreateBulk(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Port
 
 	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
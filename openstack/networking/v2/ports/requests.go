@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/revisions"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
@@ -179,6 +180,26 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk accepts a slice of CreateOpts structs, or of any other
+// CreateOptsBuilder such as the ones of the extensions, and creates all the
+// ports in a single request. Neutron creates either all of them or none.
+// The ports are returned in the order of opts.
+func CreateBulk[createOpts CreateOptsBuilder](ctx context.Context, c *gophercloud.ServiceClient, opts []createOpts) (r CreateBulkResult) {
+	ports := make([]any, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToPortCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		ports[i] = b["port"]
+	}
+	b := map[string]any{"ports": ports}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -237,6 +258,7 @@ func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts U
 		OkCodes:     []int{200, 201},
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = revisions.CheckPrecondition(r.Err, h)
 	return
 }
 
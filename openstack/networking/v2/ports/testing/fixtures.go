@@ -391,6 +391,76 @@ const CreatePortSecurityResponse = `
 }
 `
 
+const CreateBulkRequest = `
+{
+    "ports": [
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "node-1",
+            "admin_state_up": true,
+            "binding:host_id": "compute-1",
+            "binding:vnic_type": "direct"
+        },
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "node-2",
+            "port_security_enabled": false
+        }
+    ]
+}
+`
+
+const CreateBulkResponse = `
+{
+    "ports": [
+        {
+            "status": "DOWN",
+            "name": "node-1",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "device_owner": "",
+            "mac_address": "fa:16:3e:c9:cb:f0",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.2"
+                }
+            ],
+            "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+            "security_groups": [
+                "f0ac4394-7e4a-4409-9701-ba8be283dbc3"
+            ],
+            "device_id": "",
+            "binding:host_id": "compute-1",
+            "binding:vnic_type": "direct",
+            "port_security_enabled": true
+        },
+        {
+            "status": "DOWN",
+            "name": "node-2",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "device_owner": "",
+            "mac_address": "fa:16:3e:0a:4f:7c",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.3"
+                }
+            ],
+            "id": "d4f2e3a1-6c8b-4f6e-9a2d-3b1c5e7f9a0b",
+            "security_groups": [],
+            "device_id": "",
+            "binding:host_id": "",
+            "binding:vnic_type": "normal",
+            "port_security_enabled": false
+        }
+    ]
+}
+`
+
 const UpdateRequest = `
 {
     "port": {
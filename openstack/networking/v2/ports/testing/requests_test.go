@@ -10,6 +10,7 @@ import (
 
 	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/extradhcpopts"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsbinding"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/v2/pagination"
@@ -520,6 +521,80 @@ func TestCreatePortSecurity(t *testing.T) {
 	th.AssertFalse(t, portWithExt.PortSecurityEnabled)
 }
 
+func TestCreateBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateBulkRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBulkResponse)
+	})
+
+	asu := true
+	iFalse := false
+	opts := []ports.CreateOptsBuilder{
+		portsbinding.CreateOptsExt{
+			CreateOptsBuilder: ports.CreateOpts{
+				Name:         "node-1",
+				AdminStateUp: &asu,
+				NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			},
+			HostID:   "compute-1",
+			VNICType: "direct",
+		},
+		portsecurity.PortCreateOptsExt{
+			CreateOptsBuilder: ports.CreateOpts{
+				Name:      "node-2",
+				NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			},
+			PortSecurityEnabled: &iFalse,
+		},
+	}
+
+	n, err := ports.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(n))
+	th.AssertEquals(t, "node-1", n[0].Name)
+	th.AssertEquals(t, "65c0ee9f-d634-4522-8954-51021b570b0d", n[0].ID)
+	th.AssertEquals(t, "node-2", n[1].Name)
+	th.AssertEquals(t, "10.0.0.3", n[1].FixedIPs[0].IPAddress)
+
+	var portsWithExt []struct {
+		ports.Port
+		portsbinding.PortsBindingExt
+		portsecurity.PortSecurityExt
+	}
+	err = ports.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), opts).ExtractInto(&portsWithExt)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(portsWithExt))
+	th.AssertEquals(t, "compute-1", portsWithExt[0].HostID)
+	th.AssertEquals(t, "direct", portsWithExt[0].VNICType)
+	th.AssertEquals(t, true, portsWithExt[0].PortSecurityEnabled)
+	th.AssertEquals(t, "normal", portsWithExt[1].VNICType)
+	th.AssertFalse(t, portsWithExt[1].PortSecurityEnabled)
+}
+
+func TestCreateBulkRequiredOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	res := ports.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), []ports.CreateOpts{
+		{NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7"},
+		{},
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
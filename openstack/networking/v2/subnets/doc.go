@@ -52,6 +52,26 @@ Example to Create a Subnet With Specified Gateway
 		panic(err)
 	}
 
+Example to Create Subnets in Bulk
+
+	createOpts := []subnets.CreateOpts{
+		{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+		},
+		{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 6,
+			CIDR:      "fdf8:f53b:82e4::/64",
+		},
+	}
+
+	allSubnets, err := subnets.CreateBulk(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Subnet With No Gateway
 
 	var noGateway = ""
@@ -134,5 +154,26 @@ Example to Delete a Subnet
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create the Subnets of a Dual-Stack Network
+
+	dualStackOpts := subnets.DualStackOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		IPv4: subnets.CreateOpts{
+			Name: "my_subnet_v4",
+			CIDR: "192.168.199.0/24",
+		},
+		IPv6: subnets.CreateOpts{
+			Name:            "my_subnet_v6",
+			SubnetPoolID:    subnets.PrefixDelegationSubnetPoolID,
+			IPv6AddressMode: subnets.IPv6SLAAC,
+			IPv6RAMode:      subnets.IPv6SLAAC,
+		},
+	}
+
+	allSubnets, err := subnets.CreateDualStack(context.TODO(), networkClient, dualStackOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package subnets
@@ -0,0 +1,152 @@
+package subnets
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// IPv6 address and router advertisement modes of a subnet.
+const (
+	IPv6SLAAC           = "slaac"
+	IPv6DHCPv6Stateful  = "dhcpv6-stateful"
+	IPv6DHCPv6Stateless = "dhcpv6-stateless"
+)
+
+// PrefixDelegationSubnetPoolID is the SubnetPoolID requesting the prefix of
+// an IPv6 subnet through prefix delegation.
+const PrefixDelegationSubnetPoolID = "prefix_delegation"
+
+// DualStackOpts represents the IPv4 and IPv6 subnets of a dual-stack network.
+// Its options are validated before any request is sent, so that invalid
+// combinations of IPv6 modes and prefixes are reported without creating any
+// subnet.
+type DualStackOpts struct {
+	// NetworkID is the UUID of the network the subnets will be associated
+	// with. It overrides the NetworkID of IPv4 and IPv6.
+	NetworkID string
+
+	// IPv4 is the IPv4 subnet. Its IPVersion is set to 4.
+	IPv4 CreateOpts
+
+	// IPv6 is the IPv6 subnet. Its IPVersion is set to 6.
+	IPv6 CreateOpts
+}
+
+// ToSubnetCreateOptsList validates the DualStackOpts and returns the
+// CreateOpts of the IPv4 and IPv6 subnets, in this order.
+func (opts DualStackOpts) ToSubnetCreateOptsList() ([]CreateOpts, error) {
+	if opts.NetworkID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "subnets.DualStackOpts.NetworkID"
+		return nil, err
+	}
+
+	v4, v6 := opts.IPv4, opts.IPv6
+	v4.NetworkID, v4.IPVersion = opts.NetworkID, gophercloud.IPv4
+	v6.NetworkID, v6.IPVersion = opts.NetworkID, gophercloud.IPv6
+
+	if err := ValidateIPv4(v4); err != nil {
+		return nil, err
+	}
+	if err := ValidateIPv6(v6); err != nil {
+		return nil, err
+	}
+
+	return []CreateOpts{v4, v6}, nil
+}
+
+// CreateDualStack validates the DualStackOpts and creates the IPv4 and IPv6
+// subnets of a network in a single bulk request. Neutron creates either both
+// subnets or none.
+func CreateDualStack(ctx context.Context, c *gophercloud.ServiceClient, opts DualStackOpts) (r CreateBulkResult) {
+	createOpts, err := opts.ToSubnetCreateOptsList()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	return CreateBulk(ctx, c, createOpts)
+}
+
+// ValidateIPv4 checks that the CreateOpts of an IPv4 subnet have an IPv4
+// CIDR and no IPv6 mode.
+func ValidateIPv4(opts CreateOpts) error {
+	if opts.IPv6AddressMode != "" {
+		return invalidSubnetInput("IPv6AddressMode", opts.IPv6AddressMode, "IPv6 modes can't be set on an IPv4 subnet")
+	}
+	if opts.IPv6RAMode != "" {
+		return invalidSubnetInput("IPv6RAMode", opts.IPv6RAMode, "IPv6 modes can't be set on an IPv4 subnet")
+	}
+	if opts.CIDR != "" {
+		p, err := netip.ParsePrefix(opts.CIDR)
+		if err != nil || !p.Addr().Is4() {
+			return invalidSubnetInput("CIDR", opts.CIDR, "an IPv4 CIDR is expected")
+		}
+	}
+	return nil
+}
+
+// ValidateIPv6 checks the CreateOpts of an IPv6 subnet as Neutron would:
+//   - the CIDR must be an IPv6 CIDR,
+//   - the address and router advertisement modes must be known, and equal
+//     when both are set,
+//   - SLAAC and DHCPv6-stateless require a /64 prefix,
+//   - prefix delegation requires SLAAC or DHCPv6-stateless and no CIDR.
+func ValidateIPv6(opts CreateOpts) error {
+	for _, m := range []struct{ name, mode string }{
+		{"IPv6AddressMode", opts.IPv6AddressMode},
+		{"IPv6RAMode", opts.IPv6RAMode},
+	} {
+		switch m.mode {
+		case "", IPv6SLAAC, IPv6DHCPv6Stateful, IPv6DHCPv6Stateless:
+		default:
+			return invalidSubnetInput(m.name, m.mode, "unknown IPv6 mode")
+		}
+	}
+
+	if opts.IPv6AddressMode != "" && opts.IPv6RAMode != "" && opts.IPv6AddressMode != opts.IPv6RAMode {
+		return invalidSubnetInput("IPv6RAMode", opts.IPv6RAMode,
+			fmt.Sprintf("the IPv6 router advertisement mode must be equal to the address mode %q", opts.IPv6AddressMode))
+	}
+
+	mode := opts.IPv6AddressMode
+	if mode == "" {
+		mode = opts.IPv6RAMode
+	}
+	eui64 := mode == IPv6SLAAC || mode == IPv6DHCPv6Stateless
+
+	prefixLen := opts.Prefixlen
+	if opts.CIDR != "" {
+		p, err := netip.ParsePrefix(opts.CIDR)
+		if err != nil || !p.Addr().Is6() || p.Addr().Is4In6() {
+			return invalidSubnetInput("CIDR", opts.CIDR, "an IPv6 CIDR is expected")
+		}
+		prefixLen = p.Bits()
+	}
+
+	if opts.SubnetPoolID == PrefixDelegationSubnetPoolID {
+		if opts.CIDR != "" {
+			return invalidSubnetInput("CIDR", opts.CIDR, "the CIDR of a subnet using prefix delegation is delegated")
+		}
+		if !eui64 {
+			return invalidSubnetInput("IPv6AddressMode", mode, "prefix delegation requires the slaac or dhcpv6-stateless mode")
+		}
+		return nil
+	}
+
+	if eui64 && prefixLen != 0 && prefixLen != 64 {
+		return invalidSubnetInput("CIDR", fmt.Sprintf("/%d", prefixLen), fmt.Sprintf("the %s mode requires a /64 prefix", mode))
+	}
+
+	return nil
+}
+
+func invalidSubnetInput(argument, value, info string) error {
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = "subnets.CreateOpts." + argument
+	err.Value = value
+	err.Info = info
+	return err
+}
@@ -27,6 +27,26 @@ type CreateResult struct {
 	commonResult
 }
 
+// CreateBulkResult represents the result of a bulk create operation. Call its
+// Extract method to interpret it as a slice of Subnets.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the created
+// subnet resources, in the order of the request.
+func (r CreateBulkResult) Extract() ([]Subnet, error) {
+	var s []Subnet
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExtractInto extracts the created subnets into a pointer to a slice of
+// structs, which can embed Subnet along with the fields of extensions.
+func (r CreateBulkResult) ExtractInto(v any) error {
+	return r.ExtractIntoSlicePtr(v, "subnets")
+}
+
 // GetResult represents the result of a get operation. Call its Extract
 // method to interpret it as a Subnet.
 type GetResult struct {
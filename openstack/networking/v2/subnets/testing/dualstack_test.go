@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const DualStackCreateRequest = `
+{
+    "subnets": [
+        {
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "v4",
+            "ip_version": 4,
+            "cidr": "192.168.199.0/24"
+        },
+        {
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "v6",
+            "ip_version": 6,
+            "cidr": "2001:db8:1::/64",
+            "ipv6_address_mode": "slaac",
+            "ipv6_ra_mode": "slaac"
+        }
+    ]
+}
+`
+
+const DualStackCreateResponse = `
+{
+    "subnets": [
+        {
+            "id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "v4",
+            "ip_version": 4,
+            "cidr": "192.168.199.0/24"
+        },
+        {
+            "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "v6",
+            "ip_version": 6,
+            "cidr": "2001:db8:1::/64",
+            "ipv6_address_mode": "slaac",
+            "ipv6_ra_mode": "slaac"
+        }
+    ]
+}
+`
+
+func TestCreateDualStack(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, DualStackCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, DualStackCreateResponse)
+	})
+
+	opts := subnets.DualStackOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		IPv4: subnets.CreateOpts{
+			Name: "v4",
+			CIDR: "192.168.199.0/24",
+		},
+		IPv6: subnets.CreateOpts{
+			Name:            "v6",
+			CIDR:            "2001:db8:1::/64",
+			IPv6AddressMode: subnets.IPv6SLAAC,
+			IPv6RAMode:      subnets.IPv6SLAAC,
+		},
+	}
+	created, err := subnets.CreateDualStack(context.TODO(), fake.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(created))
+	th.AssertEquals(t, 4, created[0].IPVersion)
+	th.AssertEquals(t, 6, created[1].IPVersion)
+	th.AssertEquals(t, "slaac", created[1].IPv6AddressMode)
+}
+
+func TestDualStackValidation(t *testing.T) {
+	networkID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
+	v4 := subnets.CreateOpts{CIDR: "192.168.199.0/24"}
+
+	valid := []subnets.CreateOpts{
+		{CIDR: "2001:db8:1::/64"},
+		{CIDR: "2001:db8:1::/64", IPv6AddressMode: subnets.IPv6DHCPv6Stateless, IPv6RAMode: subnets.IPv6DHCPv6Stateless},
+		{CIDR: "2001:db8:1::/80", IPv6AddressMode: subnets.IPv6DHCPv6Stateful},
+		{SubnetPoolID: "0a738452-8057-4ad3-89c2-92f6a74afa76", Prefixlen: 64, IPv6RAMode: subnets.IPv6SLAAC},
+		{SubnetPoolID: subnets.PrefixDelegationSubnetPoolID, IPv6AddressMode: subnets.IPv6SLAAC, IPv6RAMode: subnets.IPv6SLAAC},
+	}
+	for _, v6 := range valid {
+		_, err := subnets.DualStackOpts{NetworkID: networkID, IPv4: v4, IPv6: v6}.ToSubnetCreateOptsList()
+		if err != nil {
+			t.Errorf("expected %+v to be valid, got %v", v6, err)
+		}
+	}
+
+	invalid := []subnets.DualStackOpts{
+		{IPv4: v4, IPv6: subnets.CreateOpts{CIDR: "2001:db8:1::/64"}},
+		{NetworkID: networkID, IPv4: subnets.CreateOpts{CIDR: "2001:db8:2::/64"}, IPv6: subnets.CreateOpts{CIDR: "2001:db8:1::/64"}},
+		{NetworkID: networkID, IPv4: subnets.CreateOpts{CIDR: "192.168.199.0/24", IPv6RAMode: subnets.IPv6SLAAC}, IPv6: subnets.CreateOpts{CIDR: "2001:db8:1::/64"}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{CIDR: "192.168.200.0/24"}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{CIDR: "2001:db8:1::/64", IPv6AddressMode: "stateless"}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{CIDR: "2001:db8:1::/64", IPv6AddressMode: subnets.IPv6SLAAC, IPv6RAMode: subnets.IPv6DHCPv6Stateful}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{CIDR: "2001:db8:1::/80", IPv6AddressMode: subnets.IPv6SLAAC}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{SubnetPoolID: "0a738452-8057-4ad3-89c2-92f6a74afa76", Prefixlen: 56, IPv6RAMode: subnets.IPv6DHCPv6Stateless}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{SubnetPoolID: subnets.PrefixDelegationSubnetPoolID, IPv6AddressMode: subnets.IPv6DHCPv6Stateful}},
+		{NetworkID: networkID, IPv4: v4, IPv6: subnets.CreateOpts{SubnetPoolID: subnets.PrefixDelegationSubnetPoolID, CIDR: "2001:db8:1::/64", IPv6AddressMode: subnets.IPv6SLAAC}},
+	}
+	for _, opts := range invalid {
+		_, err := opts.ToSubnetCreateOptsList()
+		var invalidInput gophercloud.ErrInvalidInput
+		var missingInput gophercloud.ErrMissingInput
+		if !errors.As(err, &invalidInput) && !errors.As(err, &missingInput) {
+			t.Errorf("expected %+v to be invalid, got %v", opts, err)
+		}
+	}
+}
+
+func TestCreateDualStackInvalid(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	opts := subnets.DualStackOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		IPv4:      subnets.CreateOpts{CIDR: "192.168.199.0/24"},
+		IPv6:      subnets.CreateOpts{CIDR: "2001:db8:1::/80", IPv6AddressMode: subnets.IPv6SLAAC},
+	}
+	res := subnets.CreateDualStack(context.TODO(), fake.ServiceClient(fakeServer), opts)
+	if res.Err == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -252,6 +252,70 @@ const SubnetCreateResult = `
 }
 `
 
+const SubnetCreateBulkRequest = `
+{
+	"subnets": [
+		{
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"ip_version": 4,
+			"cidr": "192.168.199.0/24"
+		},
+		{
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"ip_version": 6,
+			"cidr": "fdf8:f53b:82e4::/64",
+			"ipv6_address_mode": "slaac",
+			"ipv6_ra_mode": "slaac"
+		}
+	]
+}
+`
+
+const SubnetCreateBulkResult = `
+{
+	"subnets": [
+		{
+			"name": "",
+			"enable_dhcp": true,
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+			"dns_nameservers": [],
+			"allocation_pools": [
+				{
+					"start": "192.168.199.2",
+					"end": "192.168.199.254"
+				}
+			],
+			"host_routes": [],
+			"ip_version": 4,
+			"gateway_ip": "192.168.199.1",
+			"cidr": "192.168.199.0/24",
+			"id": "3b80198d-4f7b-4f77-9ef5-774d54e17126"
+		},
+		{
+			"name": "",
+			"enable_dhcp": true,
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+			"dns_nameservers": [],
+			"allocation_pools": [
+				{
+					"start": "fdf8:f53b:82e4::2",
+					"end": "fdf8:f53b:82e4:0:ffff:ffff:ffff:ffff"
+				}
+			],
+			"host_routes": [],
+			"ip_version": 6,
+			"gateway_ip": "fdf8:f53b:82e4::1",
+			"cidr": "fdf8:f53b:82e4::/64",
+			"ipv6_address_mode": "slaac",
+			"ipv6_ra_mode": "slaac",
+			"id": "0e2e6b5c-7f2a-4b8e-8c3d-9a1f4e6b2d7c"
+		}
+	]
+}
+`
+
 const SubnetCreateWithNoGatewayRequest = `
 {
 	"subnet": {
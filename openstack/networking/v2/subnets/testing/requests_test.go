@@ -152,6 +152,48 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, "b80340c7-9960-4f67-a99c-02501656284b", s.SubnetPoolID)
 }
 
+func TestCreateBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetCreateBulkRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, SubnetCreateBulkResult)
+	})
+
+	opts := []subnets.CreateOpts{
+		{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+		},
+		{
+			NetworkID:       "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion:       6,
+			CIDR:            "fdf8:f53b:82e4::/64",
+			IPv6AddressMode: "slaac",
+			IPv6RAMode:      "slaac",
+		},
+	}
+	s, err := subnets.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(s))
+	th.AssertEquals(t, "3b80198d-4f7b-4f77-9ef5-774d54e17126", s[0].ID)
+	th.AssertEquals(t, "192.168.199.0/24", s[0].CIDR)
+	th.AssertEquals(t, "0e2e6b5c-7f2a-4b8e-8c3d-9a1f4e6b2d7c", s[1].ID)
+	th.AssertEquals(t, 6, s[1].IPVersion)
+	th.AssertEquals(t, "slaac", s[1].IPv6AddressMode)
+}
+
 func TestCreateNoGateway(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
@@ -0,0 +1,142 @@
+package containers
+
+import (
+	"strings"
+)
+
+// ACLUser is a user, or a set of users, granted access to a container by an
+// ACL. An empty ProjectID or UserID matches any project or user.
+type ACLUser struct {
+	ProjectID string
+	UserID    string
+}
+
+// String formats an ACLUser as an ACL element, in the {project}:{user} form.
+func (u ACLUser) String() string {
+	project, user := u.ProjectID, u.UserID
+	if project == "" {
+		project = "*"
+	}
+	if user == "" {
+		user = "*"
+	}
+	return project + ":" + user
+}
+
+func parseACLUser(element string) ACLUser {
+	project, user, _ := strings.Cut(element, ":")
+	if project == "*" {
+		project = ""
+	}
+	if user == "*" {
+		user = ""
+	}
+	return ACLUser{ProjectID: project, UserID: user}
+}
+
+// ReadACL is the read ACL of a container, set in the X-Container-Read header
+// by the ContainerRead option of CreateOpts and UpdateOpts.
+type ReadACL struct {
+	// Referrers are the HTTP referrers allowed to read the objects without
+	// authentication: "*" for any referrer, a host name like
+	// "www.example.com", or a domain like ".example.com".
+	Referrers []string
+
+	// DeniedReferrers are the HTTP referrers denied access, in the same form
+	// as Referrers.
+	DeniedReferrers []string
+
+	// Listings allows the Referrers to list the objects of the container.
+	Listings bool
+
+	// Users are the users allowed to read the objects and list the
+	// container.
+	Users []ACLUser
+
+	// Roles are the roles allowed to read the objects and list the
+	// container.
+	Roles []string
+}
+
+// String formats a ReadACL into the value of the X-Container-Read header.
+func (acl ReadACL) String() string {
+	var elements []string
+	for _, referrer := range acl.Referrers {
+		elements = append(elements, ".r:"+referrer)
+	}
+	for _, referrer := range acl.DeniedReferrers {
+		elements = append(elements, ".r:-"+referrer)
+	}
+	if acl.Listings {
+		elements = append(elements, ".rlistings")
+	}
+	for _, user := range acl.Users {
+		elements = append(elements, user.String())
+	}
+	elements = append(elements, acl.Roles...)
+	return strings.Join(elements, ",")
+}
+
+// ParseReadACL parses the value of the X-Container-Read header. The Read
+// field of GetHeader can be parsed with ParseReadACL(strings.Join(h.Read, ",")).
+func ParseReadACL(acl string) ReadACL {
+	var r ReadACL
+	for _, element := range splitACL(acl) {
+		switch {
+		case element == ".rlistings":
+			r.Listings = true
+		case strings.HasPrefix(element, ".r:-"):
+			r.DeniedReferrers = append(r.DeniedReferrers, strings.TrimPrefix(element, ".r:-"))
+		case strings.HasPrefix(element, ".r:"):
+			r.Referrers = append(r.Referrers, strings.TrimPrefix(element, ".r:"))
+		case strings.Contains(element, ":"):
+			r.Users = append(r.Users, parseACLUser(element))
+		default:
+			r.Roles = append(r.Roles, element)
+		}
+	}
+	return r
+}
+
+// WriteACL is the write ACL of a container, set in the X-Container-Write
+// header by the ContainerWrite option of CreateOpts and UpdateOpts.
+type WriteACL struct {
+	// Users are the users allowed to create, update and delete the objects.
+	Users []ACLUser
+
+	// Roles are the roles allowed to create, update and delete the objects.
+	Roles []string
+}
+
+// String formats a WriteACL into the value of the X-Container-Write header.
+func (acl WriteACL) String() string {
+	var elements []string
+	for _, user := range acl.Users {
+		elements = append(elements, user.String())
+	}
+	elements = append(elements, acl.Roles...)
+	return strings.Join(elements, ",")
+}
+
+// ParseWriteACL parses the value of the X-Container-Write header.
+func ParseWriteACL(acl string) WriteACL {
+	var r WriteACL
+	for _, element := range splitACL(acl) {
+		if strings.Contains(element, ":") {
+			r.Users = append(r.Users, parseACLUser(element))
+		} else {
+			r.Roles = append(r.Roles, element)
+		}
+	}
+	return r
+}
+
+func splitACL(acl string) []string {
+	var elements []string
+	for _, element := range strings.Split(acl, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
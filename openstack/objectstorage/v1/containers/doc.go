@@ -91,5 +91,50 @@ Example to Delete a Container
 	if err != nil {
 		panic(err)
 	}
+
+Example to Share a Container with a Referrer and a Project
+
+	readACL := containers.ReadACL{
+		Referrers: []string{".example.com"},
+		Listings:  true,
+		Users: []containers.ACLUser{
+			{ProjectID: "a8a9f0d1d5ef4dc7b9ad1ef7e9b54e54"},
+		},
+	}.String()
+
+	updateOpts := containers.UpdateOpts{
+		ContainerRead: &readACL,
+	}
+
+	container, err := containers.Update(context.TODO(), objectStorageClient, "my_container", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Synchronize a Container to Another Cluster
+
+	// The same key and a target pointing to my_container must be set on the
+	// container of the other cluster.
+	syncKey, err := containers.GenerateSyncKey()
+	if err != nil {
+		panic(err)
+	}
+
+	syncTo := containers.SyncTarget{
+		Realm:     "realm1",
+		Cluster:   "cluster2",
+		Account:   "AUTH_a8a9f0d1d5ef4dc7b9ad1ef7e9b54e54",
+		Container: "my_container_replica",
+	}.String()
+
+	updateOpts := containers.UpdateOpts{
+		ContainerSyncKey: &syncKey,
+		ContainerSyncTo:  &syncTo,
+	}
+
+	container, err := containers.Update(context.TODO(), objectStorageClient, "my_container", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package containers
@@ -123,6 +123,8 @@ func (r *GetHeader) UnmarshalJSON(b []byte) error {
 		Read            string                  `json:"X-Container-Read"`
 		Date            gophercloud.JSONRFC1123 `json:"Date"`
 		VersionsEnabled string                  `json:"X-Versions-Enabled"`
+		SyncKey         string                  `json:"X-Container-Sync-Key"`
+		SyncTo          string                  `json:"X-Container-Sync-To"`
 	}
 
 	err := json.Unmarshal(b, &s)
@@ -132,6 +134,14 @@ func (r *GetHeader) UnmarshalJSON(b []byte) error {
 
 	*r = GetHeader(s.tmp)
 
+	// Swift returns the sync settings in the X-Container-Sync-* headers.
+	if r.SyncKey == "" {
+		r.SyncKey = s.SyncKey
+	}
+	if r.SyncTo == "" {
+		r.SyncTo = s.SyncTo
+	}
+
 	r.Read = strings.Split(s.Read, ",")
 	r.Write = strings.Split(s.Write, ",")
 
@@ -0,0 +1,53 @@
+package containers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// SyncTarget is the container that a container is synchronized to, set in
+// the X-Container-Sync-To header by the ContainerSyncTo option of CreateOpts
+// and UpdateOpts. The realm and the cluster must be defined in the
+// container-sync-realms.conf of both clusters, and both containers must have
+// the same sync key.
+type SyncTarget struct {
+	Realm     string
+	Cluster   string
+	Account   string
+	Container string
+}
+
+// String formats a SyncTarget into the value of the X-Container-Sync-To
+// header, in the //{realm}/{cluster}/{account}/{container} form.
+func (t SyncTarget) String() string {
+	return fmt.Sprintf("//%s/%s/%s/%s", t.Realm, t.Cluster, t.Account, t.Container)
+}
+
+// ParseSyncTarget parses the value of the X-Container-Sync-To header. Only
+// targets in the //{realm}/{cluster}/{account}/{container} form are
+// supported.
+func ParseSyncTarget(target string) (SyncTarget, error) {
+	parts := strings.Split(strings.TrimPrefix(target, "//"), "/")
+	if !strings.HasPrefix(target, "//") || len(parts) != 4 {
+		return SyncTarget{}, fmt.Errorf("invalid container sync target %q: expected //{realm}/{cluster}/{account}/{container}", target)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return SyncTarget{}, fmt.Errorf("invalid container sync target %q: expected //{realm}/{cluster}/{account}/{container}", target)
+		}
+	}
+	return SyncTarget{Realm: parts[0], Cluster: parts[1], Account: parts[2], Container: parts[3]}, nil
+}
+
+// GenerateSyncKey returns a random key to set in the X-Container-Sync-Key
+// header of both synchronized containers, by the ContainerSyncKey option of
+// CreateOpts and UpdateOpts.
+func GenerateSyncKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
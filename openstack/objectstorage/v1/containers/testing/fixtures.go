@@ -263,3 +263,15 @@ func HandleGetContainerSuccessfully(t *testing.T, fakeServer th.FakeServer, opti
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleGetContainerSyncSuccessfully creates an HTTP handler at `/testContainer` on the
+// test handler mux that responds with the container sync headers sent by Swift.
+func HandleGetContainerSyncSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/testContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("X-Container-Sync-Key", "272465181849")
+		w.Header().Set("X-Container-Sync-To", "//realm/cluster/AUTH_test/anotherContainer")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -290,3 +290,79 @@ func TestUpdateContainerVersioningOn(t *testing.T) {
 	_, err := containers.Update(context.TODO(), client.ServiceClient(fakeServer), "testVersioning", options).Extract()
 	th.AssertNoErr(t, err)
 }
+
+func TestGetContainerSync(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetContainerSyncSuccessfully(t, fakeServer)
+
+	actual, err := containers.Get(context.TODO(), client.ServiceClient(fakeServer), "testContainer", nil).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "272465181849", actual.SyncKey)
+	th.AssertEquals(t, "//realm/cluster/AUTH_test/anotherContainer", actual.SyncTo)
+
+	target, err := containers.ParseSyncTarget(actual.SyncTo)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, containers.SyncTarget{
+		Realm:     "realm",
+		Cluster:   "cluster",
+		Account:   "AUTH_test",
+		Container: "anotherContainer",
+	}, target)
+	th.AssertEquals(t, actual.SyncTo, target.String())
+}
+
+func TestParseSyncTarget(t *testing.T) {
+	for _, target := range []string{
+		"",
+		"https://example.com/v1/AUTH_test/container",
+		"//realm/cluster/AUTH_test",
+		"//realm//AUTH_test/container",
+	} {
+		_, err := containers.ParseSyncTarget(target)
+		if err == nil {
+			t.Errorf("expected an error for %q", target)
+		}
+	}
+}
+
+func TestGenerateSyncKey(t *testing.T) {
+	key1, err := containers.GenerateSyncKey()
+	th.AssertNoErr(t, err)
+	key2, err := containers.GenerateSyncKey()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 64, len(key1))
+	if key1 == key2 {
+		t.Errorf("expected different keys, got %q twice", key1)
+	}
+}
+
+func TestReadACL(t *testing.T) {
+	acl := containers.ReadACL{
+		Referrers:       []string{".example.com"},
+		DeniedReferrers: []string{"evil.example.com"},
+		Listings:        true,
+		Users: []containers.ACLUser{
+			{ProjectID: "project1", UserID: "user1"},
+			{ProjectID: "project2"},
+		},
+		Roles: []string{"reader"},
+	}
+	expected := ".r:.example.com,.r:-evil.example.com,.rlistings,project1:user1,project2:*,reader"
+	th.AssertEquals(t, expected, acl.String())
+	th.AssertDeepEquals(t, acl, containers.ParseReadACL(expected))
+
+	th.AssertEquals(t, "", containers.ReadACL{}.String())
+	th.AssertDeepEquals(t, containers.ReadACL{}, containers.ParseReadACL(""))
+	th.AssertDeepEquals(t, containers.ReadACL{Referrers: []string{"*"}}, containers.ParseReadACL(" .r:* , "))
+}
+
+func TestWriteACL(t *testing.T) {
+	acl := containers.WriteACL{
+		Users: []containers.ACLUser{{UserID: "user1"}},
+		Roles: []string{"admin"},
+	}
+	expected := "*:user1,admin"
+	th.AssertEquals(t, expected, acl.String())
+	th.AssertDeepEquals(t, acl, containers.ParseWriteACL(expected))
+}
@@ -0,0 +1,260 @@
+package dirsync
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+type localFile struct {
+	path   string
+	object string
+	size   int64
+	mtime  time.Time
+}
+
+// localFiles returns the regular files of the local directory, by path
+// relative to it with slash separators. A missing directory has no files.
+func (s *syncer) localFiles() (map[string]localFile, error) {
+	files := make(map[string]localFile)
+	err := filepath.WalkDir(s.opts.LocalDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == s.opts.LocalDir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		// Partial downloads are not synchronized.
+		if strings.HasPrefix(d.Name(), tempFilePrefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.opts.LocalDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		files[rel] = localFile{
+			path:   path,
+			object: s.opts.Prefix + rel,
+			size:   info.Size(),
+			mtime:  info.ModTime(),
+		}
+		return nil
+	})
+	return files, err
+}
+
+// remoteObjects returns the objects with the prefix, by name without it.
+// Pseudo-directory markers are ignored.
+func (s *syncer) remoteObjects(ctx context.Context) (map[string]objects.Object, error) {
+	listOpts := objects.ListOpts{
+		Prefix: s.opts.Prefix,
+	}
+	allPages, err := objects.List(s.client, s.container, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allObjects, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := make(map[string]objects.Object, len(allObjects))
+	for _, object := range allObjects {
+		path := strings.TrimPrefix(object.Name, s.opts.Prefix)
+		if path == "" || strings.HasSuffix(path, "/") {
+			continue
+		}
+		remote[path] = object
+	}
+	return remote, nil
+}
+
+// changed returns whether a file and its object differ.
+func (s *syncer) changed(ctx context.Context, file localFile, object objects.Object) (bool, error) {
+	// The object is only retrieved once, and only when the listing is not
+	// enough to compare it with the file.
+	var res *objects.GetResult
+	head := func() objects.GetResult {
+		if res == nil {
+			r := objects.Get(ctx, s.client, s.container, object.Name, nil)
+			res = &r
+		}
+		return *res
+	}
+
+	size := object.Bytes
+	// A Dynamic Large Object is listed with the size of its manifest, which
+	// is empty.
+	if size == 0 && file.size > 0 {
+		header, err := head().Extract()
+		if err != nil {
+			return false, err
+		}
+		size = header.ContentLength
+	}
+	if file.size != size {
+		return true, nil
+	}
+
+	switch s.opts.Compare {
+	case CompareSize:
+		return false, nil
+	case CompareMtime:
+		metadata, err := head().ExtractMetadata()
+		if err != nil {
+			return false, err
+		}
+		if mtime, ok := parseMtime(metadata[MtimeMetadataKey]); ok {
+			return !sameMtime(file.mtime, mtime), nil
+		}
+	}
+
+	sum, err := fileMD5(file.path)
+	if err != nil {
+		return false, err
+	}
+	if sum == strings.Trim(object.Hash, `"`) {
+		return false, nil
+	}
+
+	// The hash of a large object is not the checksum of its content, so the
+	// file is compared with its segments instead.
+	header, err := head().Extract()
+	if err != nil {
+		return false, err
+	}
+	var segments []largeobjects.Segment
+	switch {
+	case header.StaticLargeObject:
+		segments, err = largeobjects.GetManifest(ctx, s.client, s.container, object.Name).Extract()
+	case header.ObjectManifest != "":
+		segments, err = s.dynamicSegments(ctx, header.ObjectManifest)
+	default:
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return changedSegments(file, segments)
+}
+
+// dynamicSegments returns the segments of a Dynamic Large Object, from the
+// {container}/{prefix} value of its X-Object-Manifest header.
+func (s *syncer) dynamicSegments(ctx context.Context, manifest string) ([]largeobjects.Segment, error) {
+	manifest, err := url.PathUnescape(manifest)
+	if err != nil {
+		return nil, err
+	}
+	container, prefix, _ := strings.Cut(manifest, "/")
+
+	allPages, err := objects.List(s.client, container, objects.ListOpts{Prefix: prefix}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allObjects, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]largeobjects.Segment, 0, len(allObjects))
+	for _, o := range allObjects {
+		segments = append(segments, largeobjects.Segment{
+			Path:      container + "/" + o.Name,
+			ETag:      o.Hash,
+			SizeBytes: o.Bytes,
+		})
+	}
+	return segments, nil
+}
+
+// changedSegments returns whether a file differs from the concatenated
+// segments of a large object. A segment restricted to a range of bytes is
+// considered changed, as its ETag is the checksum of the whole segment.
+func changedSegments(file localFile, segments []largeobjects.Segment) (bool, error) {
+	var total int64
+	for _, segment := range segments {
+		if segment.Range != "" {
+			return true, nil
+		}
+		total += segment.SizeBytes
+	}
+	if total != file.size {
+		return true, nil
+	}
+
+	f, err := os.Open(file.path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	for _, segment := range segments {
+		hash := md5.New()
+		if _, err := io.CopyN(hash, f, segment.SizeBytes); err != nil {
+			if err == io.EOF {
+				return true, nil
+			}
+			return false, err
+		}
+		if fmt.Sprintf("%x", hash.Sum(nil)) != strings.Trim(segment.ETag, `"`) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func fileMD5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+func formatMtime(t time.Time) string {
+	return fmt.Sprintf("%d.%06d", t.Unix(), t.Nanosecond()/1000)
+}
+
+func parseMtime(v string) (time.Time, bool) {
+	f, err := strconv.ParseFloat(v, 64)
+	if v == "" || err != nil {
+		return time.Time{}, false
+	}
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9)), true
+}
+
+// sameMtime compares modification times with the precision of the
+// MtimeMetadataKey metadata and of the local filesystem.
+func sameMtime(a, b time.Time) bool {
+	d := a.Sub(b)
+	return d > -time.Millisecond && d < time.Millisecond
+}
@@ -0,0 +1,49 @@
+/*
+Package dirsync synchronizes a local directory tree with the objects of a
+container which share a prefix. Only the files which are missing or changed
+on the other side are transferred.
+
+A file and an object of different sizes always differ. Otherwise, they are
+compared by MD5 checksum, by modification time, or not at all, depending on
+Opts.Compare. The checksum of a file is compared with the segments of a large
+object, as the ETag of a large object is not the checksum of its content.
+
+Files larger than Opts.SegmentSize are uploaded as Static Large Objects.
+
+Example to Upload a Directory
+
+	syncOpts := dirsync.Opts{
+		LocalDir: "./dist",
+		Prefix:   "builds/1.2.3/",
+		// Delete the objects of files removed since the last upload.
+		Delete:      true,
+		Concurrency: 8,
+	}
+
+	report, err := dirsync.Upload(context.TODO(), objectStorageClient, "artifacts", syncOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, change := range report.Changes {
+		fmt.Printf("%s %s\n", change.Action, change.Object)
+	}
+
+Example to Report the Changes a Download Would Make
+
+	syncOpts := dirsync.Opts{
+		LocalDir: "/srv/mirror",
+		Prefix:   "builds/",
+		Compare:  dirsync.CompareMtime,
+		Delete:   true,
+		DryRun:   true,
+	}
+
+	report, err := dirsync.Download(context.TODO(), objectStorageClient, "artifacts", syncOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d files to change, %d up to date\n", len(report.Changes), len(report.Unchanged))
+*/
+package dirsync
@@ -0,0 +1,18 @@
+package dirsync
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrUnsafeObjectName is the error returned by Download when the name of an
+// object would be written outside of the local directory.
+type ErrUnsafeObjectName struct {
+	gophercloud.BaseError
+	Name string
+}
+
+func (e ErrUnsafeObjectName) Error() string {
+	return fmt.Sprintf("object %q cannot be written inside of the local directory", e.Name)
+}
@@ -0,0 +1,470 @@
+package dirsync
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// DefaultConcurrency is the number of files transferred concurrently when
+// Opts.Concurrency is not set.
+const DefaultConcurrency = 4
+
+// DefaultSegmentSize is the size above which a file is uploaded as a Static
+// Large Object when Opts.SegmentSize is not set.
+const DefaultSegmentSize = largeobjects.DefaultSegmentSize
+
+// MtimeMetadataKey is the metadata of an object holding the modification time
+// of its file, in seconds since the Unix epoch. It is the same metadata as
+// the one used by python-swiftclient.
+const MtimeMetadataKey = "Mtime"
+
+// CompareMode defines how a file and an object of the same size are compared.
+type CompareMode string
+
+const (
+	// CompareChecksum compares the MD5 checksum of the file with the ETag of
+	// the object. It reads every file which has the size of its object.
+	CompareChecksum CompareMode = "checksum"
+
+	// CompareMtime compares the modification time of the file with the
+	// MtimeMetadataKey metadata of the object. It sends a HEAD request for
+	// every object which has the size of its file, and falls back to
+	// CompareChecksum when the object has no such metadata.
+	CompareMtime CompareMode = "mtime"
+
+	// CompareSize only compares the sizes.
+	CompareSize CompareMode = "size"
+)
+
+// Opts is a structure that holds parameters for synchronizing a local
+// directory and a container.
+type Opts struct {
+	// LocalDir is the local directory. Only its regular files are
+	// synchronized.
+	LocalDir string
+
+	// Prefix is prepended to the path of a file, with slash separators, to
+	// name its object. Only the objects with this prefix are synchronized.
+	Prefix string
+
+	// Compare defines how a file and an object of the same size are compared.
+	// Defaults to CompareChecksum.
+	Compare CompareMode
+
+	// Delete deletes the objects which have no matching file when uploading,
+	// along with the segments of the Static Large Objects, and the files which
+	// have no matching object when downloading.
+	Delete bool
+
+	// DryRun only reports the changes to make.
+	DryRun bool
+
+	// Concurrency is the number of files compared and transferred
+	// concurrently. Defaults to DefaultConcurrency.
+	Concurrency int
+
+	// SegmentSize is the size above which a file is uploaded as a Static
+	// Large Object, made of segments of this size, as a single object can
+	// only be up to 5 GiB by default. The segments are uploaded to the
+	// container suffixed with "_segments", and the segments of the object it
+	// replaces are deleted. Up to three segments of every file uploaded
+	// concurrently are held in memory. Defaults to DefaultSegmentSize.
+	SegmentSize int64
+}
+
+// Upload uploads the files of a local directory which are missing or changed
+// in a container. The container is created if it does not exist. Every object
+// is uploaded with the modification time of its file in the
+// MtimeMetadataKey metadata.
+func Upload(ctx context.Context, c *gophercloud.ServiceClient, containerName string, opts Opts) (*Report, error) {
+	s, err := newSyncer(c, containerName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// A missing directory must not be mistaken for an empty one, which would
+	// delete every object.
+	if _, err := os.Stat(opts.LocalDir); err != nil {
+		return nil, err
+	}
+	files, err := s.localFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := s.remoteObjects(ctx)
+	if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, err
+	}
+	if err != nil && !opts.DryRun {
+		if err := containers.Create(ctx, c, containerName, nil).Err; err != nil {
+			return nil, err
+		}
+	}
+
+	var tasks []func(context.Context) error
+	for path, file := range files {
+		tasks = append(tasks, func(ctx context.Context) error {
+			object, exists := remote[path]
+			if exists {
+				changed, err := s.changed(ctx, file, object)
+				if err != nil {
+					return err
+				}
+				if !changed {
+					s.unchanged(path)
+					return nil
+				}
+			}
+
+			if !opts.DryRun {
+				if err := s.upload(ctx, file, exists); err != nil {
+					return err
+				}
+			}
+			s.report(Change{Action: ActionUpload, Path: path, Object: file.object, Size: file.size})
+			return nil
+		})
+	}
+	if opts.Delete {
+		for path, object := range remote {
+			if _, ok := files[path]; ok {
+				continue
+			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				if !opts.DryRun {
+					if err := s.delete(ctx, object.Name); err != nil {
+						return err
+					}
+				}
+				s.report(Change{Action: ActionDeleteObject, Path: path, Object: object.Name, Size: object.Bytes})
+				return nil
+			})
+		}
+	}
+
+	return s.run(ctx, tasks)
+}
+
+// Download downloads the objects of a container which are missing or changed
+// in a local directory. The directory is created if it does not exist. The
+// modification time of a file is set from the MtimeMetadataKey metadata of its
+// object, when present, so that the next comparison with CompareMtime finds
+// them unchanged.
+func Download(ctx context.Context, c *gophercloud.ServiceClient, containerName string, opts Opts) (*Report, error) {
+	s, err := newSyncer(c, containerName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := s.remoteObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for path, object := range remote {
+		if !filepath.IsLocal(filepath.FromSlash(path)) {
+			return nil, ErrUnsafeObjectName{Name: object.Name}
+		}
+	}
+
+	files, err := s.localFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []func(context.Context) error
+	for path, object := range remote {
+		tasks = append(tasks, func(ctx context.Context) error {
+			file, exists := files[path]
+			if exists {
+				changed, err := s.changed(ctx, file, object)
+				if err != nil {
+					return err
+				}
+				if !changed {
+					s.unchanged(path)
+					return nil
+				}
+			}
+
+			if !opts.DryRun {
+				if err := s.download(ctx, path, object); err != nil {
+					return err
+				}
+			}
+			s.report(Change{Action: ActionDownload, Path: path, Object: object.Name, Size: object.Bytes})
+			return nil
+		})
+	}
+	if opts.Delete {
+		for path, file := range files {
+			if _, ok := remote[path]; ok {
+				continue
+			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				if !opts.DryRun {
+					if err := os.Remove(file.path); err != nil && !os.IsNotExist(err) {
+						return err
+					}
+				}
+				s.report(Change{Action: ActionDeleteFile, Path: path, Object: file.object, Size: file.size})
+				return nil
+			})
+		}
+	}
+
+	return s.run(ctx, tasks)
+}
+
+type syncer struct {
+	client    *gophercloud.ServiceClient
+	container string
+	opts      Opts
+
+	mu     sync.Mutex
+	result Report
+}
+
+func newSyncer(c *gophercloud.ServiceClient, containerName string, opts Opts) (*syncer, error) {
+	if opts.LocalDir == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "dirsync.Opts.LocalDir"
+		return nil, err
+	}
+	if opts.Compare == "" {
+		opts.Compare = CompareChecksum
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSegmentSize
+	}
+
+	return &syncer{
+		client:    c,
+		container: containerName,
+		opts:      opts,
+		result:    Report{DryRun: opts.DryRun},
+	}, nil
+}
+
+// upload uploads a file, as a Static Large Object when it is larger than
+// Opts.SegmentSize. When it replaces an existing object, the segments of that
+// object are deleted once the file is uploaded.
+func (s *syncer) upload(ctx context.Context, file localFile, exists bool) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	metadata := map[string]string{
+		MtimeMetadataKey: formatMtime(file.mtime),
+	}
+
+	if file.size > s.opts.SegmentSize {
+		uploadOpts := largeobjects.UploadOpts{
+			Content:     f,
+			SegmentSize: s.opts.SegmentSize,
+			// Files are already uploaded concurrently.
+			Concurrency: 1,
+			Metadata:    metadata,
+		}
+		_, err := largeobjects.Upload(ctx, s.client, s.container, file.object, uploadOpts)
+		return err
+	}
+
+	// largeobjects.Upload deletes the segments of the object it replaces, but
+	// objects.Create does not.
+	var replaced []largeobjects.Segment
+	if exists {
+		replaced, err = s.manifestSegments(ctx, file.object)
+		if err != nil {
+			return err
+		}
+	}
+
+	createOpts := objects.CreateOpts{
+		Content:       f,
+		ContentLength: file.size,
+		Metadata:      metadata,
+	}
+	if err := objects.Create(ctx, s.client, s.container, file.object, createOpts).Err; err != nil {
+		return err
+	}
+
+	for _, segment := range replaced {
+		_ = objects.Delete(ctx, s.client, segment.Container(), segment.Object(), nil).Err
+	}
+	return nil
+}
+
+// manifestSegments returns the segments of an object when it is a Static
+// Large Object.
+func (s *syncer) manifestSegments(ctx context.Context, objectName string) ([]largeobjects.Segment, error) {
+	header, err := objects.Get(ctx, s.client, s.container, objectName, nil).Extract()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !header.StaticLargeObject {
+		return nil, nil
+	}
+	return largeobjects.GetManifest(ctx, s.client, s.container, objectName).Extract()
+}
+
+// delete deletes an object, along with its segments when it is a Static
+// Large Object.
+func (s *syncer) delete(ctx context.Context, objectName string) error {
+	header, err := objects.Get(ctx, s.client, s.container, objectName, nil).Extract()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deleteOpts := objects.DeleteOpts{}
+	if header.StaticLargeObject {
+		deleteOpts.MultipartManifest = "delete"
+	}
+	err = objects.Delete(ctx, s.client, s.container, objectName, deleteOpts).Err
+	if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
+
+const tempFilePrefix = ".dirsync-"
+
+// download writes an object to a temporary file, which replaces the file
+// once the content is verified.
+func (s *syncer) download(ctx context.Context, path string, object objects.Object) (err error) {
+	target := filepath.Join(s.opts.LocalDir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	res := objects.Download(ctx, s.client, s.container, object.Name, nil)
+	if res.Err != nil {
+		return res.Err
+	}
+	defer res.Body.Close()
+	header, err := res.Extract()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), tempFilePrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	hash := md5.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), res.Body); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// The ETag of a large object is not the checksum of its content.
+	if !header.StaticLargeObject && header.ObjectManifest == "" {
+		sum := fmt.Sprintf("%x", hash.Sum(nil))
+		if expected := strings.Trim(header.ETag, `"`); expected != "" && sum != expected {
+			return objects.ErrChecksumMismatch{Expected: expected, Actual: sum}
+		}
+	}
+
+	if mtime, ok := parseMtime(res.Header.Get("X-Object-Meta-" + MtimeMetadataKey)); ok {
+		if err := os.Chtimes(tmp.Name(), mtime, mtime); err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(tmp.Name(), target)
+}
+
+// run runs the tasks concurrently, and stops at the first error.
+func (s *syncer) run(ctx context.Context, tasks []func(context.Context) error) (*Report, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	queue := make(chan func(context.Context) error)
+	for range min(s.opts.Concurrency, len(tasks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				if err := task(ctx); err != nil {
+					errOnce.Do(func() { firstErr = err })
+					cancel()
+				}
+			}
+		}()
+	}
+
+enqueue:
+	for _, task := range tasks {
+		select {
+		case queue <- task:
+		case <-ctx.Done():
+			break enqueue
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	sort.Slice(s.result.Changes, func(i, j int) bool {
+		a, b := s.result.Changes[i], s.result.Changes[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Action < b.Action
+	})
+	sort.Strings(s.result.Unchanged)
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return &s.result, firstErr
+}
+
+func (s *syncer) report(change Change) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.result.Changes = append(s.result.Changes, change)
+}
+
+func (s *syncer) unchanged(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.result.Unchanged = append(s.result.Unchanged, path)
+}
@@ -0,0 +1,53 @@
+package dirsync
+
+// Action is an action taken to synchronize a local directory and a
+// container.
+type Action string
+
+const (
+	// ActionUpload uploads a local file which is missing or changed in the
+	// container.
+	ActionUpload Action = "upload"
+
+	// ActionDownload downloads an object which is missing or changed in the
+	// local directory.
+	ActionDownload Action = "download"
+
+	// ActionDeleteObject deletes an object which has no matching local file.
+	ActionDeleteObject Action = "delete-object"
+
+	// ActionDeleteFile deletes a local file which has no matching object.
+	ActionDeleteFile Action = "delete-file"
+)
+
+// Change is an action taken, or planned in a dry run, on a file and its
+// matching object.
+type Change struct {
+	// Action is the action taken.
+	Action Action
+
+	// Path is the path of the file relative to the local directory, with
+	// slash separators.
+	Path string
+
+	// Object is the name of the object.
+	Object string
+
+	// Size is the size of the file or object.
+	Size int64
+}
+
+// Report describes a synchronization.
+type Report struct {
+	// DryRun is whether the changes were only planned.
+	DryRun bool
+
+	// Changes are the changes made, or planned in a dry run, sorted by path.
+	// When the synchronization fails, only the changes which were completed
+	// are listed.
+	Changes []Change
+
+	// Unchanged are the paths of the files which were already up to date,
+	// sorted.
+	Unchanged []string
+}
@@ -0,0 +1,272 @@
+package testing
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+type fakeObject struct {
+	content []byte
+	mtime   string
+
+	// segments are the keys of the segments of a Static Large Object.
+	segments []string
+}
+
+// fakeSwift is a minimal in-memory object store, which handles the requests
+// sent by Upload and Download.
+type fakeSwift struct {
+	mu         sync.Mutex
+	containers map[string]bool
+	objects    map[string]fakeObject
+	requests   []string
+}
+
+// HandleFakeSwift registers a fakeSwift on the test handler mux.
+func HandleFakeSwift(t *testing.T, fakeServer th.FakeServer) *fakeSwift {
+	s := &fakeSwift{
+		containers: make(map[string]bool),
+		objects:    make(map[string]fakeObject),
+	}
+
+	fakeServer.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		container, object, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if object == "" {
+			s.handleContainer(t, w, r, container)
+			return
+		}
+
+		key := container + "/" + object
+		s.requests = append(s.requests, r.Method+" "+key)
+		switch r.Method {
+		case "PUT":
+			body, err := io.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			if r.URL.Query().Get("multipart-manifest") == "put" {
+				s.putManifest(t, w, key, body, r.Header.Get("X-Object-Meta-Mtime"))
+				return
+			}
+			th.TestHeader(t, r, "ETag", fmt.Sprintf("%x", md5.Sum(body)))
+			s.objects[key] = fakeObject{content: body, mtime: r.Header.Get("X-Object-Meta-Mtime")}
+			w.WriteHeader(http.StatusCreated)
+		case "GET", "HEAD":
+			o, ok := s.objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if o.segments != nil && r.URL.Query().Get("multipart-manifest") == "get" {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusOK)
+				th.AssertNoErr(t, json.NewEncoder(w).Encode(s.manifest(o)))
+				return
+			}
+			content := s.content(o)
+			w.Header().Set("ETag", s.etag(o))
+			w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+			if o.segments != nil {
+				w.Header().Set("X-Static-Large-Object", "True")
+			}
+			if o.mtime != "" {
+				w.Header().Set("X-Object-Meta-Mtime", o.mtime)
+			}
+			w.WriteHeader(http.StatusOK)
+			if r.Method == "GET" {
+				_, err := w.Write(content)
+				th.AssertNoErr(t, err)
+			}
+		case "DELETE":
+			o, ok := s.objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(s.objects, key)
+			if r.URL.Query().Get("multipart-manifest") == "delete" {
+				th.AssertEquals(t, true, o.segments != nil)
+				for _, segment := range o.segments {
+					delete(s.objects, segment)
+				}
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, "Number Deleted: %d\nResponse Status: 200 OK\n", len(o.segments)+1)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	return s
+}
+
+func (s *fakeSwift) handleContainer(t *testing.T, w http.ResponseWriter, r *http.Request, container string) {
+	switch r.Method {
+	case "PUT":
+		s.containers[container] = true
+		w.WriteHeader(http.StatusCreated)
+	case "GET":
+		if !s.containers[container] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		prefix := container + "/" + r.URL.Query().Get("prefix")
+		marker := container + "/" + r.URL.Query().Get("marker")
+		var names []string
+		for key := range s.objects {
+			if strings.HasPrefix(key, prefix) && key > marker {
+				names = append(names, key)
+			}
+		}
+		sort.Strings(names)
+
+		list := make([]map[string]any, 0, len(names))
+		for _, key := range names {
+			o := s.objects[key]
+			list = append(list, map[string]any{
+				"name":  strings.TrimPrefix(key, container+"/"),
+				"hash":  strings.Trim(s.etag(o), `"`),
+				"bytes": len(s.content(o)),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(list))
+	default:
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// store adds an object to a container.
+func (s *fakeSwift) store(container, object, content, mtime string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.containers[container] = true
+	s.objects[container+"/"+object] = fakeObject{content: []byte(content), mtime: mtime}
+}
+
+// storeSLO adds a Static Large Object to a container, made of the given
+// segments, which are added to the segments container.
+func (s *fakeSwift) storeSLO(container, object, mtime string, segments ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.containers[container] = true
+	s.containers[container+"_segments"] = true
+
+	o := fakeObject{mtime: mtime, segments: []string{}}
+	for i, content := range segments {
+		key := fmt.Sprintf("%s_segments/%s/slo/%08d", container, object, i)
+		s.objects[key] = fakeObject{content: []byte(content)}
+		o.segments = append(o.segments, key)
+	}
+	s.objects[container+"/"+object] = o
+}
+
+// putManifest stores a Static Large Object from its manifest.
+func (s *fakeSwift) putManifest(t *testing.T, w http.ResponseWriter, key string, body []byte, mtime string) {
+	var manifest []struct {
+		Path      string `json:"path"`
+		ETag      string `json:"etag"`
+		SizeBytes int    `json:"size_bytes"`
+	}
+	th.AssertNoErr(t, json.Unmarshal(body, &manifest))
+
+	o := fakeObject{mtime: mtime, segments: []string{}}
+	for _, segment := range manifest {
+		segmentKey := strings.TrimPrefix(segment.Path, "/")
+		stored, ok := s.objects[segmentKey]
+		th.AssertEquals(t, true, ok)
+		th.AssertEquals(t, fmt.Sprintf("%x", md5.Sum(stored.content)), segment.ETag)
+		th.AssertEquals(t, len(stored.content), segment.SizeBytes)
+		o.segments = append(o.segments, segmentKey)
+	}
+	s.objects[key] = o
+
+	w.Header().Set("ETag", s.etag(o))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// content returns the content of an object, which is the concatenated
+// content of the segments of a Static Large Object.
+func (s *fakeSwift) content(o fakeObject) []byte {
+	if o.segments == nil {
+		return o.content
+	}
+	var content []byte
+	for _, key := range o.segments {
+		content = append(content, s.objects[key].content...)
+	}
+	return content
+}
+
+// etag returns the ETag of an object. The ETag of a Static Large Object is
+// the checksum of the ETags of its segments, and is quoted.
+func (s *fakeSwift) etag(o fakeObject) string {
+	if o.segments == nil {
+		return fmt.Sprintf("%x", md5.Sum(o.content))
+	}
+	var etags string
+	for _, key := range o.segments {
+		etags += fmt.Sprintf("%x", md5.Sum(s.objects[key].content))
+	}
+	return fmt.Sprintf(`"%x"`, md5.Sum([]byte(etags)))
+}
+
+// manifest returns the raw manifest of a Static Large Object.
+func (s *fakeSwift) manifest(o fakeObject) []map[string]any {
+	manifest := make([]map[string]any, 0, len(o.segments))
+	for _, key := range o.segments {
+		manifest = append(manifest, map[string]any{
+			"path":       "/" + key,
+			"etag":       fmt.Sprintf("%x", md5.Sum(s.objects[key].content)),
+			"size_bytes": len(s.objects[key].content),
+		})
+	}
+	return manifest
+}
+
+// object returns a stored object.
+func (s *fakeSwift) object(container, object string) (fakeObject, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[container+"/"+object]
+	return o, ok
+}
+
+// objectNames returns the names of the stored objects, in order.
+func (s *fakeSwift) objectNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// objectRequests returns the requests sent for objects, in order.
+func (s *fakeSwift) objectRequests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
@@ -0,0 +1,339 @@
+package testing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/dirsync"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var mtime = time.Date(2024, time.March, 1, 12, 0, 0, 500000000, time.UTC)
+
+func writeFile(t *testing.T, dir, path, content string) {
+	t.Helper()
+	name := filepath.Join(dir, filepath.FromSlash(path))
+	th.AssertNoErr(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	th.AssertNoErr(t, os.WriteFile(name, []byte(content), 0o644))
+	th.AssertNoErr(t, os.Chtimes(name, mtime, mtime))
+}
+
+func readFile(t *testing.T, dir, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	th.AssertNoErr(t, err)
+	return string(b)
+}
+
+func TestUpload(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "new")
+	writeFile(t, dir, "sub/b.txt", "same")
+	writeFile(t, dir, "sub/c.txt", "changed")
+	swift.store("testContainer", "build/sub/b.txt", "same", "")
+	swift.store("testContainer", "build/sub/c.txt", "chAnged", "")
+	swift.store("testContainer", "build/stale.txt", "stale", "")
+	swift.store("testContainer", "other/d.txt", "other", "")
+
+	report, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: dir,
+		Prefix:   "build/",
+		Delete:   true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &dirsync.Report{
+		Changes: []dirsync.Change{
+			{Action: dirsync.ActionUpload, Path: "a.txt", Object: "build/a.txt", Size: 3},
+			{Action: dirsync.ActionDeleteObject, Path: "stale.txt", Object: "build/stale.txt", Size: 5},
+			{Action: dirsync.ActionUpload, Path: "sub/c.txt", Object: "build/sub/c.txt", Size: 7},
+		},
+		Unchanged: []string{"sub/b.txt"},
+	}, report)
+
+	th.CheckDeepEquals(t, []string{
+		"testContainer/build/a.txt",
+		"testContainer/build/sub/b.txt",
+		"testContainer/build/sub/c.txt",
+		"testContainer/other/d.txt",
+	}, swift.objectNames())
+
+	o, _ := swift.object("testContainer", "build/sub/c.txt")
+	th.AssertEquals(t, "changed", string(o.content))
+	th.AssertEquals(t, "1709294400.500000", o.mtime)
+}
+
+func TestUploadLargeObjectUnchanged(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "same.bin", "aaaabbbbcc")
+	writeFile(t, dir, "changed.bin", "aaaabbbbcd")
+	swift.storeSLO("testContainer", "same.bin", "", "aaaa", "bbbb", "cc")
+	swift.storeSLO("testContainer", "changed.bin", "", "aaaa", "bbbb", "cc")
+
+	// The hash of a Static Large Object in a listing is not the checksum of
+	// its content, so it is compared with its segments.
+	report, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: dir,
+		DryRun:   true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"same.bin"}, report.Unchanged)
+	th.CheckDeepEquals(t, []dirsync.Change{
+		{Action: dirsync.ActionUpload, Path: "changed.bin", Object: "changed.bin", Size: 10},
+	}, report.Changes)
+}
+
+func TestUploadLargeFile(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "big.bin", "aaaabbbbcc")
+	writeFile(t, dir, "small.bin", "aaaa")
+	opts := dirsync.Opts{
+		LocalDir:    dir,
+		SegmentSize: 4,
+	}
+
+	_, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", opts)
+	th.AssertNoErr(t, err)
+
+	o, _ := swift.object("testContainer", "big.bin")
+	th.AssertEquals(t, 3, len(o.segments))
+	th.AssertTrue(t, strings.HasPrefix(o.segments[0], "testContainer_segments/big.bin/slo/"))
+	th.AssertEquals(t, "aaaabbbbcc", string(swift.content(o)))
+	th.AssertEquals(t, "1709294400.500000", o.mtime)
+	o, _ = swift.object("testContainer", "small.bin")
+	th.AssertEquals(t, 0, len(o.segments))
+
+	// The changed file is uploaded with new segments, and the ones of the
+	// replaced object are deleted.
+	writeFile(t, dir, "big.bin", "aaaaBBBBcc")
+	report, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", opts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []dirsync.Change{
+		{Action: dirsync.ActionUpload, Path: "big.bin", Object: "big.bin", Size: 10},
+	}, report.Changes)
+
+	o, _ = swift.object("testContainer", "big.bin")
+	th.AssertEquals(t, "aaaaBBBBcc", string(swift.content(o)))
+	th.CheckDeepEquals(t, append([]string{"testContainer/big.bin", "testContainer/small.bin"}, o.segments...), swift.objectNames())
+
+	// A file which is no longer larger than the segment size replaces the
+	// Static Large Object with a regular object, and its segments are deleted.
+	writeFile(t, dir, "big.bin", "abc")
+	_, err = dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", opts)
+	th.AssertNoErr(t, err)
+
+	o, _ = swift.object("testContainer", "big.bin")
+	th.AssertEquals(t, 0, len(o.segments))
+	th.AssertEquals(t, "abc", string(o.content))
+	th.CheckDeepEquals(t, []string{"testContainer/big.bin", "testContainer/small.bin"}, swift.objectNames())
+}
+
+func TestUploadDeleteLargeObject(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "a")
+	swift.store("testContainer", "a.txt", "a", "")
+	swift.store("testContainer", "stale.txt", "stale", "")
+	swift.storeSLO("testContainer", "stale.bin", "", "aaaa", "bbbb", "cc")
+
+	report, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: dir,
+		Delete:   true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []dirsync.Change{
+		{Action: dirsync.ActionDeleteObject, Path: "stale.bin", Object: "stale.bin", Size: 10},
+		{Action: dirsync.ActionDeleteObject, Path: "stale.txt", Object: "stale.txt", Size: 5},
+	}, report.Changes)
+
+	// The segments of the Static Large Object are deleted along with it.
+	th.CheckDeepEquals(t, []string{"testContainer/a.txt"}, swift.objectNames())
+}
+
+func TestUploadDryRun(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "new")
+	swift.store("testContainer", "stale.txt", "stale", "")
+
+	report, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: dir,
+		Delete:   true,
+		DryRun:   true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, report.DryRun)
+	th.AssertEquals(t, 2, len(report.Changes))
+	th.CheckDeepEquals(t, []string{"testContainer/stale.txt"}, swift.objectNames())
+	th.AssertEquals(t, 0, len(swift.objectRequests()))
+}
+
+func TestUploadCreatesContainer(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "new")
+
+	_, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: dir,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, swift.containers["testContainer"])
+	th.CheckDeepEquals(t, []string{"testContainer/a.txt"}, swift.objectNames())
+}
+
+func TestUploadRequiresLocalDir(t *testing.T) {
+	_, err := dirsync.Upload(context.TODO(), nil, "testContainer", dirsync.Opts{})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	_, err = dirsync.Upload(context.TODO(), nil, "testContainer", dirsync.Opts{
+		LocalDir: filepath.Join(t.TempDir(), "missing"),
+		Delete:   true,
+	})
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected a missing directory error, got %v", err)
+	}
+}
+
+func TestDownload(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	swift.store("testContainer", "build/a.txt", "new", "1709294400.500000")
+	swift.store("testContainer", "build/sub/b.txt", "same", "")
+	swift.store("testContainer", "build/sub/c.txt", "changed", "")
+	swift.store("testContainer", "build/sub/", "", "")
+
+	dir := filepath.Join(t.TempDir(), "mirror")
+	writeFile(t, dir, "sub/b.txt", "same")
+	writeFile(t, dir, "sub/c.txt", "chAnged")
+	writeFile(t, dir, "stale.txt", "stale")
+
+	report, err := dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir:    dir,
+		Prefix:      "build/",
+		Delete:      true,
+		Concurrency: 2,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &dirsync.Report{
+		Changes: []dirsync.Change{
+			{Action: dirsync.ActionDownload, Path: "a.txt", Object: "build/a.txt", Size: 3},
+			{Action: dirsync.ActionDeleteFile, Path: "stale.txt", Object: "build/stale.txt", Size: 5},
+			{Action: dirsync.ActionDownload, Path: "sub/c.txt", Object: "build/sub/c.txt", Size: 7},
+		},
+		Unchanged: []string{"sub/b.txt"},
+	}, report)
+
+	th.AssertEquals(t, "new", readFile(t, dir, "a.txt"))
+	th.AssertEquals(t, "changed", readFile(t, dir, "sub/c.txt"))
+	_, err = os.Stat(filepath.Join(dir, "stale.txt"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected stale.txt to be deleted, got %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "a.txt"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, info.ModTime().Equal(mtime))
+
+	var names []string
+	entries, err := os.ReadDir(filepath.Join(dir, "sub"))
+	th.AssertNoErr(t, err)
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	th.CheckDeepEquals(t, []string{"b.txt", "c.txt"}, names)
+}
+
+func TestDownloadCompareMtime(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	// Objects of the same size are only compared by modification time.
+	swift.store("testContainer", "a.txt", "AAAA", "1709294400.500000")
+	swift.store("testContainer", "b.txt", "BBBB", "1709294401.000000")
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "aaaa")
+	writeFile(t, dir, "b.txt", "bbbb")
+
+	report, err := dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: dir,
+		Compare:  dirsync.CompareMtime,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"a.txt"}, report.Unchanged)
+	th.CheckDeepEquals(t, []dirsync.Change{
+		{Action: dirsync.ActionDownload, Path: "b.txt", Object: "b.txt", Size: 4},
+	}, report.Changes)
+	th.AssertEquals(t, "aaaa", readFile(t, dir, "a.txt"))
+	th.AssertEquals(t, "BBBB", readFile(t, dir, "b.txt"))
+}
+
+func TestDownloadLargeObject(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.storeSLO("testContainer", "big.bin", "1709294400.500000", "aaaa", "bbbb", "cc")
+
+	dir := t.TempDir()
+	opts := dirsync.Opts{LocalDir: dir}
+	report, err := dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "testContainer", opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(report.Changes))
+	th.AssertEquals(t, "aaaabbbbcc", readFile(t, dir, "big.bin"))
+
+	// The downloaded file matches the segments of the object.
+	report, err = dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "testContainer", opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(report.Changes))
+	th.CheckDeepEquals(t, []string{"big.bin"}, report.Unchanged)
+}
+
+func TestDownloadUnsafeObjectName(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.store("testContainer", "build/../../evil", "evil", "")
+
+	_, err := dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "testContainer", dirsync.Opts{
+		LocalDir: t.TempDir(),
+		Prefix:   "build/",
+	})
+	unsafe, ok := err.(dirsync.ErrUnsafeObjectName)
+	if !ok {
+		t.Fatalf("expected ErrUnsafeObjectName, got %v", err)
+	}
+	th.AssertEquals(t, "build/../../evil", unsafe.Name)
+}
@@ -0,0 +1,75 @@
+/*
+Package largeobjects contains functionality for working with Object Storage
+Static Large Objects (SLO). A Static Large Object is made of segments, which
+are regular objects, and of a manifest listing them in order. Downloading the
+object returns the concatenated content of its segments.
+
+Example to Upload a Static Large Object
+
+	f, err := os.Open("disk.img")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     f,
+		SegmentSize: 500 * 1024 * 1024,
+		Concurrency: 8,
+		ContentType: "application/octet-stream",
+		// Keep the segments if the upload fails, so that running it again
+		// with Resume and the same prefix only uploads the missing ones.
+		SegmentPrefix:         "disk.img/slo/2024-03-01/",
+		KeepSegmentsOnFailure: true,
+		Resume:                true,
+	}
+
+	res, err := largeobjects.Upload(context.TODO(), objectStorageClient, "my_container", "disk.img", uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Uploaded %d segments, skipped %d\n", res.UploadedSegments, res.SkippedSegments)
+
+Example to Create a Manifest from Existing Segments
+
+	createOpts := largeobjects.CreateManifestOpts{
+		Segments: []largeobjects.Segment{
+			{
+				Path: "my_segments/disk.img/00000000",
+			},
+			{
+				Path:  "my_segments/disk.img/00000001",
+				Range: "0-1048575",
+			},
+		},
+	}
+
+	_, err := largeobjects.CreateManifest(context.TODO(), objectStorageClient, "my_container", "disk.img", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Manifest of a Static Large Object
+
+	segments, err := largeobjects.GetManifest(context.TODO(), objectStorageClient, "my_container", "disk.img").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, segment := range segments {
+		fmt.Printf("%s: %d bytes\n", segment.Path, segment.SizeBytes)
+	}
+
+Example to Delete a Static Large Object and its Segments
+
+	deleteOpts := objects.DeleteOpts{
+		MultipartManifest: "delete",
+	}
+
+	_, err := objects.Delete(context.TODO(), objectStorageClient, "my_container", "disk.img", deleteOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package largeobjects
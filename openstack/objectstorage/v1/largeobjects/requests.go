@@ -0,0 +1,94 @@
+package largeobjects
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// CreateManifestOptsBuilder allows extensions to add additional parameters to
+// the CreateManifest request.
+type CreateManifestOptsBuilder interface {
+	ToManifestCreateParams() (io.Reader, map[string]string, error)
+}
+
+// CreateManifestOpts is a structure that holds parameters for creating the
+// manifest of a Static Large Object.
+type CreateManifestOpts struct {
+	// Segments are the segments of the object, in order.
+	Segments []Segment
+
+	// Metadata is the custom metadata of the object.
+	Metadata map[string]string
+
+	ContentType string `h:"Content-Type"`
+	DeleteAfter int64  `h:"X-Delete-After"`
+	DeleteAt    int64  `h:"X-Delete-At"`
+}
+
+// ToManifestCreateParams formats a CreateManifestOpts into a request body and
+// a map of headers.
+func (opts CreateManifestOpts) ToManifestCreateParams() (io.Reader, map[string]string, error) {
+	if len(opts.Segments) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "largeobjects.CreateManifestOpts.Segments"
+		return nil, nil, err
+	}
+
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range opts.Metadata {
+		h["X-Object-Meta-"+k] = v
+	}
+
+	b, err := json.Marshal(opts.Segments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bytes.NewReader(b), h, nil
+}
+
+// CreateManifest creates or replaces a Static Large Object by uploading its
+// manifest. The segments must already exist, Swift checks their size and ETag
+// against the manifest.
+func CreateManifest(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string, opts CreateManifestOptsBuilder) (r CreateManifestResult) {
+	url, err := createManifestURL(c, containerName, objectName)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	b, h, err := opts.ToManifestCreateParams()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := c.Put(ctx, url, b, nil, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetManifest retrieves the manifest of a Static Large Object.
+func GetManifest(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string) (r GetManifestResult) {
+	url, err := getManifestURL(c, containerName, objectName)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := c.Get(ctx, url, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
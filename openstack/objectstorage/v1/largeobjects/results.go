@@ -0,0 +1,64 @@
+package largeobjects
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// Segment is a segment of a Static Large Object, as listed in its manifest.
+type Segment struct {
+	// Path is the container and object name of the segment, in the
+	// {container}/{object} form.
+	Path string `json:"path"`
+
+	// ETag is the MD5 checksum of the content of the segment. It is checked
+	// by Swift when the manifest is created, unless it is empty.
+	ETag string `json:"etag,omitempty"`
+
+	// SizeBytes is the size of the segment. It is checked by Swift when the
+	// manifest is created, unless it is zero.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+
+	// Range restricts the segment to a range of bytes of the object, in the
+	// {start}-{end} form.
+	Range string `json:"range,omitempty"`
+}
+
+// Container returns the name of the container of the segment.
+func (s Segment) Container() string {
+	container, _, _ := strings.Cut(strings.TrimPrefix(s.Path, "/"), "/")
+	return container
+}
+
+// Object returns the name of the object of the segment.
+func (s Segment) Object() string {
+	_, object, _ := strings.Cut(strings.TrimPrefix(s.Path, "/"), "/")
+	return object
+}
+
+// CreateManifestResult represents the result of a CreateManifest operation.
+type CreateManifestResult struct {
+	gophercloud.HeaderResult
+}
+
+// Extract will return a struct of headers returned from a call to
+// CreateManifest.
+func (r CreateManifestResult) Extract() (*objects.CreateHeader, error) {
+	var s objects.CreateHeader
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetManifestResult represents the result of a GetManifest operation.
+type GetManifestResult struct {
+	gophercloud.Result
+}
+
+// Extract will return the segments listed in the manifest.
+func (r GetManifestResult) Extract() ([]Segment, error) {
+	var s []Segment
+	err := r.ExtractInto(&s)
+	return s, err
+}
@@ -0,0 +1,2 @@
+// largeobjects unit tests
+package testing
@@ -0,0 +1,291 @@
+package testing
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// CreateManifestRequest is the body of a CreateManifest request.
+const CreateManifestRequest = `
+[
+  {
+    "path": "testContainer_segments/testObject/slo/00000000",
+    "etag": "0cc175b9c0f1b6a831c399e269772661",
+    "size_bytes": 1
+  },
+  {
+    "path": "testContainer_segments/testObject/slo/00000001",
+    "range": "0-0"
+  }
+]
+`
+
+// GetManifestResponse is the raw manifest returned by GetManifest.
+const GetManifestResponse = `
+[
+  {
+    "path": "/testContainer_segments/testObject/slo/00000000",
+    "etag": "0cc175b9c0f1b6a831c399e269772661",
+    "size_bytes": 1
+  },
+  {
+    "path": "/testContainer_segments/testObject/slo/00000001",
+    "etag": "92eb5ffee6ae2fec3ad71c777531578f",
+    "size_bytes": 1
+  }
+]
+`
+
+// HandleCreateManifestSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that responds with a
+// `CreateManifest` response.
+func HandleCreateManifestSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+		th.TestHeader(t, r, "X-Object-Meta-Foo", "bar")
+		th.TestFormValues(t, r, map[string]string{"multipart-manifest": "put"})
+		th.TestJSONRequest(t, r, CreateManifestRequest)
+
+		w.Header().Set("ETag", `"d0ae0a4c7b1b86fc5bcd4bb84e1b7d5c"`)
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// HandleGetManifestSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that responds with a
+// `GetManifest` response.
+func HandleGetManifestSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"multipart-manifest": "get", "format": "raw"})
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetManifestResponse)
+	})
+}
+
+// fakeSwift is a minimal in-memory object store, which handles the requests
+// sent by Upload. Static Large Objects are stored in manifests, and regular
+// objects in objects.
+type fakeSwift struct {
+	mu         sync.Mutex
+	containers map[string]bool
+	objects    map[string][]byte
+	manifests  map[string][]byte
+	headers    map[string]http.Header
+
+	// failures is the number of times the upload of an object fails before
+	// succeeding, by suffix of the object.
+	failures map[string]int
+	// corrupted objects, by suffix, are stored with a wrong ETag.
+	corrupted map[string]bool
+	// puts counts the uploads of every object.
+	puts map[string]int
+}
+
+// HandleFakeSwift registers a fakeSwift on the test handler mux.
+func HandleFakeSwift(t *testing.T, fakeServer th.FakeServer) *fakeSwift {
+	s := &fakeSwift{
+		containers: make(map[string]bool),
+		objects:    make(map[string][]byte),
+		manifests:  make(map[string][]byte),
+		headers:    make(map[string]http.Header),
+		failures:   make(map[string]int),
+		corrupted:  make(map[string]bool),
+		puts:       make(map[string]int),
+	}
+
+	fakeServer.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		container, object, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if object == "" {
+			s.handleContainer(t, w, r, container)
+			return
+		}
+
+		key := container + "/" + object
+		switch r.Method {
+		case "PUT":
+			body, err := io.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			s.puts[key]++
+
+			if r.URL.Query().Get("multipart-manifest") == "put" {
+				delete(s.objects, key)
+				s.manifests[key] = body
+				s.headers[key] = r.Header.Clone()
+				w.Header().Set("ETag", `"slo-etag"`)
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+
+			for suffix, failures := range s.failures {
+				if failures > 0 && strings.HasSuffix(key, suffix) {
+					s.failures[suffix]--
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+			}
+
+			etag := fmt.Sprintf("%x", md5.Sum(body))
+			th.TestHeader(t, r, "ETag", etag)
+			for suffix := range s.corrupted {
+				if strings.HasSuffix(key, suffix) {
+					etag = "corrupted"
+				}
+			}
+			delete(s.manifests, key)
+			s.objects[key] = body
+			s.headers[key] = r.Header.Clone()
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusCreated)
+		case "HEAD":
+			switch {
+			case s.manifests[key] != nil:
+				w.Header().Set("X-Static-Large-Object", "True")
+			case s.objects[key] != nil:
+				w.Header().Set("ETag", fmt.Sprintf("%x", md5.Sum(s.objects[key])))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"multipart-manifest": "get", "format": "raw"})
+			if s.manifests[key] == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write(s.manifests[key])
+			th.AssertNoErr(t, err)
+		case "DELETE":
+			if _, ok := s.objects[key]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(s.objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	return s
+}
+
+func (s *fakeSwift) handleContainer(t *testing.T, w http.ResponseWriter, r *http.Request, container string) {
+	switch r.Method {
+	case "PUT":
+		s.containers[container] = true
+		w.WriteHeader(http.StatusCreated)
+	case "GET":
+		if !s.containers[container] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		prefix := container + "/" + r.URL.Query().Get("prefix")
+		marker := container + "/" + r.URL.Query().Get("marker")
+		var names []string
+		for key := range s.objects {
+			if strings.HasPrefix(key, prefix) && key > marker {
+				names = append(names, key)
+			}
+		}
+		sort.Strings(names)
+
+		list := make([]map[string]any, 0, len(names))
+		for _, key := range names {
+			list = append(list, map[string]any{
+				"name":  strings.TrimPrefix(key, container+"/"),
+				"hash":  fmt.Sprintf("%x", md5.Sum(s.objects[key])),
+				"bytes": len(s.objects[key]),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(list))
+	default:
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// store adds an object, as if it was left behind by a previous upload.
+func (s *fakeSwift) store(container, object string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.containers[container] = true
+	s.objects[container+"/"+object] = content
+}
+
+// read returns the content of an object, which is the concatenated content
+// of the segments of a Static Large Object. It fails when a segment is
+// missing or doesn't match the manifest.
+func (s *fakeSwift) read(container, object string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := container + "/" + object
+	if content, ok := s.objects[key]; ok {
+		return string(content), nil
+	}
+	body, ok := s.manifests[key]
+	if !ok {
+		return "", fmt.Errorf("object %s not found", key)
+	}
+
+	var manifest []struct {
+		Path string `json:"path"`
+		ETag string `json:"etag"`
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return "", err
+	}
+	var content []byte
+	for _, segment := range manifest {
+		segmentContent, ok := s.objects[segment.Path]
+		if !ok {
+			return "", fmt.Errorf("segment %s not found", segment.Path)
+		}
+		if etag := fmt.Sprintf("%x", md5.Sum(segmentContent)); etag != segment.ETag {
+			return "", fmt.Errorf("segment %s has ETag %s, expected %s", segment.Path, etag, segment.ETag)
+		}
+		content = append(content, segmentContent...)
+	}
+	return string(content), nil
+}
+
+// objectNames returns the names of the stored objects, in order.
+func (s *fakeSwift) objectNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	return names
+}
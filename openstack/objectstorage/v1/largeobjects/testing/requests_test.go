@@ -0,0 +1,311 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestCreateManifest(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateManifestSuccessfully(t, fakeServer)
+
+	options := largeobjects.CreateManifestOpts{
+		Segments: []largeobjects.Segment{
+			{
+				Path:      "testContainer_segments/testObject/slo/00000000",
+				ETag:      "0cc175b9c0f1b6a831c399e269772661",
+				SizeBytes: 1,
+			},
+			{
+				Path:  "testContainer_segments/testObject/slo/00000001",
+				Range: "0-0",
+			},
+		},
+		ContentType: "application/octet-stream",
+		Metadata:    map[string]string{"Foo": "bar"},
+	}
+	header, err := largeobjects.CreateManifest(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, `"d0ae0a4c7b1b86fc5bcd4bb84e1b7d5c"`, header.ETag)
+}
+
+func TestCreateManifestRequiresSegments(t *testing.T) {
+	_, _, err := largeobjects.CreateManifestOpts{}.ToManifestCreateParams()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestGetManifest(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetManifestSuccessfully(t, fakeServer)
+
+	segments, err := largeobjects.GetManifest(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(segments))
+	th.AssertEquals(t, "testContainer_segments", segments[1].Container())
+	th.AssertEquals(t, "testObject/slo/00000001", segments[1].Object())
+	th.AssertEquals(t, int64(1), segments[1].SizeBytes)
+}
+
+func TestUploadSmallObject(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	res, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:     bytes.NewReader([]byte("abcd")),
+		SegmentSize: 4,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(res.Segments))
+	th.AssertEquals(t, "e2fc714c4727ee9395f324cd2e7f331f", res.ETag)
+	th.CheckDeepEquals(t, []string{"testContainer/testObject"}, swift.objectNames())
+	th.AssertEquals(t, 0, len(swift.manifests))
+}
+
+func TestUpload(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	res, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:     bytes.NewReader([]byte("aaaabbbbcc")),
+		SegmentSize: 4,
+		Concurrency: 2,
+		ContentType: "text/plain",
+		Metadata:    map[string]string{"Foo": "bar"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, res.UploadedSegments)
+	th.AssertEquals(t, 0, res.SkippedSegments)
+	th.AssertEquals(t, `"slo-etag"`, res.ETag)
+
+	// The segments are named with a prefix unique to the upload.
+	prefix := uploadPrefix(t, res)
+	th.CheckDeepEquals(t, []string{
+		prefix + "00000000",
+		prefix + "00000001",
+		prefix + "00000002",
+	}, swift.objectNames())
+	th.AssertEquals(t, true, swift.containers["testContainer_segments"])
+
+	var manifest []largeobjects.Segment
+	th.AssertNoErr(t, json.Unmarshal(swift.manifests["testContainer/testObject"], &manifest))
+	th.CheckDeepEquals(t, []largeobjects.Segment{
+		{Path: prefix + "00000000", ETag: "74b87337454200d4d33f80c4663dc5e5", SizeBytes: 4},
+		{Path: prefix + "00000001", ETag: "65ba841e01d6db7733e90a5b7f9e6f80", SizeBytes: 4},
+		{Path: prefix + "00000002", ETag: "e0323a9039add2978bf5b49550572c7c", SizeBytes: 2},
+	}, manifest)
+	th.CheckDeepEquals(t, manifest, res.Segments)
+
+	header := swift.headers["testContainer/testObject"]
+	th.AssertEquals(t, "text/plain", header.Get("Content-Type"))
+	th.AssertEquals(t, "bar", header.Get("X-Object-Meta-Foo"))
+}
+
+func TestUploadRetries(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.failures["/00000001"] = 2
+
+	res, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:     bytes.NewReader([]byte("aaaabbbb")),
+		SegmentSize: 4,
+		MaxRetries:  2,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, res.UploadedSegments)
+	th.AssertEquals(t, 3, swift.puts[uploadPrefix(t, res)+"00000001"])
+}
+
+func TestUploadETagMismatch(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.corrupted["/00000001"] = true
+
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:     bytes.NewReader([]byte("aaaabbbbcccc")),
+		SegmentSize: 4,
+		MaxRetries:  -1,
+	})
+
+	var mismatch largeobjects.ErrSegmentETagMismatch
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected ErrSegmentETagMismatch, got %v", err)
+	}
+	th.AssertTrue(t, strings.HasPrefix(mismatch.Segment, "testObject/slo/"))
+	th.AssertTrue(t, strings.HasSuffix(mismatch.Segment, "/00000001"))
+	th.AssertEquals(t, "65ba841e01d6db7733e90a5b7f9e6f80", mismatch.Expected)
+	th.AssertEquals(t, "corrupted", mismatch.Actual)
+
+	th.AssertEquals(t, 0, len(swift.objectNames()))
+	th.AssertEquals(t, 0, len(swift.manifests))
+}
+
+func TestUploadKeepSegmentsOnFailure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.failures["testContainer_segments/testObject/slo/00000001"] = 1
+
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:               bytes.NewReader([]byte("aaaabbbb")),
+		SegmentSize:           4,
+		SegmentPrefix:         "testObject/slo/",
+		Concurrency:           1,
+		MaxRetries:            -1,
+		KeepSegmentsOnFailure: true,
+	})
+	if !gophercloud.ResponseCodeIs(err, 500) {
+		t.Fatalf("expected a 500 error, got %v", err)
+	}
+	th.CheckDeepEquals(t, []string{"testContainer_segments/testObject/slo/00000000"}, swift.objectNames())
+}
+
+func TestUploadResume(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.store("testContainer_segments", "testObject/slo/00000000", []byte("aaaa"))
+	swift.store("testContainer_segments", "testObject/slo/00000001", []byte("xxxx"))
+	swift.store("testContainer_segments", "testObject/slo/00000002", []byte("cc"))
+	swift.store("testContainer_segments", "testObject/slo/00000003", []byte("dd"))
+	swift.store("testContainer_segments", "otherObject/slo/00000000", []byte("aaaa"))
+
+	res, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:       bytes.NewReader([]byte("aaaabbbbcc")),
+		SegmentSize:   4,
+		SegmentPrefix: "testObject/slo/",
+		Resume:        true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, res.UploadedSegments)
+	th.AssertEquals(t, 2, res.SkippedSegments)
+	th.AssertEquals(t, 1, swift.puts["testContainer_segments/testObject/slo/00000001"])
+	th.AssertEquals(t, 0, swift.puts["testContainer_segments/testObject/slo/00000000"])
+
+	th.CheckDeepEquals(t, []string{
+		"testContainer_segments/otherObject/slo/00000000",
+		"testContainer_segments/testObject/slo/00000000",
+		"testContainer_segments/testObject/slo/00000001",
+		"testContainer_segments/testObject/slo/00000002",
+	}, swift.objectNames())
+}
+
+func TestUploadResumeFailure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+	swift.store("testContainer_segments", "testObject/slo/00000000", []byte("aaaa"))
+	swift.failures["testContainer_segments/testObject/slo/00000002"] = 1
+
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+		Content:       bytes.NewReader([]byte("aaaabbbbcc")),
+		SegmentSize:   4,
+		SegmentPrefix: "testObject/slo/",
+		Concurrency:   1,
+		MaxRetries:    -1,
+		Resume:        true,
+	})
+	if !gophercloud.ResponseCodeIs(err, 500) {
+		t.Fatalf("expected a 500 error, got %v", err)
+	}
+
+	// The segment uploaded by the failed upload is deleted, but not the one
+	// which was skipped.
+	th.AssertEquals(t, 1, swift.puts["testContainer_segments/testObject/slo/00000001"])
+	th.CheckDeepEquals(t, []string{"testContainer_segments/testObject/slo/00000000"}, swift.objectNames())
+}
+
+func TestUploadResumeRequiresSegmentPrefix(t *testing.T) {
+	_, err := largeobjects.Upload(context.TODO(), nil, "testContainer", "testObject", largeobjects.UploadOpts{
+		Content: bytes.NewReader([]byte("aaaa")),
+		Resume:  true,
+	})
+	missing, ok := err.(gophercloud.ErrMissingInput)
+	if !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+	th.AssertEquals(t, "largeobjects.UploadOpts.SegmentPrefix", missing.Argument)
+}
+
+func TestUploadReplace(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	swift := HandleFakeSwift(t, fakeServer)
+
+	upload := func(content string) (*largeobjects.UploadResponse, error) {
+		return largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", largeobjects.UploadOpts{
+			Content:     bytes.NewReader([]byte(content)),
+			SegmentSize: 4,
+			Concurrency: 1,
+			MaxRetries:  -1,
+		})
+	}
+
+	first, err := upload("aaaabbbbcc")
+	th.AssertNoErr(t, err)
+
+	// A failed upload leaves the object intact.
+	swift.failures["/00000002"] = 1
+	_, err = upload("AAAABBBBCC")
+	if !gophercloud.ResponseCodeIs(err, 500) {
+		t.Fatalf("expected a 500 error, got %v", err)
+	}
+	content, err := swift.read("testContainer", "testObject")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "aaaabbbbcc", content)
+	th.AssertEquals(t, 3, len(swift.objectNames()))
+
+	// The segments of the replaced object are deleted once it is replaced.
+	second, err := upload("AAAABBBBCC")
+	th.AssertNoErr(t, err)
+	content, err = swift.read("testContainer", "testObject")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "AAAABBBBCC", content)
+	if uploadPrefix(t, first) == uploadPrefix(t, second) {
+		t.Fatalf("expected the uploads to use different prefixes, got %s", uploadPrefix(t, first))
+	}
+	prefix := uploadPrefix(t, second)
+	th.CheckDeepEquals(t, []string{prefix + "00000000", prefix + "00000001", prefix + "00000002"}, swift.objectNames())
+
+	// So are they when the object is replaced by a regular object.
+	_, err = upload("abcd")
+	th.AssertNoErr(t, err)
+	content, err = swift.read("testContainer", "testObject")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "abcd", content)
+	th.CheckDeepEquals(t, []string{"testContainer/testObject"}, swift.objectNames())
+}
+
+func TestUploadRequiresContent(t *testing.T) {
+	_, err := largeobjects.Upload(context.TODO(), nil, "testContainer", "testObject", largeobjects.UploadOpts{})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+// uploadPrefix returns the segment prefix of an upload, with its container.
+func uploadPrefix(t *testing.T, res *largeobjects.UploadResponse) string {
+	t.Helper()
+	th.AssertTrue(t, len(res.Segments) > 0)
+	path := res.Segments[0].Path
+	if !strings.HasPrefix(path, "testContainer_segments/testObject/slo/") || !strings.HasSuffix(path, "/4/00000000") {
+		t.Fatalf("unexpected segment path %s", path)
+	}
+	return strings.TrimSuffix(path, "00000000")
+}
@@ -0,0 +1,34 @@
+package largeobjects
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud/v2"
+	v1 "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1"
+)
+
+func manifestURL(c *gophercloud.ServiceClient, container, object string) (string, error) {
+	if err := v1.CheckContainerName(container); err != nil {
+		return "", err
+	}
+	if err := v1.CheckObjectName(object); err != nil {
+		return "", err
+	}
+	return c.ServiceURL(url.PathEscape(container), url.PathEscape(object)), nil
+}
+
+func createManifestURL(c *gophercloud.ServiceClient, container, object string) (string, error) {
+	u, err := manifestURL(c, container, object)
+	if err != nil {
+		return "", err
+	}
+	return u + "?multipart-manifest=put", nil
+}
+
+func getManifestURL(c *gophercloud.ServiceClient, container, object string) (string, error) {
+	u, err := manifestURL(c, container, object)
+	if err != nil {
+		return "", err
+	}
+	return u + "?multipart-manifest=get&format=raw", nil
+}
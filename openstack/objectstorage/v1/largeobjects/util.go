@@ -0,0 +1,483 @@
+package largeobjects
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	v1 "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+const (
+	// DefaultSegmentSize is the size of the segments uploaded by Upload when
+	// UploadOpts.SegmentSize is not set.
+	DefaultSegmentSize int64 = 100 * 1024 * 1024
+
+	// DefaultConcurrency is the number of segments uploaded concurrently by
+	// Upload when UploadOpts.Concurrency is not set.
+	DefaultConcurrency = 4
+
+	// DefaultMaxRetries is the number of times Upload retries to upload a
+	// segment when UploadOpts.MaxRetries is not set.
+	DefaultMaxRetries = 3
+)
+
+// ErrSegmentETagMismatch is the error returned when the ETag returned by Swift
+// for an uploaded segment does not match the MD5 checksum of its content.
+type ErrSegmentETagMismatch struct {
+	gophercloud.BaseError
+	Segment  string
+	Expected string
+	Actual   string
+}
+
+func (e ErrSegmentETagMismatch) Error() string {
+	return fmt.Sprintf("segment %q was stored with ETag %q, expected %q", e.Segment, e.Actual, e.Expected)
+}
+
+// UploadOpts is a structure that holds parameters for uploading a Static
+// Large Object.
+type UploadOpts struct {
+	// Content is the content of the object. It is read sequentially, and up
+	// to Concurrency+2 segments are held in memory at once.
+	Content io.Reader
+
+	// SegmentSize is the size of every segment but the last one. Content
+	// which is not larger than SegmentSize is uploaded as a regular object.
+	// Defaults to DefaultSegmentSize.
+	SegmentSize int64
+
+	// SegmentContainer is the container the segments are uploaded to. It is
+	// created if needed. Defaults to the container of the object, suffixed
+	// with "_segments".
+	SegmentContainer string
+
+	// SegmentPrefix is prepended to the index of a segment to name it.
+	// Defaults to a prefix unique to the upload, in the
+	// {object}/slo/{unix-nanoseconds}/{segment-size}/ form, so that the
+	// segments of the object being replaced are left untouched until the
+	// upload succeeds.
+	SegmentPrefix string
+
+	// Concurrency is the number of segments uploaded concurrently. Defaults
+	// to DefaultConcurrency.
+	Concurrency int
+
+	// MaxRetries is the number of times the upload of a segment is retried.
+	// Defaults to DefaultMaxRetries. Set it to a negative value to disable
+	// retries.
+	MaxRetries int
+
+	// Resume skips the upload of the segments which already exist with the
+	// same size and MD5 checksum, such as the ones of a previous upload which
+	// failed with KeepSegmentsOnFailure set. It requires SegmentPrefix to be
+	// set to the prefix of that upload. The other segments are overwritten,
+	// so the prefix must not be the one of the segments of an existing
+	// object. Once the manifest is created, the other existing segments with
+	// the same prefix are deleted.
+	Resume bool
+
+	// KeepSegmentsOnFailure leaves the segments in place when the upload
+	// fails, so that it can be resumed. By default they are deleted.
+	KeepSegmentsOnFailure bool
+
+	// ContentType is the content type of the object.
+	ContentType string
+
+	// Metadata is the custom metadata of the object.
+	Metadata map[string]string
+}
+
+// UploadResponse describes a completed Upload.
+type UploadResponse struct {
+	// Segments are the segments of the object, in order. It is empty when
+	// the content was uploaded as a regular object.
+	Segments []Segment
+
+	// UploadedSegments is the number of segments which were uploaded.
+	UploadedSegments int
+
+	// SkippedSegments is the number of segments which already existed and
+	// were not uploaded again.
+	SkippedSegments int
+
+	// ETag is the ETag of the object.
+	ETag string
+}
+
+// Upload uploads the content as a Static Large Object: the content is split
+// into segments which are uploaded concurrently, then the manifest of the
+// object is created. The ETag of every segment is checked against the MD5
+// checksum of its content, and failed segment uploads are retried. Unless
+// KeepSegmentsOnFailure is set, the segments are deleted if the upload fails.
+//
+// When the object already exists as a Static Large Object, the segments of
+// its manifest which are not used by the new object are deleted once the new
+// object is created.
+func Upload(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string, opts UploadOpts) (*UploadResponse, error) {
+	if opts.Content == nil {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "largeobjects.UploadOpts.Content"
+		return nil, err
+	}
+	if err := v1.CheckContainerName(containerName); err != nil {
+		return nil, err
+	}
+	if err := v1.CheckObjectName(objectName); err != nil {
+		return nil, err
+	}
+
+	u := uploader{
+		client:           c,
+		opts:             opts,
+		container:        containerName,
+		object:           objectName,
+		segmentSize:      opts.SegmentSize,
+		concurrency:      opts.Concurrency,
+		maxRetries:       opts.MaxRetries,
+		segmentPrefix:    opts.SegmentPrefix,
+		segmentContainer: opts.SegmentContainer,
+	}
+	if u.segmentSize <= 0 {
+		u.segmentSize = DefaultSegmentSize
+	}
+	if u.concurrency <= 0 {
+		u.concurrency = DefaultConcurrency
+	}
+	if u.maxRetries == 0 {
+		u.maxRetries = DefaultMaxRetries
+	} else if u.maxRetries < 0 {
+		u.maxRetries = 0
+	}
+	if u.segmentContainer == "" {
+		u.segmentContainer = containerName + "_segments"
+	}
+	if u.segmentPrefix == "" {
+		if opts.Resume {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "largeobjects.UploadOpts.SegmentPrefix"
+			return nil, err
+		}
+		u.segmentPrefix = fmt.Sprintf("%s/slo/%d/%d/", objectName, time.Now().UnixNano(), u.segmentSize)
+	}
+	if err := v1.CheckContainerName(u.segmentContainer); err != nil {
+		return nil, err
+	}
+
+	replaced, err := u.replacedSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Content which fits in a single segment is uploaded as is.
+	first, err := readSegment(opts.Content, u.segmentSize)
+	if err != nil {
+		return nil, err
+	}
+	var second []byte
+	if int64(len(first)) == u.segmentSize {
+		second, err = readSegment(opts.Content, u.segmentSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var res *UploadResponse
+	if len(second) == 0 {
+		res, err = u.uploadObject(ctx, first)
+	} else {
+		res, err = u.uploadSLO(ctx, first, second)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	u.deleteReplaced(ctx, replaced)
+	return res, nil
+}
+
+type uploader struct {
+	client           *gophercloud.ServiceClient
+	opts             UploadOpts
+	container        string
+	object           string
+	segmentSize      int64
+	concurrency      int
+	maxRetries       int
+	segmentPrefix    string
+	segmentContainer string
+
+	mu       sync.Mutex
+	err      error
+	segments []Segment
+	uploaded int
+	skipped  int
+
+	// written are the names of the segments whose upload was started, which
+	// are the only ones deleted when the upload fails.
+	written []string
+}
+
+// readSegment reads up to size bytes. It returns an empty slice at the end of
+// the content.
+func readSegment(r io.Reader, size int64) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, size))
+	_, err := io.CopyN(buf, r, size)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// replacedSegments returns the segments of the object when it already exists
+// as a Static Large Object.
+func (u *uploader) replacedSegments(ctx context.Context) ([]Segment, error) {
+	header, err := objects.Get(ctx, u.client, u.container, u.object, nil).Extract()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !header.StaticLargeObject {
+		return nil, nil
+	}
+	return GetManifest(ctx, u.client, u.container, u.object).Extract()
+}
+
+// deleteReplaced deletes the segments of the replaced object which are not
+// segments of the new one.
+func (u *uploader) deleteReplaced(ctx context.Context, replaced []Segment) {
+	inUse := make(map[string]bool, len(u.segments))
+	for _, s := range u.segments {
+		inUse[s.Container()+"/"+s.Object()] = true
+	}
+	for _, s := range replaced {
+		if !inUse[s.Container()+"/"+s.Object()] {
+			_ = deleteSegment(ctx, u.client, s.Container(), s.Object())
+		}
+	}
+}
+
+func (u *uploader) uploadObject(ctx context.Context, content []byte) (*UploadResponse, error) {
+	createOpts := objects.CreateOpts{
+		Content:       bytes.NewReader(content),
+		ContentLength: int64(len(content)),
+		ContentType:   u.opts.ContentType,
+		Metadata:      u.opts.Metadata,
+	}
+	header, err := objects.Create(ctx, u.client, u.container, u.object, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadResponse{ETag: header.ETag}, nil
+}
+
+func (u *uploader) uploadSLO(ctx context.Context, first, second []byte) (*UploadResponse, error) {
+	if u.segmentContainer != u.container {
+		err := containers.Create(ctx, u.client, u.segmentContainer, nil).Err
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	existing := make(map[string]objects.Object)
+	if u.opts.Resume {
+		allPages, err := objects.List(u.client, u.segmentContainer, objects.ListOpts{Prefix: u.segmentPrefix}).AllPages(ctx)
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil, err
+		}
+		var allObjects []objects.Object
+		if err == nil {
+			allObjects, err = objects.ExtractInfo(allPages)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, o := range allObjects {
+			existing[o.Name] = o
+		}
+	}
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, u.concurrency)
+	var wg sync.WaitGroup
+
+	segment := first
+	next := second
+	for index := 0; len(segment) > 0; index++ {
+		if u.failed() {
+			break
+		}
+
+		name := fmt.Sprintf("%s%08d", u.segmentPrefix, index)
+		sum := fmt.Sprintf("%x", md5.Sum(segment))
+
+		u.mu.Lock()
+		u.segments = append(u.segments, Segment{
+			Path:      u.segmentContainer + "/" + name,
+			ETag:      sum,
+			SizeBytes: int64(len(segment)),
+		})
+		u.mu.Unlock()
+
+		if o, ok := existing[name]; ok && o.Hash == sum && o.Bytes == int64(len(segment)) {
+			u.mu.Lock()
+			u.skipped++
+			u.mu.Unlock()
+		} else {
+			select {
+			case sem <- struct{}{}:
+			case <-uploadCtx.Done():
+				u.fail(uploadCtx.Err())
+			}
+			if u.failed() {
+				break
+			}
+
+			u.mu.Lock()
+			u.written = append(u.written, name)
+			u.mu.Unlock()
+
+			wg.Add(1)
+			go func(name, sum string, content []byte) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				if err := u.uploadSegment(uploadCtx, name, sum, content); err != nil {
+					u.fail(err)
+					cancel()
+					return
+				}
+
+				u.mu.Lock()
+				u.uploaded++
+				u.mu.Unlock()
+			}(name, sum, segment)
+		}
+
+		segment = next
+		next = nil
+		if int64(len(segment)) == u.segmentSize {
+			var err error
+			next, err = readSegment(u.opts.Content, u.segmentSize)
+			if err != nil {
+				u.fail(err)
+			}
+		}
+	}
+
+	wg.Wait()
+
+	if err := u.firstErr(); err != nil {
+		u.cleanup(ctx)
+		return nil, err
+	}
+
+	createOpts := CreateManifestOpts{
+		Segments:    u.segments,
+		ContentType: u.opts.ContentType,
+		Metadata:    u.opts.Metadata,
+	}
+	header, err := CreateManifest(ctx, u.client, u.container, u.object, createOpts).Extract()
+	if err != nil {
+		u.cleanup(ctx)
+		return nil, err
+	}
+
+	// Segments left behind by a previous, larger upload are no longer
+	// referenced by any manifest.
+	inUse := make(map[string]bool, len(u.segments))
+	for _, s := range u.segments {
+		inUse[s.Object()] = true
+	}
+	for name := range existing {
+		if !inUse[name] {
+			_ = deleteSegment(ctx, u.client, u.segmentContainer, name)
+		}
+	}
+
+	return &UploadResponse{
+		Segments:         u.segments,
+		UploadedSegments: u.uploaded,
+		SkippedSegments:  u.skipped,
+		ETag:             header.ETag,
+	}, nil
+}
+
+func (u *uploader) uploadSegment(ctx context.Context, name, sum string, content []byte) error {
+	var err error
+	for attempt := 0; attempt <= u.maxRetries; attempt++ {
+		createOpts := objects.CreateOpts{
+			Content:       bytes.NewReader(content),
+			ContentLength: int64(len(content)),
+			ETag:          sum,
+		}
+
+		var header *objects.CreateHeader
+		header, err = objects.Create(ctx, u.client, u.segmentContainer, name, createOpts).Extract()
+		if err == nil {
+			etag := strings.Trim(header.ETag, `"`)
+			if etag == "" || etag == sum {
+				return nil
+			}
+			err = ErrSegmentETagMismatch{Segment: name, Expected: sum, Actual: etag}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// cleanup deletes the segments written by a failed upload. The existing
+// segments skipped with Resume are kept. It is not interrupted by the
+// cancellation of the upload.
+func (u *uploader) cleanup(ctx context.Context) {
+	if u.opts.KeepSegmentsOnFailure {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	for _, name := range u.written {
+		_ = deleteSegment(ctx, u.client, u.segmentContainer, name)
+	}
+}
+
+func (u *uploader) fail(err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.err == nil {
+		u.err = err
+	}
+}
+
+func (u *uploader) failed() bool {
+	return u.firstErr() != nil
+}
+
+func (u *uploader) firstErr() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.err
+}
+
+func deleteSegment(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string) error {
+	err := objects.Delete(ctx, c, containerName, objectName, nil).Err
+	if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
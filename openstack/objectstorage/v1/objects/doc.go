@@ -106,5 +106,134 @@ Example to Download an Object's Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a FormPOST Upload Form
+
+	formOpts := objects.CreateFormPOSTOpts{
+		TTL:          3600,
+		MaxFileSize:  100 * 1024 * 1024,
+		MaxFileCount: 10,
+		Prefix:       "uploads/",
+		Redirect:     "https://example.com/uploaded",
+		Digest:       "sha256",
+	}
+
+	form, err := objects.CreateFormPOST(context.TODO(), objectStorageClient, "my_container", formOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("<form action=%q method=\"POST\" enctype=\"multipart/form-data\">\n", form.URL)
+	for name, value := range form.Fields() {
+		fmt.Printf("<input type=\"hidden\" name=%q value=%q/>\n", name, value)
+	}
+	fmt.Println("<input type=\"file\" name=\"file\"/>\n</form>")
+
+Example to Create and Inspect a Symlink
+
+	symlinkOpts := objects.CreateSymlinkOpts{
+		TargetContainer: "my_container",
+		TargetObject:    "releases/1.2.3.tar.gz",
+	}
+
+	err := objects.CreateSymlink(context.TODO(), objectStorageClient, "my_container", "releases/latest.tar.gz", symlinkOpts).Err
+	if err != nil {
+		panic(err)
+	}
+
+	getOpts := objects.GetOpts{
+		Symlink: "get",
+	}
+
+	symlink, err := objects.Get(context.TODO(), objectStorageClient, "my_container", "releases/latest.tar.gz", getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%s\n", symlink.SymlinkTarget)
+
+Example to List, Get and Delete the Versions of an Object
+
+	listOpts := objects.ListOpts{
+		Prefix:   "my_object",
+		Versions: true,
+	}
+
+	allPages, err := objects.List(objectStorageClient, "my_versioned_container", listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	versions, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, version := range versions {
+		if version.Name != "my_object" || version.IsLatest || version.IsDeleteMarker() {
+			continue
+		}
+
+		downloadOpts := objects.DownloadOpts{
+			ObjectVersionID: version.VersionID,
+		}
+		download := objects.Download(context.TODO(), objectStorageClient, "my_versioned_container", "my_object", downloadOpts)
+		content, err := download.ExtractContent()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s: %d bytes\n", version.VersionID, len(content))
+
+		deleteOpts := objects.DeleteOpts{
+			ObjectVersionID: version.VersionID,
+		}
+		_, err = objects.Delete(context.TODO(), objectStorageClient, "my_versioned_container", "my_object", deleteOpts).Extract()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+Example to Download an Object in Parallel and Resume an Interrupted Download
+
+	f, err := os.OpenFile("backup.tar", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	downloadOpts := objects.DownloadParallelOpts{
+		ChunkSize:   64 * 1024 * 1024,
+		Concurrency: 8,
+	}
+
+	n, err := objects.DownloadParallel(context.TODO(), objectStorageClient, "my_container", "backup.tar", f, downloadOpts)
+	if err != nil {
+		// Only the content after the first n bytes is downloaded again.
+		downloadOpts.Offset = n
+		_, err = objects.DownloadParallel(context.TODO(), objectStorageClient, "my_container", "backup.tar", f, downloadOpts)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+Example to Upload Files as an Archive Extracted into a Container
+
+	extractOpts := objects.ExtractArchiveOpts{
+		Format: objects.ArchiveTarGz,
+		Files: []objects.ArchiveFile{
+			{Name: "docs/index.html", Path: "site/index.html"},
+			{Name: "docs/style.css", Content: strings.NewReader("body {}"), Size: 7},
+		},
+	}
+
+	extracted, err := objects.ExtractArchive(context.TODO(), objectStorageClient, "my_container", extractOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// Swift reports the files which could not be created in the response.
+	for _, bulkErr := range extracted.Errors {
+		fmt.Printf("%s: %s\n", bulkErr.Name, bulkErr.Status)
+	}
 */
 package objects
@@ -1,7 +1,9 @@
 package objects
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/hmac"
 	"crypto/md5"
@@ -12,6 +14,7 @@ import (
 	"hash"
 	"io"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
@@ -62,6 +65,11 @@ type ListOpts struct {
 	Delimiter string `q:"delimiter"`
 	Path      string `q:"path"`
 	Versions  bool   `q:"versions"`
+
+	// VersionMarker lists the versions of the Marker object older than this
+	// version ID, then the versions of the following objects. It requires
+	// Versions.
+	VersionMarker string `q:"version_marker"`
 }
 
 // ToObjectListParams formats a ListOpts into a query string.
@@ -116,6 +124,9 @@ type DownloadOpts struct {
 	MultipartManifest string    `q:"multipart-manifest"`
 	Signature         string    `q:"signature"`
 	ObjectVersionID   string    `q:"version-id"`
+
+	// Symlink set to "get" downloads a symlink itself instead of its target.
+	Symlink string `q:"symlink"`
 }
 
 // ToObjectDownloadParams formats a DownloadOpts into a query string and map of
@@ -416,7 +427,11 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, containerName, ob
 		}
 		url += query
 	}
-	resp, err := c.Delete(ctx, url, nil)
+	// The deletion of a Static Large Object and its segments responds with
+	// 200 and the report of the deletion.
+	resp, err := c.Delete(ctx, url, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -434,6 +449,10 @@ type GetOpts struct {
 	Expires         string `q:"expires"`
 	Signature       string `q:"signature"`
 	ObjectVersionID string `q:"version-id"`
+
+	// Symlink set to "get" retrieves the metadata of a symlink itself,
+	// including its target, instead of the metadata of its target.
+	Symlink string `q:"symlink"`
 }
 
 // ToObjectGetParams formats a GetOpts into a query string and a map of headers.
@@ -611,37 +630,229 @@ func CreateTempURL(ctx context.Context, c *gophercloud.ServiceClient, containerN
 	// UNIX time is always UTC
 	expiry := date.Add(duration).Unix()
 
-	// Initialize the tempURLKey to calculate a signature
-	tempURLKey := opts.TempURLKey
+	secretKey, err := resolveTempURLKey(ctx, c, containerName, opts.TempURLKey)
+	if err != nil {
+		return "", err
+	}
+
+	_, objectPath, splitFound := strings.Cut(urlToBeSigned, opts.Split)
+	if !splitFound {
+		return "", fmt.Errorf("URL prefix %q not found", opts.Split)
+	}
+	objectPath = opts.Split + objectPath
+	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
+	hexsum, err := signTempURLBody(secretKey, opts.Digest, body)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?temp_url_sig=%s&temp_url_expires=%d", url, hexsum, expiry), nil
+}
+
+// CreateFormPOSTOpts are options for creating the signature of a form which
+// uploads files to a container with the FormPOST middleware.
+type CreateFormPOSTOpts struct {
+	// (REQUIRED) TTL is the number of seconds the form should be valid.
+	TTL int
+
+	// (REQUIRED) MaxFileSize is the maximum size in bytes of an uploaded file.
+	MaxFileSize int64
+
+	// (REQUIRED) MaxFileCount is the maximum number of files uploaded at once.
+	MaxFileCount int
+
+	// (Optional) Prefix is prepended to the names of the uploaded files.
+	Prefix string
+
+	// (Optional) Redirect is the URL the browser is redirected to once the
+	// files are uploaded, with the status and message of the upload in its
+	// query. Without it, the response is a plain text page.
+	Redirect string
+
+	// (Optional) Split is the string on which to split the container URL.
+	// Since only the path is used in the hash, the container URL needs to
+	// be parsed. If empty, the default OpenStack URL split point will be
+	// used ("/v1/").
+	Split string
+
+	// (Optional) Timestamp is the current timestamp used to calculate the
+	// expiration of the form. If not specified, the current UNIX timestamp is
+	// used.
+	Timestamp time.Time
+
+	// (Optional) TempURLKey overrides the Swift container or account Temp URL
+	// key, which also signs forms. If not specified, the key is obtained from
+	// a Swift container or account.
+	TempURLKey string
+
+	// (Optional) Digest specifies the cryptographic hash function used to
+	// calculate the signature. Valid values include sha1, sha256, and
+	// sha512. If not specified, the default hash function is sha1.
+	Digest string
+}
+
+// CreateFormPOST is a function for creating the signature and the hidden
+// fields of an HTML form, which lets browsers upload files to a container
+// without credentials, until the form expires.
+func CreateFormPOST(ctx context.Context, c *gophercloud.ServiceClient, containerName string, opts CreateFormPOSTOpts) (*FormPOST, error) {
+	if opts.MaxFileSize <= 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.CreateFormPOSTOpts.MaxFileSize"
+		return nil, err
+	}
+	if opts.MaxFileCount <= 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.CreateFormPOSTOpts.MaxFileCount"
+		return nil, err
+	}
+
+	containerURL, err := listURL(c, containerName)
+	if err != nil {
+		return nil, err
+	}
+	urlToBeSigned := tempURL(c, containerName, opts.Prefix)
+
+	if opts.Split == "" {
+		opts.Split = "/v1/"
+	}
+
+	date := opts.Timestamp
+	if date.IsZero() {
+		date = time.Now()
+	}
+	expiry := date.Add(time.Duration(opts.TTL) * time.Second).Unix()
+
+	secretKey, err := resolveTempURLKey(ctx, c, containerName, opts.TempURLKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, path, splitFound := strings.Cut(urlToBeSigned, opts.Split)
+	if !splitFound {
+		return nil, fmt.Errorf("URL prefix %q not found", opts.Split)
+	}
+	path = opts.Split + path
+	body := fmt.Sprintf("%s\n%s\n%d\n%d\n%d", path, opts.Redirect, opts.MaxFileSize, opts.MaxFileCount, expiry)
+	hexsum, err := signTempURLBody(secretKey, opts.Digest, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FormPOST{
+		URL:          containerURL + "/" + url.PathEscape(opts.Prefix),
+		Redirect:     opts.Redirect,
+		MaxFileSize:  opts.MaxFileSize,
+		MaxFileCount: opts.MaxFileCount,
+		Expires:      expiry,
+		Signature:    hexsum,
+	}, nil
+}
+
+// CreateSymlinkOptsBuilder allows extensions to add additional parameters to
+// the CreateSymlink request.
+type CreateSymlinkOptsBuilder interface {
+	ToObjectCreateSymlinkParams() (map[string]string, error)
+}
+
+// CreateSymlinkOpts is a structure that holds parameters for creating a
+// symlink to an object.
+type CreateSymlinkOpts struct {
+	// TargetContainer is the container of the target object.
+	TargetContainer string `required:"true"`
+
+	// TargetObject is the name of the target object.
+	TargetObject string `required:"true"`
+
+	// TargetAccount is the account of the target object, when it differs from
+	// the account of the symlink.
+	TargetAccount string `h:"X-Symlink-Target-Account"`
+
+	// TargetETag makes the symlink static: it can only be created if the
+	// target has this ETag, and fails to resolve once the target changes.
+	TargetETag string `h:"X-Symlink-Target-Etag"`
+
+	ContentType string `h:"Content-Type"`
+	DeleteAfter int64  `h:"X-Delete-After"`
+	DeleteAt    int64  `h:"X-Delete-At"`
+
+	Metadata map[string]string
+}
+
+// ToObjectCreateSymlinkParams formats a CreateSymlinkOpts into a map of
+// headers.
+func (opts CreateSymlinkOpts) ToObjectCreateSymlinkParams() (map[string]string, error) {
+	if err := v1.CheckContainerName(opts.TargetContainer); err != nil {
+		return nil, err
+	}
+	if err := v1.CheckObjectName(opts.TargetObject); err != nil {
+		return nil, err
+	}
+
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range opts.Metadata {
+		h["X-Object-Meta-"+k] = v
+	}
+	h["X-Symlink-Target"] = url.PathEscape(opts.TargetContainer) + "/" + url.PathEscape(opts.TargetObject)
+
+	return h, nil
+}
+
+// CreateSymlink is a function that creates or replaces an object with a
+// symlink to another object. Requests to the symlink are redirected to its
+// target, unless they set the Symlink option to "get".
+func CreateSymlink(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string, opts CreateSymlinkOptsBuilder) (r CreateResult) {
+	url, err := createURL(c, containerName, objectName)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	h, err := opts.ToObjectCreateSymlinkParams()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := c.Put(ctx, url, bytes.NewReader(nil), nil, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// resolveTempURLKey returns the given key or, when it is empty, the Temp URL
+// key of the container or of the account.
+func resolveTempURLKey(ctx context.Context, c *gophercloud.ServiceClient, containerName, tempURLKey string) ([]byte, error) {
 	if tempURLKey == "" {
 		// fallback to a container TempURL key
 		getHeader, err := containers.Get(ctx, c, containerName, nil).Extract()
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		tempURLKey = getHeader.TempURLKey
 		if tempURLKey == "" {
 			// fallback to an account TempURL key
 			getHeader, err := accounts.Get(ctx, c, nil).Extract()
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			tempURLKey = getHeader.TempURLKey
 		}
 		if tempURLKey == "" {
-			return "", ErrTempURLKeyNotFound{}
+			return nil, ErrTempURLKeyNotFound{}
 		}
 	}
 
-	secretKey := []byte(tempURLKey)
-	_, objectPath, splitFound := strings.Cut(urlToBeSigned, opts.Split)
-	if !splitFound {
-		return "", fmt.Errorf("URL prefix %q not found", opts.Split)
-	}
-	objectPath = opts.Split + objectPath
-	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
+	return []byte(tempURLKey), nil
+}
+
+// signTempURLBody returns the hexadecimal HMAC signature of a body, as
+// expected by the tempurl and formpost middlewares.
+func signTempURLBody(secretKey []byte, digest, body string) (string, error) {
 	var hash hash.Hash
-	switch opts.Digest {
+	switch digest {
 	case "", "sha1":
 		hash = hmac.New(sha1.New, secretKey)
 	case "sha256":
@@ -649,11 +860,10 @@ func CreateTempURL(ctx context.Context, c *gophercloud.ServiceClient, containerN
 	case "sha512":
 		hash = hmac.New(sha512.New, secretKey)
 	default:
-		return "", ErrTempURLDigestNotValid{Digest: opts.Digest}
+		return "", ErrTempURLDigestNotValid{Digest: digest}
 	}
 	hash.Write([]byte(body))
-	hexsum := fmt.Sprintf("%x", hash.Sum(nil))
-	return fmt.Sprintf("%s?temp_url_sig=%s&temp_url_expires=%d", url, hexsum, expiry), nil
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
 }
 
 // BulkDelete is a function that bulk deletes objects.
@@ -692,3 +902,185 @@ func BulkDelete(ctx context.Context, c *gophercloud.ServiceClient, container str
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// ArchiveFormat is the format of an archive uploaded with ExtractArchive.
+type ArchiveFormat string
+
+const (
+	// ArchiveTar is an uncompressed tar archive.
+	ArchiveTar ArchiveFormat = "tar"
+	// ArchiveTarGz is a gzipped tar archive.
+	ArchiveTarGz ArchiveFormat = "tar.gz"
+	// ArchiveTarBz2 is a bzip2 compressed tar archive.
+	ArchiveTarBz2 ArchiveFormat = "tar.bz2"
+)
+
+// ArchiveFile is a file added to the archive built by ExtractArchiveOpts.
+type ArchiveFile struct {
+	// Name is the name of the object, relative to the container, or of
+	// the container followed by the name of the object when the archive is
+	// extracted to the account.
+	Name string
+
+	// Path is the local file holding the content of the object. Exactly one
+	// of Path and Content must be set.
+	Path string
+
+	// Content is the content of the object, of Size bytes.
+	Content io.Reader
+	Size    int64
+}
+
+// ExtractArchiveOptsBuilder allows extensions to add additional parameters to
+// the ExtractArchive request.
+type ExtractArchiveOptsBuilder interface {
+	ToObjectExtractArchiveParams() (io.Reader, ArchiveFormat, error)
+}
+
+// ExtractArchiveOpts is a structure that holds parameters for uploading an
+// archive with ExtractArchive.
+type ExtractArchiveOpts struct {
+	// Content is an archive in Format. Exactly one of Content and Files
+	// must be set.
+	Content io.Reader
+
+	// Files are streamed as a tar archive, which is gzipped when Format is
+	// ArchiveTarGz. ArchiveTarBz2 is not supported.
+	Files []ArchiveFile
+
+	// Format is the format of the archive. Defaults to ArchiveTar.
+	Format ArchiveFormat
+}
+
+// ToObjectExtractArchiveParams formats an ExtractArchiveOpts into a request
+// body and an archive format. The archive of Files is written to the body
+// as it is read.
+func (opts ExtractArchiveOpts) ToObjectExtractArchiveParams() (io.Reader, ArchiveFormat, error) {
+	format := opts.Format
+	if format == "" {
+		format = ArchiveTar
+	}
+
+	if (opts.Content == nil) == (len(opts.Files) == 0) {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.ExtractArchiveOpts.Content/objects.ExtractArchiveOpts.Files"
+		err.Info = "exactly one of Content and Files must be set"
+		return nil, "", err
+	}
+	if opts.Content != nil {
+		return opts.Content, format, nil
+	}
+
+	if format != ArchiveTar && format != ArchiveTarGz {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "objects.ExtractArchiveOpts.Format"
+		err.Value = format
+		err.Info = fmt.Sprintf("an archive of files can only be built in the %q and %q formats", ArchiveTar, ArchiveTarGz)
+		return nil, "", err
+	}
+	for _, f := range opts.Files {
+		if f.Name == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "objects.ArchiveFile.Name"
+			return nil, "", err
+		}
+		if (f.Path == "") == (f.Content == nil) {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "objects.ArchiveFile.Path/objects.ArchiveFile.Content"
+			err.Info = fmt.Sprintf("exactly one of Path and Content must be set for %q", f.Name)
+			return nil, "", err
+		}
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeArchive(pw, opts.Files, format == ArchiveTarGz))
+	}()
+	return pr, format, nil
+}
+
+func writeArchive(w io.Writer, files []ArchiveFile, gzipped bool) error {
+	var gw *gzip.Writer
+	if gzipped {
+		gw = gzip.NewWriter(w)
+		w = gw
+	}
+
+	tw := tar.NewWriter(w)
+	for _, f := range files {
+		if err := writeArchiveFile(tw, f); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if gw != nil {
+		return gw.Close()
+	}
+	return nil
+}
+
+func writeArchiveFile(tw *tar.Writer, f ArchiveFile) error {
+	content, size := f.Content, f.Size
+	if f.Path != "" {
+		file, err := os.Open(f.Path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		content, size = file, info.Size()
+	}
+
+	header := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     f.Name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, content)
+	return err
+}
+
+// ExtractArchive is a function that uploads the files of an archive as
+// objects, in a single request. When containerName is empty, the archive is
+// extracted to the account: the first directory of the path of every file
+// names its container, which is created if needed.
+//
+// The request succeeds even if some files fail to be created: the status of
+// the upload and the errors of the files are in the ExtractArchiveResponse.
+func ExtractArchive(ctx context.Context, c *gophercloud.ServiceClient, containerName string, opts ExtractArchiveOptsBuilder) (r ExtractArchiveResult) {
+	b, format, err := opts.ToObjectExtractArchiveParams()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	// Stop writing the archive if the request fails.
+	if closer, ok := b.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	url, err := extractArchiveURL(c, containerName, format)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := c.Put(ctx, url, b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Accept": "application/json",
+		},
+		OkCodes: []int{200, 201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
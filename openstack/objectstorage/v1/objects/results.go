@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,20 @@ type Object struct {
 	// VersionID contains a version ID of the object, when container
 	// versioning is enabled.
 	VersionID string `json:"version_id"`
+
+	// SymlinkPath is the path of the target of a symlink, in the
+	// /v1/{account}/{container}/{object} form.
+	SymlinkPath string `json:"symlink_path"`
+}
+
+// deleteMarkerContentType is the content type of the versions which record
+// the deletion of an object in a versioned container.
+const deleteMarkerContentType = "application/x-deleted;swift_versions_deleted=1"
+
+// IsDeleteMarker returns whether a version of an object, listed with
+// ListOpts.Versions, records the deletion of the object.
+func (r Object) IsDeleteMarker() bool {
+	return r.ContentType == deleteMarkerContentType
 }
 
 func (r *Object) UnmarshalJSON(b []byte) error {
@@ -89,6 +104,30 @@ func (r ObjectPage) LastMarker() (string, error) {
 	return extractLastMarker(r)
 }
 
+// NextPageURL generates the URL for the page of objects after this one. When
+// versions are listed, the next page starts after the last version, since
+// all the versions of an object share its name.
+func (r ObjectPage) NextPageURL(endpointURL string) (string, error) {
+	next, err := r.MarkerPageBase.NextPageURL(endpointURL)
+	if err != nil || r.URL.Query().Get("versions") == "" {
+		return next, err
+	}
+
+	parsed, err := ExtractInfo(r)
+	if err != nil || len(parsed) == 0 {
+		return next, err
+	}
+
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("version_marker", parsed[len(parsed)-1].VersionID)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
 // ExtractInfo is a function that takes a page of objects and returns their
 // full information.
 func ExtractInfo(r pagination.Page) ([]Object, error) {
@@ -232,6 +271,13 @@ type GetHeader struct {
 	StaticLargeObject  bool      `json:"-"`
 	TransID            string    `json:"X-Trans-Id"`
 	ObjectVersionID    string    `json:"X-Object-Version-Id"`
+
+	// The symlink fields are only set by a Get request with the Symlink
+	// option set to "get". SymlinkTarget is in the {container}/{object} form.
+	SymlinkTarget        string `json:"X-Symlink-Target"`
+	SymlinkTargetAccount string `json:"X-Symlink-Target-Account"`
+	SymlinkTargetETag    string `json:"X-Symlink-Target-Etag"`
+	SymlinkTargetBytes   int64  `json:"X-Symlink-Target-Bytes,string"`
 }
 
 func (r *GetHeader) UnmarshalJSON(b []byte) error {
@@ -490,6 +536,70 @@ func (r BulkDeleteResult) Extract() (*BulkDeleteResponse, error) {
 	return &s, err
 }
 
+// BulkError is the error of a file of a bulk operation.
+type BulkError struct {
+	// Name is the path of the object, in the /{container}/{object} form.
+	Name string
+
+	// Status is the HTTP status of the operation on the object, like
+	// "400 Bad Request".
+	Status string
+}
+
+// StatusCode returns the HTTP status code of the operation on the object.
+func (r BulkError) StatusCode() int {
+	code, _, _ := strings.Cut(r.Status, " ")
+	n, _ := strconv.Atoi(code)
+	return n
+}
+
+// UnmarshalJSON reads a BulkError from its [name, status] form.
+func (r *BulkError) UnmarshalJSON(b []byte) error {
+	var s []string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if len(s) != 2 {
+		return fmt.Errorf("unexpected bulk error: %s", b)
+	}
+	r.Name, r.Status = s[0], s[1]
+	return nil
+}
+
+// ExtractArchiveResponse represents the report of an ExtractArchive request.
+type ExtractArchiveResponse struct {
+	// NumberFilesCreated is the number of objects created.
+	NumberFilesCreated int `json:"Number Files Created"`
+
+	// ResponseStatus is the HTTP status of the upload, like "201 Created".
+	// It is "400 Bad Request" when some files failed.
+	ResponseStatus string `json:"Response Status"`
+
+	// ResponseBody describes the failure of the upload.
+	ResponseBody string `json:"Response Body"`
+
+	// Errors are the errors of the files which could not be created.
+	Errors []BulkError `json:"Errors"`
+}
+
+// StatusCode returns the HTTP status code of the upload.
+func (r ExtractArchiveResponse) StatusCode() int {
+	return BulkError{Status: r.ResponseStatus}.StatusCode()
+}
+
+// ExtractArchiveResult represents the result of an ExtractArchive operation.
+// To extract the report of the upload, call its Extract method.
+type ExtractArchiveResult struct {
+	gophercloud.Result
+}
+
+// Extract will return the ExtractArchiveResponse of an ExtractArchive call.
+func (r ExtractArchiveResult) Extract() (*ExtractArchiveResponse, error) {
+	var s ExtractArchiveResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
 // extractLastMarker is a function that takes a page of objects and returns the
 // marker for the page. This can either be a subdir or the last object's name.
 func extractLastMarker(r pagination.Page) (string, error) {
@@ -545,3 +655,26 @@ func extractLastMarker(r pagination.Page) (string, error) {
 		return "", fmt.Errorf("cannot extract names from response with content-type: [%s]", ct)
 	}
 }
+
+// FormPOST holds the URL and the hidden fields of an HTML form which uploads
+// files with the FormPOST middleware. The files are sent in the "file" fields
+// of a multipart/form-data POST request, after the hidden fields.
+type FormPOST struct {
+	URL          string
+	Redirect     string
+	MaxFileSize  int64
+	MaxFileCount int
+	Expires      int64
+	Signature    string
+}
+
+// Fields returns the hidden fields of the form, by name.
+func (r FormPOST) Fields() map[string]string {
+	return map[string]string{
+		"redirect":       r.Redirect,
+		"max_file_size":  strconv.FormatInt(r.MaxFileSize, 10),
+		"max_file_count": strconv.Itoa(r.MaxFileCount),
+		"expires":        strconv.FormatInt(r.Expires, 10),
+		"signature":      r.Signature,
+	}
+}
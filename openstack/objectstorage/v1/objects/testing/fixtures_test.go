@@ -1,10 +1,14 @@
 package testing
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"crypto/md5"
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"testing"
 	"time"
 
@@ -372,3 +376,197 @@ func HandleGetObjectSuccessfully(t *testing.T, fakeServer th.FakeServer, options
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// ParallelDownloadContent is the content served by
+// HandleDownloadParallelSuccessfully.
+var ParallelDownloadContent = []byte("Successful download with Gophercloud")
+
+// parallelDownload records the ranges requested to
+// HandleDownloadParallelSuccessfully.
+type parallelDownload struct {
+	mu     sync.Mutex
+	ranges []string
+}
+
+func (d *parallelDownload) requestedRanges() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.ranges...)
+}
+
+// HandleDownloadParallelSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that serves ranges of
+// ParallelDownloadContent. The first range request is interrupted after
+// interruptAfter bytes, unless it is negative.
+func HandleDownloadParallelSuccessfully(t *testing.T, fakeServer th.FakeServer, etag string, interruptAfter int) *parallelDownload {
+	d := &parallelDownload{}
+	fakeServer.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("ETag", etag)
+
+		if r.Method == "HEAD" {
+			w.Header().Set("Content-Length", fmt.Sprint(len(ParallelDownloadContent)))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "If-Match", etag)
+		r.Header.Del("If-Match")
+
+		d.mu.Lock()
+		d.ranges = append(d.ranges, r.Header.Get("Range"))
+		interrupt := len(d.ranges) == 1 && interruptAfter >= 0
+		d.mu.Unlock()
+
+		if interrupt {
+			var start, end int
+			_, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end)
+			th.AssertNoErr(t, err)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(ParallelDownloadContent)))
+			w.Header().Set("Content-Length", fmt.Sprint(end-start+1))
+			w.WriteHeader(http.StatusPartialContent)
+			_, err = w.Write(ParallelDownloadContent[start : start+interruptAfter])
+			th.AssertNoErr(t, err)
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(ParallelDownloadContent))
+	})
+
+	return d
+}
+
+// HandleCreateSymlinkSuccessfully creates an HTTP handler at
+// `/testContainer/testSymlink` on the test handler mux that responds with a
+// `CreateSymlink` response.
+func HandleCreateSymlinkSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/testContainer/testSymlink", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "X-Symlink-Target", "targetContainer/target%20object")
+		th.TestHeader(t, r, "X-Symlink-Target-Etag", "451e372e48e0f6b1114fa0724aa79fa1")
+		th.TestHeader(t, r, "X-Object-Meta-Foo", "bar")
+		th.TestBody(t, r, "")
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// HandleGetSymlinkSuccessfully creates an HTTP handler at
+// `/testContainer/testSymlink` on the test handler mux that responds with a
+// `Get` response for a symlink.
+func HandleGetSymlinkSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/testContainer/testSymlink", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"symlink": "get"})
+		w.Header().Set("X-Symlink-Target", "targetContainer/target%20object")
+		w.Header().Set("X-Symlink-Target-Etag", "451e372e48e0f6b1114fa0724aa79fa1")
+		w.Header().Set("X-Symlink-Target-Bytes", "14")
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// HandleListObjectVersionsSuccessfully creates an HTTP handler at
+// `/testContainer` on the test handler mux that responds with the versions
+// of an object, two by two.
+func HandleListObjectVersionsSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/testContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		th.AssertEquals(t, "true", r.Form.Get("versions"))
+		th.AssertEquals(t, "2", r.Form.Get("limit"))
+
+		marker := r.Form.Get("marker") + "/" + r.Form.Get("version_marker")
+		switch marker {
+		case "/":
+			fmt.Fprint(w, `[
+      {
+        "hash": "d41d8cd98f00b204e9800998ecf8427e",
+        "last_modified": "2016-08-17T22:12:58.602650",
+        "bytes": 0,
+        "name": "hello",
+        "content_type": "application/x-deleted;swift_versions_deleted=1",
+        "version_id": "1471471978.60265",
+        "is_latest": true
+      },
+      {
+        "hash": "451e372e48e0f6b1114fa0724aa79fa1",
+        "last_modified": "2016-08-17T22:11:58.602650",
+        "bytes": 14,
+        "name": "hello",
+        "content_type": "application/octet-stream",
+        "version_id": "1471471918.60265",
+        "is_latest": false
+      }
+    ]`)
+		case "hello/1471471918.60265":
+			fmt.Fprint(w, `[
+      {
+        "hash": "451e372e48e0f6b1114fa0724aa79fa1",
+        "last_modified": "2016-08-17T22:10:58.602650",
+        "bytes": 14,
+        "name": "hello",
+        "content_type": "application/octet-stream",
+        "version_id": "1471471858.60265",
+        "is_latest": false
+      }
+    ]`)
+		case "hello/1471471858.60265":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// ExtractArchiveResponse is the report of an ExtractArchive request, with a
+// failed file.
+const ExtractArchiveResponse = `
+{
+  "Number Files Created": 2,
+  "Response Status": "400 Bad Request",
+  "Response Body": "",
+  "Errors": [
+    ["/testContainer/logs/huge.log", "413 Request Entity Too Large"]
+  ]
+}
+`
+
+// HandleExtractArchiveSuccessfully creates an HTTP handler at `/testContainer`
+// on the test handler mux that reads a gzipped tar archive, and responds with
+// ExtractArchiveResponse.
+func HandleExtractArchiveSuccessfully(t *testing.T, fakeServer th.FakeServer, files map[string]string) {
+	fakeServer.Mux.HandleFunc("/testContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestFormValues(t, r, map[string]string{"extract-archive": "tar.gz"})
+
+		gr, err := gzip.NewReader(r.Body)
+		th.AssertNoErr(t, err)
+		tr := tar.NewReader(gr)
+		received := make(map[string]string)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			th.AssertNoErr(t, err)
+			content, err := io.ReadAll(tr)
+			th.AssertNoErr(t, err)
+			received[header.Name] = string(content)
+		}
+		th.CheckDeepEquals(t, files, received)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ExtractArchiveResponse)
+	})
+}
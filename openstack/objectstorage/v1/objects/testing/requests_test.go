@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	v1 "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1"
 	accountTesting "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/accounts/testing"
 	containerTesting "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers/testing"
@@ -533,3 +536,287 @@ func TestCreateTempURL(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, expectedURL, tempURL)
 }
+
+func TestDownloadParallel(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	etag := fmt.Sprintf("%x", md5.Sum(ParallelDownloadContent))
+	d := HandleDownloadParallelSuccessfully(t, fakeServer, etag, -1)
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "testObject"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	n, err := objects.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", f, objects.DownloadParallelOpts{
+		ChunkSize:   10,
+		Concurrency: 2,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(ParallelDownloadContent)), n)
+	th.AssertEquals(t, 4, len(d.requestedRanges()))
+
+	content, err := os.ReadFile(f.Name())
+	th.AssertNoErr(t, err)
+	th.AssertByteArrayEquals(t, ParallelDownloadContent, content)
+}
+
+func TestDownloadParallelRetry(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	etag := fmt.Sprintf("%x", md5.Sum(ParallelDownloadContent))
+	d := HandleDownloadParallelSuccessfully(t, fakeServer, etag, 4)
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "testObject"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	n, err := objects.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", f, objects.DownloadParallelOpts{
+		ChunkSize:   100,
+		Concurrency: 1,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(ParallelDownloadContent)), n)
+
+	// The second attempt only requests the bytes which were not received.
+	th.CheckDeepEquals(t, []string{"bytes=0-35", "bytes=4-35"}, d.requestedRanges())
+
+	content, err := os.ReadFile(f.Name())
+	th.AssertNoErr(t, err)
+	th.AssertByteArrayEquals(t, ParallelDownloadContent, content)
+}
+
+func TestDownloadParallelResume(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	etag := fmt.Sprintf("%x", md5.Sum(ParallelDownloadContent))
+	d := HandleDownloadParallelSuccessfully(t, fakeServer, etag, 4)
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "testObject"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	// The download is interrupted after the 4 first bytes.
+	n, err := objects.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", f, objects.DownloadParallelOpts{
+		ChunkSize:  100,
+		MaxRetries: -1,
+	})
+	if err == nil {
+		t.Fatal("expected the download to fail")
+	}
+	th.AssertEquals(t, int64(4), n)
+
+	n, err = objects.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", f, objects.DownloadParallelOpts{
+		ChunkSize: 100,
+		Offset:    n,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(ParallelDownloadContent)), n)
+	th.CheckDeepEquals(t, []string{"bytes=0-35", "bytes=4-35"}, d.requestedRanges())
+
+	content, err := os.ReadFile(f.Name())
+	th.AssertNoErr(t, err)
+	th.AssertByteArrayEquals(t, ParallelDownloadContent, content)
+}
+
+func TestDownloadParallelChecksumMismatch(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDownloadParallelSuccessfully(t, fakeServer, "90cd4a1ea4e8fd6c5a04d5f33a98ef6d", -1)
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "testObject"))
+	th.AssertNoErr(t, err)
+	defer f.Close()
+
+	n, err := objects.DownloadParallel(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testObject", f, objects.DownloadParallelOpts{})
+	th.AssertEquals(t, int64(0), n)
+	mismatch, ok := err.(objects.ErrChecksumMismatch)
+	if !ok {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	th.AssertEquals(t, "90cd4a1ea4e8fd6c5a04d5f33a98ef6d", mismatch.Expected)
+	th.AssertEquals(t, fmt.Sprintf("%x", md5.Sum(ParallelDownloadContent)), mismatch.Actual)
+}
+
+type writerAt struct{}
+
+func (writerAt) WriteAt(p []byte, off int64) (int, error) { return len(p), nil }
+
+func TestDownloadParallelRequiresReaderAt(t *testing.T) {
+	_, err := objects.DownloadParallel(context.TODO(), nil, "testContainer", "testObject", writerAt{}, objects.DownloadParallelOpts{})
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestCreateFormPOST(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	client := client.ServiceClient(fakeServer)
+	client.Endpoint = client.Endpoint + "v1/"
+
+	form, err := objects.CreateFormPOST(context.TODO(), client, "testContainer", objects.CreateFormPOSTOpts{
+		TTL:          600,
+		MaxFileSize:  104857600,
+		MaxFileCount: 10,
+		Prefix:       "uploads/",
+		Redirect:     "https://example.com/done",
+		Timestamp:    time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+		TempURLKey:   "super-secret",
+		Digest:       "sha256",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, fakeServer.Endpoint()+"v1/testContainer/uploads%2F", form.URL)
+	th.CheckDeepEquals(t, map[string]string{
+		"redirect":       "https://example.com/done",
+		"max_file_size":  "104857600",
+		"max_file_count": "10",
+		"expires":        "1593566520",
+		"signature":      "06a1cac0968d7321776e88bd2d1753149e2e2bf74093c982315aba6dc689b52a",
+	}, form.Fields())
+}
+
+func TestCreateFormPOSTWithoutPrefix(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	client := client.ServiceClient(fakeServer)
+	client.Endpoint = client.Endpoint + "v1/"
+
+	form, err := objects.CreateFormPOST(context.TODO(), client, "testContainer", objects.CreateFormPOSTOpts{
+		TTL:          600,
+		MaxFileSize:  1024,
+		MaxFileCount: 1,
+		Timestamp:    time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+		TempURLKey:   "super-secret",
+		Digest:       "sha512",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, fakeServer.Endpoint()+"v1/testContainer/", form.URL)
+	th.AssertEquals(t, "ae5047af945c9fa6995503db8174bd93831c33d7f5acee0207e83d7b3293bf17a3965c3943513079bc3663de0ed8094977b2a75accf44f9335bc83b64d21f9ac", form.Signature)
+
+	_, err = objects.CreateFormPOST(context.TODO(), client, "testContainer", objects.CreateFormPOSTOpts{
+		TTL:          600,
+		MaxFileSize:  1024,
+		MaxFileCount: 1,
+		TempURLKey:   "super-secret",
+		Digest:       "md5",
+	})
+	if _, ok := err.(objects.ErrTempURLDigestNotValid); !ok {
+		t.Fatalf("expected ErrTempURLDigestNotValid, got %v", err)
+	}
+}
+
+func TestCreateFormPOSTRequiresLimits(t *testing.T) {
+	_, err := objects.CreateFormPOST(context.TODO(), nil, "testContainer", objects.CreateFormPOSTOpts{
+		TTL:         600,
+		MaxFileSize: 1024,
+	})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestCreateSymlink(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSymlinkSuccessfully(t, fakeServer)
+
+	options := objects.CreateSymlinkOpts{
+		TargetContainer: "targetContainer",
+		TargetObject:    "target object",
+		TargetETag:      "451e372e48e0f6b1114fa0724aa79fa1",
+		Metadata:        map[string]string{"Foo": "bar"},
+	}
+	res := objects.CreateSymlink(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testSymlink", options)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestGetSymlink(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSymlinkSuccessfully(t, fakeServer)
+
+	header, err := objects.Get(context.TODO(), client.ServiceClient(fakeServer), "testContainer", "testSymlink", objects.GetOpts{Symlink: "get"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "targetContainer/target%20object", header.SymlinkTarget)
+	th.AssertEquals(t, "451e372e48e0f6b1114fa0724aa79fa1", header.SymlinkTargetETag)
+	th.AssertEquals(t, int64(14), header.SymlinkTargetBytes)
+}
+
+func TestListObjectVersions(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListObjectVersionsSuccessfully(t, fakeServer)
+
+	allPages, err := objects.List(client.ServiceClient(fakeServer), "testContainer", objects.ListOpts{Versions: true, Limit: 2}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	versions, err := objects.ExtractInfo(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 3, len(versions))
+	th.AssertEquals(t, true, versions[0].IsDeleteMarker())
+	th.AssertEquals(t, true, versions[0].IsLatest)
+	th.AssertEquals(t, false, versions[1].IsDeleteMarker())
+	th.AssertEquals(t, "1471471858.60265", versions[2].VersionID)
+}
+
+func TestExtractArchive(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleExtractArchiveSuccessfully(t, fakeServer, map[string]string{
+		"logs/a.log":    "first",
+		"logs/b.log":    "second",
+		"logs/huge.log": "third",
+	})
+
+	path := filepath.Join(t.TempDir(), "b.log")
+	th.AssertNoErr(t, os.WriteFile(path, []byte("second"), 0o644))
+
+	options := objects.ExtractArchiveOpts{
+		Format: objects.ArchiveTarGz,
+		Files: []objects.ArchiveFile{
+			{Name: "logs/a.log", Content: strings.NewReader("first"), Size: 5},
+			{Name: "logs/b.log", Path: path},
+			{Name: "logs/huge.log", Content: strings.NewReader("third"), Size: 5},
+		},
+	}
+	res, err := objects.ExtractArchive(context.TODO(), client.ServiceClient(fakeServer), "testContainer", options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, res.NumberFilesCreated)
+	th.AssertEquals(t, 400, res.StatusCode())
+	th.CheckDeepEquals(t, []objects.BulkError{
+		{Name: "/testContainer/logs/huge.log", Status: "413 Request Entity Too Large"},
+	}, res.Errors)
+	th.AssertEquals(t, 413, res.Errors[0].StatusCode())
+}
+
+func TestExtractArchiveOpts(t *testing.T) {
+	_, _, err := objects.ExtractArchiveOpts{}.ToObjectExtractArchiveParams()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	_, _, err = objects.ExtractArchiveOpts{
+		Format: objects.ArchiveTarBz2,
+		Files:  []objects.ArchiveFile{{Name: "a", Content: strings.NewReader("a"), Size: 1}},
+	}.ToObjectExtractArchiveParams()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+
+	_, _, err = objects.ExtractArchiveOpts{
+		Files: []objects.ArchiveFile{{Name: "a"}},
+	}.ToObjectExtractArchiveParams()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	body, format, err := objects.ExtractArchiveOpts{
+		Content: strings.NewReader("archive"),
+		Format:  objects.ArchiveTarBz2,
+	}.ToObjectExtractArchiveParams()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, objects.ArchiveTarBz2, format)
+	b, err := io.ReadAll(body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "archive", string(b))
+}
@@ -55,3 +55,15 @@ func updateURL(c *gophercloud.ServiceClient, container, object string) (string,
 func bulkDeleteURL(c *gophercloud.ServiceClient) string {
 	return c.Endpoint + "?bulk-delete=true"
 }
+
+func extractArchiveURL(c *gophercloud.ServiceClient, container string, format ArchiveFormat) (string, error) {
+	query := "?extract-archive=" + url.QueryEscape(string(format))
+	if container == "" {
+		return c.Endpoint + query, nil
+	}
+	u, err := listURL(c, container)
+	if err != nil {
+		return "", err
+	}
+	return u + query, nil
+}
@@ -0,0 +1,117 @@
+package objects
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/rangedownload"
+)
+
+// ErrChecksumMismatch is the error returned when the checksum of a downloaded
+// object does not match its ETag.
+type ErrChecksumMismatch struct {
+	gophercloud.BaseError
+	Expected string
+	Actual   string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("downloaded content has checksum %q, expected %q", e.Actual, e.Expected)
+}
+
+// DownloadParallelOpts is a structure that holds parameters for downloading
+// an object with DownloadParallel.
+type DownloadParallelOpts struct {
+	// ChunkSize is the size of the ranges requested. Defaults to 16 MiB.
+	ChunkSize int64
+
+	// Concurrency is the number of ranges downloaded concurrently. Defaults
+	// to 4.
+	Concurrency int
+
+	// MaxRetries is the number of times the download of a range is retried,
+	// starting from the last byte received. Defaults to 3. Set it to a
+	// negative value to disable retries.
+	MaxRetries int
+
+	// Offset resumes an interrupted download: the content before Offset is
+	// expected to be already written.
+	Offset int64
+
+	// SkipVerify disables the verification of the MD5 checksum of the
+	// content against the ETag of the object.
+	SkipVerify bool
+}
+
+// DownloadParallel downloads an object into w by requesting concurrent
+// ranges of its content. Every range is requested with the ETag of the object
+// in If-Match, so that the download fails if the object is replaced.
+//
+// Unless SkipVerify is set, w must also implement io.ReaderAt, like *os.File,
+// so that the MD5 checksum of the content can be read back and checked
+// against the ETag. The content of Static and Dynamic Large Objects is not
+// verified, since their ETag is not the checksum of their content.
+//
+// DownloadParallel returns the offset up to which the content is written
+// without gap. When the download fails, it can be passed as
+// DownloadParallelOpts.Offset to resume it.
+func DownloadParallel(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string, w io.WriterAt, opts DownloadParallelOpts) (int64, error) {
+	r, verify := w.(io.ReaderAt)
+	if !verify && !opts.SkipVerify {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "w"
+		err.Value = "io.WriterAt which does not implement io.ReaderAt"
+		err.Info = "the destination must implement io.ReaderAt to verify the checksum of the content, unless SkipVerify is set"
+		return opts.Offset, err
+	}
+
+	header, err := Get(ctx, c, containerName, objectName, nil).Extract()
+	if err != nil {
+		return opts.Offset, err
+	}
+
+	ifMatch := header.ETag
+	if header.ObjectManifest != "" {
+		// The ETag of a Dynamic Large Object changes with its segments.
+		ifMatch = ""
+	}
+
+	fetch := func(ctx context.Context, offset, length int64) (io.ReadCloser, http.Header, error) {
+		downloadOpts := DownloadOpts{
+			IfMatch: ifMatch,
+			Range:   rangedownload.Range(offset, length),
+		}
+		res := Download(ctx, c, containerName, objectName, downloadOpts)
+		return res.Body, res.Header, res.Err
+	}
+
+	n, err := rangedownload.Download(ctx, w, fetch, rangedownload.Opts{
+		Size:        header.ContentLength,
+		Offset:      opts.Offset,
+		ChunkSize:   opts.ChunkSize,
+		Concurrency: opts.Concurrency,
+		MaxRetries:  opts.MaxRetries,
+	})
+	if err != nil {
+		return n, err
+	}
+
+	if opts.SkipVerify || header.StaticLargeObject || header.ObjectManifest != "" {
+		return n, nil
+	}
+
+	sum, err := rangedownload.Hash(r, header.ContentLength, md5.New())
+	if err != nil {
+		return n, err
+	}
+	if expected := strings.Trim(header.ETag, `"`); sum != expected {
+		return 0, ErrChecksumMismatch{Expected: expected, Actual: sum}
+	}
+
+	return n, nil
+}